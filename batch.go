@@ -0,0 +1,99 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of many documents, in parallel.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"runtime"
+	"sync"
+)
+
+// BatchResult is the validation of a document of a batch, Err is set instead
+// of Result when the document is not valid JSON
+type BatchResult struct {
+	Index  int
+	Result *ValidationResult
+	Err    error
+}
+
+// ValidateAll decodes and validates json documents, at most parallelism of
+// them at once ( the number of CPUs when 0 ), the results are in the order of
+// the documents
+func (v *JsonSchemaDocument) ValidateAll(documents [][]byte, parallelism int) []BatchResult {
+	results := make([]BatchResult, len(documents))
+
+	queue := make(chan []byte)
+	go func() {
+		defer close(queue)
+		for _, document := range documents {
+			queue <- document
+		}
+	}()
+	v.ValidateEach(queue, parallelism, func(result BatchResult) {
+		results[result.Index] = result
+	})
+	return results
+}
+
+// ValidateEach decodes and validates the json documents received until the
+// channel is closed, at most parallelism of them at once ( the number of CPUs
+// when 0 ), the documents are indexed in the order they are received
+// onResult is called once per document, in no particular order, never by two
+// goroutines at once. Returns when all the documents are validated
+func (v *JsonSchemaDocument) ValidateEach(documents <-chan []byte, parallelism int, onResult func(result BatchResult)) {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+
+	type indexedDocument struct {
+		index    int
+		document []byte
+	}
+	queue := make(chan indexedDocument)
+	go func() {
+		defer close(queue)
+		index := 0
+		for document := range documents {
+			queue <- indexedDocument{index, document}
+			index++
+		}
+	}()
+
+	var resultMutex sync.Mutex
+	var workers sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for document := range queue {
+				result, err := v.ValidateBytes(document.document)
+				resultMutex.Lock()
+				onResult(BatchResult{Index: document.index, Result: result, Err: err})
+				resultMutex.Unlock()
+			}
+		}()
+	}
+	workers.Wait()
+}
@@ -0,0 +1,78 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the batch validations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidateAll(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"type":"integer","maximum":50}`)
+
+	var documents [][]byte
+	for i := 0; i < 100; i++ {
+		documents = append(documents, []byte(fmt.Sprint(i)))
+	}
+	documents = append(documents, []byte(`{`))
+
+	for _, parallelism := range []int{0, 1, 7} {
+		results := schemaDocument.ValidateAll(documents, parallelism)
+		if len(results) != len(documents) {
+			t.Fatalf("Expects %d results, given %d", len(documents), len(results))
+		}
+		for i, result := range results[:100] {
+			if result.Index != i || result.Err != nil || result.Result.IsValid() != (i <= 50) {
+				t.Errorf("Expects the result of %d at its index, given %+v", i, result)
+			}
+		}
+		if results[100].Err == nil || results[100].Result != nil {
+			t.Errorf("Expects an error for the invalid json, given %+v", results[100])
+		}
+	}
+}
+
+func TestValidateEach(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"type":"string"}`)
+
+	documents := make(chan []byte)
+	go func() {
+		defer close(documents)
+		for _, document := range []string{`"a"`, `1`, `"b"`} {
+			documents <- []byte(document)
+		}
+	}()
+
+	invalid := map[int]bool{}
+	schemaDocument.ValidateEach(documents, 2, func(result BatchResult) {
+		invalid[result.Index] = !result.Result.IsValid()
+	})
+	if len(invalid) != 3 || invalid[0] || !invalid[1] || invalid[2] {
+		t.Errorf("Expects only the document 1 to be invalid, given %v", invalid)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Selection of the anyOf / oneOf branch whose errors are reported.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+// FailedBranch is an anyOf / oneOf branch that the document did not validate
+type FailedBranch struct {
+	Index  int
+	Schema *SchemaNode
+	Result *ValidationResult
+}
+
+// BranchSelector chooses, when no anyOf / oneOf branch validates, the branch
+// whose errors are reported, the document location is given as the Context of
+// the errors ( ex: ROOT.items.0 )
+type BranchSelector interface {
+	// Returns the index in branches of the branch to report
+	SelectBranch(context string, keyword string, branches []FailedBranch) int
+}
+
+// BranchSelectorFunc adapts a function to a BranchSelector
+type BranchSelectorFunc func(context string, keyword string, branches []FailedBranch) int
+
+func (f BranchSelectorFunc) SelectBranch(context string, keyword string, branches []FailedBranch) int {
+	return f(context, keyword, branches)
+}
+
+// ScoreBranchSelector reports the branch with the highest Score, the first one
+// on a tie, it is the default
+type ScoreBranchSelector struct{}
+
+func (ScoreBranchSelector) SelectBranch(context string, keyword string, branches []FailedBranch) int {
+	return bestScoredBranch(branches, func(FailedBranch) bool { return true })
+}
+
+// TypeBranchSelector reports the branch with the highest Score among those whose
+// "type" matches the document, or among all of them when none matches
+type TypeBranchSelector struct{}
+
+func (TypeBranchSelector) SelectBranch(context string, keyword string, branches []FailedBranch) int {
+	typeMatched := func(branch FailedBranch) bool {
+		for _, resultError := range branch.Result.GetErrors() {
+			if resultError.Type == ERROR_TYPE_TYPE && resultError.Context == context {
+				return false
+			}
+		}
+		return true
+	}
+	if index := bestScoredBranch(branches, typeMatched); index >= 0 {
+		return index
+	}
+	return ScoreBranchSelector{}.SelectBranch(context, keyword, branches)
+}
+
+// Index of the branch with the highest score among the accepted ones, -1 when none is
+func bestScoredBranch(branches []FailedBranch, accept func(FailedBranch) bool) int {
+	best := -1
+	for i, branch := range branches {
+		if accept(branch) && (best < 0 || branch.Result.score > branches[best].Result.score) {
+			best = i
+		}
+	}
+	return best
+}
+
+// Selects the reported branch among the results of the branches of schemas, an
+// out of range selection falls back to the best score
+func (o *validationOptions) selectBranch(context *jsonContext, keyword string, schemas []*jsonSchema, results []*ValidationResult) *ValidationResult {
+	branches := make([]FailedBranch, len(results))
+	for i, result := range results {
+		branches[i] = FailedBranch{Index: i, Schema: &SchemaNode{schema: schemas[i]}, Result: result}
+	}
+	var selector BranchSelector = ScoreBranchSelector{}
+	if o != nil && o.branchSelector != nil {
+		selector = o.branchSelector
+	}
+	index := selector.SelectBranch(context.String(), keyword, branches)
+	if index < 0 || index >= len(branches) {
+		index = ScoreBranchSelector{}.SelectBranch(context.String(), keyword, branches)
+	}
+	return results[index]
+}
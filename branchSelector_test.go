@@ -0,0 +1,80 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the branch selectors.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestBranchSelector(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"oneOf":[
+		{"type":"string","minLength":100},
+		{"type":"object","properties":{"a":{"type":"string"},"b":{"type":"string"},"c":{"type":"string"}}}
+	]}`)
+	document := mustParseJson(t, `{"a":1,"b":1,"c":1}`)
+
+	reportedKeyword := func() string {
+		for _, resultError := range schemaDocument.Validate(document).GetErrors() {
+			if resultError.Keyword != KEY_ONE_OF {
+				return resultError.Keyword
+			}
+		}
+		return ""
+	}
+
+	if keyword := reportedKeyword(); keyword != KEY_TYPE {
+		t.Errorf("Expects the best scored branch to be reported, given %s", keyword)
+	}
+
+	schemaDocument.SetBranchSelector(TypeBranchSelector{})
+	result := schemaDocument.Validate(document)
+	if errors := result.GetErrors(); len(errors) != 4 || errors[1].Context != "ROOT.a" {
+		t.Errorf("Expects the errors of the object branch, given %v", errors)
+	}
+
+	var selected []FailedBranch
+	schemaDocument.SetBranchSelector(BranchSelectorFunc(func(context string, keyword string, branches []FailedBranch) int {
+		if context != "ROOT" || keyword != KEY_ONE_OF {
+			t.Errorf("Expects the selection of the %s of ROOT, given %s of %s", KEY_ONE_OF, keyword, context)
+		}
+		selected = branches
+		return 0
+	}))
+	if keyword := reportedKeyword(); keyword != KEY_TYPE {
+		t.Errorf("Expects the selected branch to be reported, given %s", keyword)
+	}
+	if len(selected) != 2 || selected[1].Index != 1 || selected[1].Schema.Types()[0] != TYPE_OBJECT || selected[1].Result.IsValid() {
+		t.Errorf("Expects the failed branches to be given to the selector, given %v", selected)
+	}
+
+	schemaDocument.SetBranchSelector(BranchSelectorFunc(func(string, string, []FailedBranch) int {
+		return 5
+	}))
+	if keyword := reportedKeyword(); keyword != KEY_TYPE {
+		t.Errorf("Expects an out of range selection to fall back to the best score, given %s", keyword)
+	}
+}
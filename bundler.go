@@ -0,0 +1,240 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Bundles a schema and the schemas it references in a single document.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonreference"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Bundle loads the schema at reference ( file or http scheme ) and returns it
+// as a single self-contained document : the schemas referenced in other
+// documents are copied in the root definitions and their $ref rewritten
+func Bundle(reference string) (map[string]interface{}, error) {
+
+	rootReference, err := gojsonreference.NewJsonReference(reference)
+	if err != nil {
+		return nil, err
+	}
+	if !rootReference.IsCanonical() {
+		return nil, errors.New(fmt.Sprintf("Reference must be canonical %s", reference))
+	}
+
+	b := &bundler{pool: newSchemaPool(), names: make(map[string]string), definitions: make(map[string]interface{})}
+	b.rootUrl = documentUrl(rootReference)
+
+	spd, err := b.pool.GetPoolDocument(rootReference)
+	if err != nil {
+		return nil, err
+	}
+	if !isKind(spd.Document, reflect.Map) {
+		return nil, errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, STRING_SCHEMA, STRING_OBJECT))
+	}
+	b.existingDefinitions, _ = spd.Document.(map[string]interface{})[KEY_DEFINITIONS].(map[string]interface{})
+
+	bundled, err := b.bundle(spd.Document, rootReference)
+	if err != nil {
+		return nil, err
+	}
+	root := bundled.(map[string]interface{})
+
+	if len(b.definitions) > 0 {
+		definitions, _ := root[KEY_DEFINITIONS].(map[string]interface{})
+		if definitions == nil {
+			definitions = make(map[string]interface{})
+		}
+		for name, definition := range b.definitions {
+			definitions[name] = definition
+		}
+		root[KEY_DEFINITIONS] = definitions
+	}
+
+	return root, nil
+}
+
+type bundler struct {
+	pool    *schemaPool
+	rootUrl string
+
+	// definitions name of the inlined schemas, by absolute reference
+	names               map[string]string
+	definitions         map[string]interface{}
+	existingDefinitions map[string]interface{}
+}
+
+// documentUrl returns the url of the document of a reference, without fragment
+func documentUrl(reference gojsonreference.JsonReference) string {
+	u := *reference.GetUrl()
+	u.Fragment = ""
+	return u.String()
+}
+
+// bundle copies a node of the document at base, rewriting its references
+func (b *bundler) bundle(node interface{}, base gojsonreference.JsonReference) (interface{}, error) {
+
+	switch node := node.(type) {
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(node))
+		for key, value := range node {
+
+			// values, not schemas
+			if key == KEY_ENUM || key == KEY_DEFAULT {
+				m[key] = copyJson(value)
+				continue
+			}
+
+			// schemas by name, a name is not a keyword
+			if schemas, ok := value.(map[string]interface{}); ok && isSchemasByName(key) {
+				bundled, err := b.bundleSchemasByName(schemas, base)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = bundled
+				continue
+			}
+
+			if reference, ok := value.(string); ok && key == KEY_REF {
+				rewritten, err := b.rewriteReference(reference, base)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = rewritten
+				continue
+			}
+
+			bundled, err := b.bundle(value, base)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = bundled
+		}
+		return m, nil
+
+	case []interface{}:
+		a := make([]interface{}, len(node))
+		for i, value := range node {
+			bundled, err := b.bundle(value, base)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = bundled
+		}
+		return a, nil
+	}
+
+	return node, nil
+}
+
+// isSchemasByName tells if the value of a keyword maps names ( properties,
+// patterns, definitions ) to schemas
+func isSchemasByName(keyword string) bool {
+	switch keyword {
+	case KEY_PROPERTIES, KEY_PATTERN_PROPERTIES, KEY_DEFINITIONS, KEY_DEPENDENCIES:
+		return true
+	}
+	return false
+}
+
+// bundleSchemasByName copies the schemas of a properties / definitions... keyword
+func (b *bundler) bundleSchemasByName(schemas map[string]interface{}, base gojsonreference.JsonReference) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(schemas))
+	for name, schema := range schemas {
+		bundled, err := b.bundle(schema, base)
+		if err != nil {
+			return nil, err
+		}
+		m[name] = bundled
+	}
+	return m, nil
+}
+
+// rewriteReference inlines the schema of a reference to another document, and
+// returns the reference to use in the bundle
+func (b *bundler) rewriteReference(reference string, base gojsonreference.JsonReference) (string, error) {
+
+	jsonReference, err := gojsonreference.NewJsonReference(reference)
+	if err != nil {
+		return "", err
+	}
+	absoluteReference, err := base.Inherits(jsonReference)
+	if err != nil {
+		return "", err
+	}
+
+	if documentUrl(*absoluteReference) == b.rootUrl {
+		return "#" + absoluteReference.GetUrl().Fragment, nil
+	}
+
+	name, ok := b.names[absoluteReference.String()]
+	if !ok {
+		name = b.newDefinitionName(*absoluteReference)
+		// registered before bundling for recursive schemas
+		b.names[absoluteReference.String()] = name
+		b.definitions[name] = nil
+
+		spd, err := b.pool.GetPoolDocument(*absoluteReference)
+		if err != nil {
+			return "", err
+		}
+		schema, _, err := absoluteReference.GetPointer().Get(spd.Document)
+		if err != nil {
+			return "", err
+		}
+		bundled, err := b.bundle(schema, *absoluteReference)
+		if err != nil {
+			return "", err
+		}
+		b.definitions[name] = bundled
+	}
+
+	return "#/" + KEY_DEFINITIONS + "/" + escapeJsonPointerToken(name), nil
+}
+
+// newDefinitionName names an inlined schema after its document and fragment
+// ( ex: other.json#/definitions/Port => other_Port ), made unique with a suffix
+func (b *bundler) newDefinitionName(reference gojsonreference.JsonReference) string {
+
+	name := strings.TrimSuffix(path.Base(reference.GetUrl().Path), path.Ext(reference.GetUrl().Path))
+	if fragment := reference.GetUrl().Fragment; fragment != "" {
+		name += "_" + path.Base(fragment)
+	}
+
+	unique := name
+	for i := 2; ; i++ {
+		_, inlined := b.definitions[unique]
+		_, existing := b.existingDefinitions[unique]
+		if !inlined && !existing {
+			return unique
+		}
+		unique = name + "_" + strconv.Itoa(i)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema bundler.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBundle(t *testing.T) {
+
+	directory := t.TempDir()
+	files := map[string]string{
+		"main.json": `{
+			"definitions":{"Name":{"type":"string"}},
+			"properties":{
+				"name":{"$ref":"#/definitions/Name"},
+				"port":{"$ref":"other.json#/definitions/Port"},
+				"other":{"$ref":"other.json"},
+				"tree":{"$ref":"tree.json"},
+				"default":{"$ref":"other.json#/definitions/Port"}
+			}
+		}`,
+		"other.json": `{"definitions":{"Port":{"type":"integer","maximum":65535}},"properties":{"port":{"$ref":"#/definitions/Port"}}}`,
+		"tree.json":  `{"properties":{"children":{"items":{"$ref":"#"}}},"enum":[{"$ref":"not a reference"}]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Could not write %s : %s", name, err.Error())
+		}
+	}
+
+	bundle, err := Bundle("file://" + filepath.ToSlash(filepath.Join(directory, "main.json")))
+	if err != nil {
+		t.Fatalf("Could not bundle : %s", err.Error())
+	}
+
+	expected := `{"definitions":{"Name":{"type":"string"},"other":{"definitions":{"Port":{"maximum":65535,"type":"integer"}},"properties":{"port":{"$ref":"#/definitions/other_Port"}}},"other_Port":{"maximum":65535,"type":"integer"},"tree":{"enum":[{"$ref":"not a reference"}],"properties":{"children":{"items":{"$ref":"#/definitions/tree"}}}}},"properties":{"default":{"$ref":"#/definitions/other_Port"},"name":{"$ref":"#/definitions/Name"},"other":{"$ref":"#/definitions/other"},"port":{"$ref":"#/definitions/other_Port"},"tree":{"$ref":"#/definitions/tree"}}}`
+	if given := *mustMarshalToString(t, bundle); given != expected {
+		t.Errorf("Expects %s, given %s", expected, given)
+	}
+
+	// the bundle is a schema of its own
+	bundleFile := filepath.Join(directory, "bundle.json")
+	if err := os.WriteFile(bundleFile, []byte(expected), 0644); err != nil {
+		t.Fatalf("Could not write the bundle : %s", err.Error())
+	}
+	os.Remove(filepath.Join(directory, "other.json"))
+	schemaDocument, err := NewJsonSchemaDocument("file://" + filepath.ToSlash(bundleFile))
+	if err != nil {
+		t.Fatalf("Could not parse the bundle : %s", err.Error())
+	}
+	if result := schemaDocument.Validate(mustParseJson(t, `{"port":70000}`)); result.IsValid() {
+		t.Errorf("Expects the inlined schema to be validated")
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command line validation of json documents.
+//
+// created          16-10-2026
+
+// Command gojsonschema validates json documents against a json schema.
+//
+//	gojsonschema validate --schema schema.json doc1.json doc2.yaml
+//
+// It also bundles a schema and the schemas it references in a single document.
+//
+//	gojsonschema bundle --schema schema.json > bundle.json
+//
+// And generates the go types of a schema.
+//
+//	gojsonschema generate --schema schema.json --package models --validate > models.go
+//
+// And draws the graph of the references between schemas.
+//
+//	gojsonschema graph --schema schema.json | dot -Tsvg > references.svg
+//
+// Exits with 0 when all documents are valid, 1 when some are not valid and
+// 2 when the schema or a document cannot be loaded.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"github.com/sigu-399/gojsonschema/codegen"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	EXIT_VALID   = 0
+	EXIT_INVALID = 1
+	EXIT_ERROR   = 2
+)
+
+const usage = `usage: gojsonschema validate --schema <schema> [--output text|json|junit|tap] [--trace] <document>...
+       gojsonschema bundle --schema <schema>
+       gojsonschema generate --schema <schema> [--package <name>] [--type <name>] [--validate]
+       gojsonschema graph --schema <schema> [--output dot|json]
+
+Documents can be json, json with comments ( .jsonc, .json5 ), yaml or toml,
+- reads a json document from the standard input
+--trace writes every keyword evaluation to the standard error
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// documentResult is the validation of one document
+type documentResult struct {
+	name   string
+	result *gojsonschema.ValidationResult
+	err    error
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	if len(args) > 0 && args[0] == "bundle" {
+		return runBundle(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "generate" {
+		return runGenerate(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "graph" {
+		return runGraph(args[1:], stdout, stderr)
+	}
+	if len(args) == 0 || args[0] != "validate" {
+		fmt.Fprint(stderr, usage)
+		return EXIT_ERROR
+	}
+
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, usage) }
+	schemaPath := flags.String("schema", "", "path or url of the schema")
+	output := flags.String("output", "text", "output format : text, json, junit or tap")
+	trace := flags.Bool("trace", false, "write the keyword evaluations to the standard error")
+	if err := flags.Parse(args[1:]); err != nil {
+		return EXIT_ERROR
+	}
+	if *schemaPath == "" || flags.NArg() == 0 {
+		fmt.Fprint(stderr, usage)
+		return EXIT_ERROR
+	}
+	writer, ok := outputWriters[*output]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown output format %s\n", *output)
+		return EXIT_ERROR
+	}
+
+	schemaReference, err := toReference(*schemaPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s : %s\n", *schemaPath, err.Error())
+		return EXIT_ERROR
+	}
+	schema, err := gojsonschema.NewJsonSchemaDocument(schemaReference)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s : %s\n", *schemaPath, err.Error())
+		return EXIT_ERROR
+	}
+	schema.SetTrace(*trace)
+
+	var results []documentResult
+	for _, name := range flags.Args() {
+		document, err := loadDocument(name, stdin)
+		if err != nil {
+			results = append(results, documentResult{name: name, err: err})
+			continue
+		}
+		result := schema.Validate(document)
+		if *trace {
+			fmt.Fprintf(stderr, "%s :\n%s\n", name, result.GetTrace().String())
+		}
+		results = append(results, documentResult{name: name, result: result})
+	}
+
+	if err := writer(stdout, results); err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return EXIT_ERROR
+	}
+
+	return exitCode(results)
+}
+
+func runBundle(args []string, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("bundle", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, usage) }
+	schemaPath := flags.String("schema", "", "path or url of the schema")
+	if err := flags.Parse(args); err != nil {
+		return EXIT_ERROR
+	}
+	if *schemaPath == "" || flags.NArg() != 0 {
+		fmt.Fprint(stderr, usage)
+		return EXIT_ERROR
+	}
+
+	schemaReference, err := toReference(*schemaPath)
+	if err == nil {
+		var bundle map[string]interface{}
+		bundle, err = gojsonschema.Bundle(schemaReference)
+		if err == nil {
+			encoder := json.NewEncoder(stdout)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(bundle)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s : %s\n", *schemaPath, err.Error())
+		return EXIT_ERROR
+	}
+
+	return EXIT_VALID
+}
+
+func runGenerate(args []string, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, usage) }
+	schemaPath := flags.String("schema", "", "path or url of the schema")
+	packageName := flags.String("package", codegen.DEFAULT_PACKAGE, "package of the generated file")
+	typeName := flags.String("type", "", "name of the root type, the title of the schema by default")
+	validate := flags.Bool("validate", false, "adds a Validate method to the root type")
+	if err := flags.Parse(args); err != nil {
+		return EXIT_ERROR
+	}
+	if *schemaPath == "" || flags.NArg() != 0 {
+		fmt.Fprint(stderr, usage)
+		return EXIT_ERROR
+	}
+
+	schemaReference, err := toReference(*schemaPath)
+	if err == nil {
+		var schema *gojsonschema.JsonSchemaDocument
+		schema, err = gojsonschema.NewJsonSchemaDocument(schemaReference)
+		if err == nil {
+			var source []byte
+			source, err = codegen.Generate(schema, codegen.Options{Package: *packageName, TypeName: *typeName, Validate: *validate})
+			if err == nil {
+				_, err = stdout.Write(source)
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s : %s\n", *schemaPath, err.Error())
+		return EXIT_ERROR
+	}
+
+	return EXIT_VALID
+}
+
+func runGraph(args []string, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("graph", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, usage) }
+	schemaPath := flags.String("schema", "", "path or url of the schema")
+	output := flags.String("output", "dot", "output format : dot or json")
+	if err := flags.Parse(args); err != nil {
+		return EXIT_ERROR
+	}
+	if *schemaPath == "" || flags.NArg() != 0 || (*output != "dot" && *output != "json") {
+		fmt.Fprint(stderr, usage)
+		return EXIT_ERROR
+	}
+
+	schemaReference, err := toReference(*schemaPath)
+	if err == nil {
+		var schema *gojsonschema.JsonSchemaDocument
+		schema, err = gojsonschema.NewJsonSchemaDocument(schemaReference)
+		if err == nil {
+			graph := schema.ReferenceGraph()
+			if *output == "dot" {
+				_, err = io.WriteString(stdout, graph.Dot())
+			} else {
+				encoder := json.NewEncoder(stdout)
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(graph)
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s : %s\n", *schemaPath, err.Error())
+		return EXIT_ERROR
+	}
+
+	return EXIT_VALID
+}
+
+// toReference turns a schema path into a reference the schema pool can load
+func toReference(path string) (string, error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "file://") {
+		return path, nil
+	}
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return "file://" + filepath.ToSlash(absolutePath), nil
+}
+
+// loadDocument loads a document with the loader matching its extension
+func loadDocument(name string, stdin io.Reader) (interface{}, error) {
+
+	if name == "-" {
+		content, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		return gojsonschema.GetJsoncDocument(content)
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return gojsonschema.GetFileYaml(name)
+	case ".toml":
+		return gojsonschema.GetFileToml(name)
+	case ".jsonc", ".json5":
+		return gojsonschema.GetFileJsonc(name)
+	case ".json", "":
+		return gojsonschema.GetFileJson(name)
+	}
+
+	return nil, errors.New(fmt.Sprintf("unknown document extension %s", filepath.Ext(name)))
+}
+
+func exitCode(results []documentResult) int {
+	code := EXIT_VALID
+	for _, documentResult := range results {
+		if documentResult.err != nil {
+			return EXIT_ERROR
+		}
+		if !documentResult.result.IsValid() {
+			code = EXIT_INVALID
+		}
+	}
+	return code
+}
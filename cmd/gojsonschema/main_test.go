@@ -0,0 +1,149 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the command line.
+//
+// created          16-10-2026
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Could not write %s : %s", name, err.Error())
+		}
+	}
+	return directory
+}
+
+func TestValidateCommand(t *testing.T) {
+
+	directory := writeFiles(t, map[string]string{
+		"schema.json":  `{"required":["name"]}`,
+		"valid.json":   `{"name":"x"}`,
+		"invalid.yaml": "other: 1\n",
+		"broken.json":  `{`,
+	})
+
+	tests := []struct {
+		args         []string
+		stdin        string
+		expectedCode int
+		expectedOut  string
+	}{
+		{[]string{"valid.json"}, "", EXIT_VALID, "valid.json : valid\n"},
+		{[]string{"valid.json", "invalid.yaml"}, "", EXIT_INVALID, "valid.json : valid\ninvalid.yaml : not valid\n  - ROOT : name property is required\n"},
+		{[]string{"broken.json", "invalid.yaml"}, "", EXIT_ERROR, "broken.json : error : "},
+		{[]string{"-"}, `{"name":"stdin"}`, EXIT_VALID, "- : valid\n"},
+	}
+
+	for _, test := range tests {
+		args := []string{"validate", "--schema", filepath.Join(directory, "schema.json")}
+		for _, arg := range test.args {
+			if arg != "-" {
+				arg = filepath.Join(directory, arg)
+			}
+			args = append(args, arg)
+		}
+
+		var stdout, stderr bytes.Buffer
+		code := run(args, strings.NewReader(test.stdin), &stdout, &stderr)
+		if code != test.expectedCode {
+			t.Errorf("Expects exit code %d for %v, given %d", test.expectedCode, test.args, code)
+		}
+		if given := strings.Replace(stdout.String(), directory+string(filepath.Separator), "", -1); !strings.HasPrefix(given, test.expectedOut) {
+			t.Errorf("Expects output %q for %v, given %q", test.expectedOut, test.args, given)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"validate", "doc.json"}, nil, &stdout, &stderr); code != EXIT_ERROR || !strings.HasPrefix(stderr.String(), "usage") {
+		t.Errorf("Expects the usage without schema, given %d %q", code, stderr.String())
+	}
+}
+
+func TestGenerateCommand(t *testing.T) {
+
+	directory := writeFiles(t, map[string]string{
+		"schema.json": `{"title":"user","properties":{"name":{"type":"string"}},"required":["name"]}`,
+	})
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"generate", "--schema", filepath.Join(directory, "schema.json"), "--package", "models"}, nil, &stdout, &stderr)
+	if code != EXIT_VALID {
+		t.Fatalf("Expects exit code %d, given %d : %s", EXIT_VALID, code, stderr.String())
+	}
+	for _, expected := range []string{"package models\n", "type User struct {\n\tName string `json:\"name\"`\n}"} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("Expects the output to contain %q, given %q", expected, stdout.String())
+		}
+	}
+
+	if code := run([]string{"generate", "--schema", filepath.Join(directory, "missing.json")}, nil, &stdout, &stderr); code != EXIT_ERROR {
+		t.Errorf("Expects exit code %d for a missing schema, given %d", EXIT_ERROR, code)
+	}
+}
+
+func TestGraphCommand(t *testing.T) {
+
+	directory := writeFiles(t, map[string]string{
+		"schema.json": `{"definitions":{"a":{}},"properties":{"a":{"$ref":"#/definitions/a"}}}`,
+	})
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"graph", "--schema", filepath.Join(directory, "schema.json"), "--output", "json"}, nil, &stdout, &stderr)
+	if code != EXIT_VALID {
+		t.Fatalf("Expects exit code %d, given %d : %s", EXIT_VALID, code, stderr.String())
+	}
+	if given := stdout.String(); !strings.Contains(given, "schema.json#\": [\n") || !strings.Contains(given, "schema.json#/definitions/a\"\n") {
+		t.Errorf("Expects the adjacency list of the schema, given %s", given)
+	}
+
+	if code := run([]string{"graph", "--schema", filepath.Join(directory, "schema.json"), "--output", "svg"}, nil, &stdout, &stderr); code != EXIT_ERROR {
+		t.Errorf("Expects exit code %d for an unknown output, given %d", EXIT_ERROR, code)
+	}
+}
+
+func TestValidateTrace(t *testing.T) {
+
+	directory := writeFiles(t, map[string]string{
+		"schema.json": `{"properties":{"age":{"minimum":0}}}`,
+		"doc.json":    `{"age":-1}`,
+	})
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"validate", "--schema", filepath.Join(directory, "schema.json"), "--trace", filepath.Join(directory, "doc.json")}, nil, &stdout, &stderr)
+	if code != EXIT_INVALID {
+		t.Fatalf("Expects exit code %d, given %d : %s", EXIT_INVALID, code, stderr.String())
+	}
+	if given := stderr.String(); !strings.Contains(given, "ROOT.age ") || !strings.Contains(given, "schema.json#/properties/age/minimum invalid") {
+		t.Errorf("Expects the trace of the validation, given %s", given)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Output formats of the command line.
+//
+// created          16-10-2026
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// outputWriters write the validation results in each output format
+var outputWriters = map[string]func(w io.Writer, results []documentResult) error{
+	"text":  writeText,
+	"json":  writeJson,
+	"junit": writeJunit,
+	"tap":   writeTap,
+}
+
+// writeText lists the documents and their errors
+func writeText(w io.Writer, results []documentResult) error {
+	for _, documentResult := range results {
+		switch {
+		case documentResult.err != nil:
+			fmt.Fprintf(w, "%s : error : %s\n", documentResult.name, documentResult.err.Error())
+		case documentResult.result.IsValid():
+			fmt.Fprintf(w, "%s : valid\n", documentResult.name)
+		default:
+			fmt.Fprintf(w, "%s : not valid\n", documentResult.name)
+			for _, message := range documentResult.result.GetErrorMessages() {
+				fmt.Fprintf(w, "  - %s\n", message)
+			}
+		}
+	}
+	return nil
+}
+
+type jsonDocumentResult struct {
+	File   string            `json:"file"`
+	Valid  bool              `json:"valid"`
+	Error  string            `json:"error,omitempty"`
+	Errors []jsonResultError `json:"errors,omitempty"`
+}
+
+type jsonResultError struct {
+	Field       string `json:"field"`
+	Keyword     string `json:"keyword"`
+	Description string `json:"description"`
+	Suggestion  string `json:"suggestion,omitempty"`
+}
+
+// writeJson writes the results as a json array
+func writeJson(w io.Writer, results []documentResult) error {
+
+	jsonResults := []jsonDocumentResult{}
+	for _, documentResult := range results {
+		jsonResult := jsonDocumentResult{File: documentResult.name}
+		if documentResult.err != nil {
+			jsonResult.Error = documentResult.err.Error()
+		} else {
+			jsonResult.Valid = documentResult.result.IsValid()
+			for _, resultError := range documentResult.result.GetErrors() {
+				jsonResult.Errors = append(jsonResult.Errors, jsonResultError{Field: resultError.Context, Keyword: resultError.Keyword, Description: resultError.Description, Suggestion: resultError.Suggestion})
+			}
+		}
+		jsonResults = append(jsonResults, jsonResult)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonResults)
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+// writeJunit writes the results as a junit report, one test case per document
+func writeJunit(w io.Writer, results []documentResult) error {
+
+	testSuite := junitTestSuite{Name: "gojsonschema", Tests: len(results)}
+	for _, documentResult := range results {
+		testCase := junitTestCase{Name: documentResult.name, ClassName: "gojsonschema"}
+		if documentResult.err != nil {
+			testSuite.Errors++
+			testCase.Error = &junitMessage{Message: documentResult.err.Error()}
+		} else if !documentResult.result.IsValid() {
+			testSuite.Failures++
+			messages := documentResult.result.GetErrorMessages()
+			testCase.Failure = &junitMessage{Message: fmt.Sprintf("%d validation errors", len(messages)), Content: strings.Join(messages, "\n")}
+		}
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(testSuite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// writeTap writes the results in the Test Anything Protocol, with the errors
+// of a document in a yaml block
+func writeTap(w io.Writer, results []documentResult) error {
+
+	fmt.Fprintf(w, "TAP version 13\n1..%d\n", len(results))
+	for i, documentResult := range results {
+		switch {
+		case documentResult.err != nil:
+			fmt.Fprintf(w, "not ok %d - %s\n  ---\n  error: %q\n  ...\n", i+1, documentResult.name, documentResult.err.Error())
+		case documentResult.result.IsValid():
+			fmt.Fprintf(w, "ok %d - %s\n", i+1, documentResult.name)
+		default:
+			fmt.Fprintf(w, "not ok %d - %s\n  ---\n  errors:\n", i+1, documentResult.name)
+			for _, message := range documentResult.result.GetErrorMessages() {
+				fmt.Fprintf(w, "    - %q\n", message)
+			}
+			fmt.Fprint(w, "  ...\n")
+		}
+	}
+	return nil
+}
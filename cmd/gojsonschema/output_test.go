@@ -0,0 +1,120 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the output formats of the command line.
+//
+// created          16-10-2026
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/sigu-399/gojsonschema"
+	"strings"
+	"testing"
+)
+
+func TestOutputFormats(t *testing.T) {
+
+	var schemaNode interface{} = map[string]interface{}{"required": []interface{}{"name"}}
+	schema, err := gojsonschema.NewJsonSchemaDocument(schemaNode)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	results := []documentResult{
+		{name: "valid.json", result: schema.Validate(map[string]interface{}{"name": "x"})},
+		{name: "invalid.json", result: schema.Validate(map[string]interface{}{})},
+		{name: "broken.json", err: errors.New("unexpected end of JSON input")},
+	}
+
+	expected := map[string]string{
+		"text": `valid.json : valid
+invalid.json : not valid
+  - ROOT : name property is required
+broken.json : error : unexpected end of JSON input
+`,
+		"json": `[
+  {
+    "file": "valid.json",
+    "valid": true
+  },
+  {
+    "file": "invalid.json",
+    "valid": false,
+    "errors": [
+      {
+        "field": "ROOT",
+        "keyword": "required",
+        "description": "name property is required"
+      }
+    ]
+  },
+  {
+    "file": "broken.json",
+    "valid": false,
+    "error": "unexpected end of JSON input"
+  }
+]
+`,
+		"junit": `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="gojsonschema" tests="3" failures="1" errors="1">
+  <testcase name="valid.json" classname="gojsonschema"></testcase>
+  <testcase name="invalid.json" classname="gojsonschema">
+    <failure message="1 validation errors">ROOT : name property is required</failure>
+  </testcase>
+  <testcase name="broken.json" classname="gojsonschema">
+    <error message="unexpected end of JSON input"></error>
+  </testcase>
+</testsuite>
+`,
+		"tap": `TAP version 13
+1..3
+ok 1 - valid.json
+not ok 2 - invalid.json
+  ---
+  errors:
+    - "ROOT : name property is required"
+  ...
+not ok 3 - broken.json
+  ---
+  error: "unexpected end of JSON input"
+  ...
+`,
+	}
+
+	for format, writer := range outputWriters {
+		var output bytes.Buffer
+		if err := writer(&output, results); err != nil {
+			t.Errorf("Could not write %s : %s", format, err.Error())
+			continue
+		}
+		if output.String() != expected[format] {
+			t.Errorf("Expects %s output :\n%s\ngiven :\n%s", format, expected[format], output.String())
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"validate", "--schema", "schema.json", "--output", "xml", "doc.json"}, nil, &stdout, &stderr); code != EXIT_ERROR || !strings.Contains(stderr.String(), "unknown output format") {
+		t.Errorf("Expects an unknown output format to be an error, given %d %q", code, stderr.String())
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generates go types from schemas.
+//
+// created          16-10-2026
+
+// Package codegen generates go types from a json schema
+//
+// Objects become structs with json tags, string enums become typed constants
+// and the schemas referenced by $ref become named types.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	DEFAULT_PACKAGE   = "main"
+	DEFAULT_TYPE_NAME = "Root"
+)
+
+type Options struct {
+	// Package of the generated file, DEFAULT_PACKAGE when empty
+	Package string
+	// TypeName of the root schema, its title or DEFAULT_TYPE_NAME when empty
+	TypeName string
+	// Validate adds a Validate method to the root type, validating values against the schema
+	Validate bool
+}
+
+// Generate returns the formatted go source of the types of the schema
+func Generate(schema *gojsonschema.JsonSchemaDocument, options Options) ([]byte, error) {
+
+	packageName := options.Package
+	if packageName == "" {
+		packageName = DEFAULT_PACKAGE
+	}
+
+	root := schema.Root()
+	typeName := options.TypeName
+	if typeName == "" {
+		typeName = exportedName(root.Resolve().Title())
+	}
+	if typeName == "" {
+		typeName = DEFAULT_TYPE_NAME
+	}
+
+	g := generator{names: make(map[string]bool), refs: make(map[string]string), structs: make(map[string]bool)}
+	g.names[typeName] = true
+	// a $ref to the root schema, ex: #, reuses the root type
+	resolved := root.Resolve()
+	g.refs[resolved.Document()+"#"+resolved.Pointer()] = typeName
+	g.declareNamed(resolved, typeName)
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by gojsonschema. DO NOT EDIT.\n\n")
+	source.WriteString("package " + packageName + "\n\n")
+
+	if options.Validate {
+		schemaJson, err := json.Marshal(schema)
+		if err != nil {
+			return nil, err
+		}
+		schemaVariable := unexportedName(typeName) + "Schema"
+		source.WriteString("import (\n\t\"encoding/json\"\n\t\"github.com/sigu-399/gojsonschema\"\n)\n\n")
+		source.WriteString(strings.Join(g.declarations, ""))
+		fmt.Fprintf(&source, "// %sJson is the schema the types were generated from\n", schemaVariable)
+		fmt.Fprintf(&source, "const %sJson = %s\n\n", schemaVariable, strconv.Quote(string(schemaJson)))
+		fmt.Fprintf(&source, `var %s = func() *gojsonschema.JsonSchemaDocument {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(%sJson), &document); err != nil {
+		panic(err)
+	}
+	schema, err := gojsonschema.NewJsonSchemaDocument(document)
+	if err != nil {
+		panic(err)
+	}
+	return schema
+}()
+
+// Validate validates the value against the schema it was generated from
+func (v %s) Validate() error {
+	return %s.Validate(v).AsError()
+}
+`, schemaVariable, schemaVariable, typeName, schemaVariable)
+	} else {
+		source.WriteString(strings.Join(g.declarations, ""))
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("generated source is not valid : %s", err.Error()))
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	// declarations in the order their types are found, the root type first
+	declarations []string
+
+	// type names already used
+	names map[string]bool
+	// reference of a schema => name of its type
+	refs map[string]string
+	// names of the struct types
+	structs map[string]bool
+}
+
+// goType returns the go type of a schema, name is the name of the type when one must be declared
+func (g *generator) goType(node *gojsonschema.SchemaNode, name string) string {
+
+	if reference := node.Reference(); reference != "" {
+		if typeName, ok := g.refs[reference]; ok {
+			return typeName
+		}
+		target := node.Resolve()
+		typeName := exportedName(lastPointerToken(target.Pointer()))
+		if typeName == "" {
+			typeName = name
+		}
+		typeName = g.uniqueName(typeName)
+		g.refs[reference] = typeName
+		g.declareNamed(target, typeName)
+		return typeName
+	}
+
+	types, nullable := nodeTypes(node)
+	if len(types) != 1 {
+		return "interface{}"
+	}
+
+	var goType string
+	switch types[0] {
+
+	case gojsonschema.TYPE_OBJECT:
+		if len(node.Properties()) > 0 {
+			goType = g.uniqueName(name)
+			g.declareStruct(node, goType)
+			break
+		}
+		goType = "map[string]interface{}"
+		if _, additionalProperties := node.AdditionalProperties(); additionalProperties != nil {
+			goType = "map[string]" + g.goType(additionalProperties, name+"Value")
+		}
+		return goType
+
+	case gojsonschema.TYPE_ARRAY:
+		items, tuple := node.Items()
+		if len(items) == 0 || tuple {
+			return "[]interface{}"
+		}
+		return "[]" + g.goType(items[0], name+"Item")
+
+	case gojsonschema.TYPE_STRING:
+		goType = "string"
+		if enum := node.Constraints().Enum; len(enum) > 0 && isStringEnum(enum) {
+			goType = g.uniqueName(name)
+			g.declareEnum(node, goType, enum)
+		}
+
+	case gojsonschema.TYPE_INTEGER:
+		goType = "int64"
+	case gojsonschema.TYPE_NUMBER:
+		goType = "float64"
+	case gojsonschema.TYPE_BOOLEAN:
+		goType = "bool"
+	default:
+		return "interface{}"
+	}
+
+	if nullable {
+		return "*" + goType
+	}
+	return goType
+}
+
+// declareNamed declares a type of the given name for a schema
+func (g *generator) declareNamed(node *gojsonschema.SchemaNode, name string) {
+
+	types, _ := nodeTypes(node)
+	if len(types) == 1 && types[0] == gojsonschema.TYPE_OBJECT && len(node.Properties()) > 0 {
+		g.declareStruct(node, name)
+		return
+	}
+	if enum := node.Constraints().Enum; len(types) == 1 && types[0] == gojsonschema.TYPE_STRING && len(enum) > 0 && isStringEnum(enum) {
+		g.declareEnum(node, name, enum)
+		return
+	}
+
+	declaration := g.reserveDeclaration()
+	goType := strings.TrimPrefix(g.goType(node, name+"Value"), "*")
+
+	var w bytes.Buffer
+	writeComment(&w, node, "")
+	fmt.Fprintf(&w, "type %s %s\n\n", name, goType)
+	g.declarations[declaration] = w.String()
+}
+
+func (g *generator) declareStruct(node *gojsonschema.SchemaNode, name string) {
+
+	g.structs[name] = true
+	declaration := g.reserveDeclaration()
+
+	properties := node.Properties()
+	var propertyNames []string
+	for propertyName := range properties {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	sort.Strings(propertyNames)
+
+	required := node.Constraints().Required
+
+	fieldNames := make(map[string]bool)
+	var fields bytes.Buffer
+	for _, propertyName := range propertyNames {
+		property := properties[propertyName]
+
+		fieldName := exportedName(propertyName)
+		if fieldName == "" {
+			fieldName = "Field"
+		}
+		for i := 2; fieldNames[fieldName]; i++ {
+			fieldName = exportedName(propertyName) + strconv.Itoa(i)
+		}
+		fieldNames[fieldName] = true
+
+		fieldType := g.goType(property, name+fieldName)
+		tag := propertyName
+		if !isStringInSlice(required, propertyName) {
+			tag += ",omitempty"
+			if g.structs[fieldType] {
+				fieldType = "*" + fieldType
+			}
+		}
+
+		writeComment(&fields, property, "\t")
+		fmt.Fprintf(&fields, "\t%s %s `json:%s`\n", fieldName, fieldType, strconv.Quote(tag))
+	}
+
+	var w bytes.Buffer
+	writeComment(&w, node, "")
+	fmt.Fprintf(&w, "type %s struct {\n%s}\n\n", name, fields.String())
+	g.declarations[declaration] = w.String()
+}
+
+func (g *generator) declareEnum(node *gojsonschema.SchemaNode, name string, enum []interface{}) {
+
+	var w bytes.Buffer
+	writeComment(&w, node, "")
+	fmt.Fprintf(&w, "type %s string\n\nconst (\n", name)
+
+	for _, value := range enum {
+		constantName := g.uniqueName(name + exportedName(value.(string)))
+		fmt.Fprintf(&w, "\t%s %s = %s\n", constantName, name, strconv.Quote(value.(string)))
+	}
+	w.WriteString(")\n\n")
+
+	g.declarations = append(g.declarations, w.String())
+}
+
+// writeComment writes the title and description of a schema as a doc comment
+func writeComment(w *bytes.Buffer, node *gojsonschema.SchemaNode, indent string) {
+	comment := strings.TrimSpace(strings.Join([]string{node.Title(), node.Description()}, "\n"))
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(comment, "\n") {
+		fmt.Fprintf(w, "%s// %s\n", indent, line)
+	}
+}
+
+// reserveDeclaration reserves the place of a declaration before the types it uses are declared
+func (g *generator) reserveDeclaration() int {
+	g.declarations = append(g.declarations, "")
+	return len(g.declarations) - 1
+}
+
+// uniqueName returns name, suffixed with a number when already used
+func (g *generator) uniqueName(name string) string {
+	unique := name
+	for i := 2; g.names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	g.names[unique] = true
+	return unique
+}
+
+// nodeTypes returns the types of a schema without null, and whether null is allowed
+// Types are inferred from the keywords of schemas without type
+func nodeTypes(node *gojsonschema.SchemaNode) (types []string, nullable bool) {
+
+	for _, t := range node.Types() {
+		if t == gojsonschema.TYPE_NULL {
+			nullable = true
+		} else {
+			types = append(types, t)
+		}
+	}
+	if len(node.Types()) > 0 {
+		return types, nullable
+	}
+
+	if len(node.Properties()) > 0 {
+		return []string{gojsonschema.TYPE_OBJECT}, false
+	}
+	if items, _ := node.Items(); len(items) > 0 {
+		return []string{gojsonschema.TYPE_ARRAY}, false
+	}
+	if enum := node.Constraints().Enum; len(enum) > 0 && isStringEnum(enum) {
+		return []string{gojsonschema.TYPE_STRING}, false
+	}
+	return nil, false
+}
+
+func isStringEnum(enum []interface{}) bool {
+	for _, value := range enum {
+		if _, ok := value.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// exportedName turns a json name into an exported go identifier : first_name => FirstName
+func exportedName(s string) string {
+
+	var name strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if name.Len() == 0 && unicode.IsDigit(r) {
+			name.WriteRune('N')
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		name.WriteRune(r)
+	}
+	return name.String()
+}
+
+func unexportedName(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func lastPointerToken(pointer string) string {
+	token := pointer[strings.LastIndex(pointer, "/")+1:]
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
+func isStringInSlice(s []string, what string) bool {
+	for i := range s {
+		if s[i] == what {
+			return true
+		}
+	}
+	return false
+}
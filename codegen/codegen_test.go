@@ -0,0 +1,179 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the generation of go types.
+//
+// created          16-10-2026
+
+package codegen
+
+import (
+	"encoding/json"
+	"github.com/sigu-399/gojsonschema"
+	"strings"
+	"testing"
+)
+
+const codegenSchema = `{
+	"title":"pet",
+	"definitions":{
+		"color":{"enum":["red","light-blue"]},
+		"node":{"description":"A tree","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/node"}},"parent":{"$ref":"#/definitions/node"}}}
+	},
+	"type":"object",
+	"required":["name","id"],
+	"properties":{
+		"id":{"type":"integer"},
+		"name":{"type":"string","description":"Name of the pet"},
+		"color":{"$ref":"#/definitions/color"},
+		"size":{"enum":["small","large"]},
+		"weight":{"type":["number","null"]},
+		"tree":{"$ref":"#/definitions/node"},
+		"owner":{"type":"object","properties":{"first_name":{"type":"string"}}},
+		"labels":{"type":"object","additionalProperties":{"type":"string"}},
+		"any":{}
+	}
+}`
+
+func mustNewSchemaDocument(t *testing.T, s string) *gojsonschema.JsonSchemaDocument {
+	var document interface{}
+	if err := json.Unmarshal([]byte(s), &document); err != nil {
+		t.Fatalf("Could not parse json : %s", err.Error())
+	}
+	schemaDocument, err := gojsonschema.NewJsonSchemaDocument(document)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	return schemaDocument
+}
+
+func TestGenerate(t *testing.T) {
+
+	source, err := Generate(mustNewSchemaDocument(t, codegenSchema), Options{Package: "pets"})
+	if err != nil {
+		t.Fatalf("Could not generate : %s", err.Error())
+	}
+
+	// backquotes of the struct tags are written as quotes
+	expected := strings.Replace(`// Code generated by gojsonschema. DO NOT EDIT.
+
+package pets
+
+// pet
+type Pet struct {
+	Any    interface{}       'json:"any,omitempty"'
+	Color  Color             'json:"color,omitempty"'
+	Id     int64             'json:"id"'
+	Labels map[string]string 'json:"labels,omitempty"'
+	// Name of the pet
+	Name   string    'json:"name"'
+	Owner  *PetOwner 'json:"owner,omitempty"'
+	Size   PetSize   'json:"size,omitempty"'
+	Tree   *Node     'json:"tree,omitempty"'
+	Weight *float64  'json:"weight,omitempty"'
+}
+
+type Color string
+
+const (
+	ColorRed       Color = "red"
+	ColorLightBlue Color = "light-blue"
+)
+
+type PetOwner struct {
+	FirstName string 'json:"first_name,omitempty"'
+}
+
+type PetSize string
+
+const (
+	PetSizeSmall PetSize = "small"
+	PetSizeLarge PetSize = "large"
+)
+
+// A tree
+type Node struct {
+	Children []Node 'json:"children,omitempty"'
+	Parent   *Node  'json:"parent,omitempty"'
+}
+`, "'", "`", -1)
+	if string(source) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(source))
+	}
+}
+
+func TestGenerateRecursiveRoot(t *testing.T) {
+
+	schema := `{"title":"node","type":"object","properties":{"next":{"$ref":"#"},"children":{"type":"array","items":{"$ref":"#"}}}}`
+	source, err := Generate(mustNewSchemaDocument(t, schema), Options{Package: "lists"})
+	if err != nil {
+		t.Fatalf("Could not generate : %s", err.Error())
+	}
+
+	expected := strings.Replace(`// Code generated by gojsonschema. DO NOT EDIT.
+
+package lists
+
+// node
+type Node struct {
+	Children []Node 'json:"children,omitempty"'
+	Next     *Node  'json:"next,omitempty"'
+}
+`, "'", "`", -1)
+	if string(source) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(source))
+	}
+}
+
+func TestGenerateValidate(t *testing.T) {
+
+	source, err := Generate(mustNewSchemaDocument(t, codegenSchema), Options{Package: "pets", TypeName: "Animal", Validate: true})
+	if err != nil {
+		t.Fatalf("Could not generate : %s", err.Error())
+	}
+
+	for _, expected := range []string{
+		"type Animal struct {",
+		"const animalSchemaJson = ",
+		"func (v Animal) Validate() error {\n\treturn animalSchema.Validate(v).AsError()\n}",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("Expects the source to contain %s, given :\n%s", expected, string(source))
+		}
+	}
+
+	// the embedded schema compiles back to an equivalent schema
+	start := strings.Index(string(source), "animalSchemaJson = ") + len("animalSchemaJson = ")
+	var schemaJson string
+	if err := json.Unmarshal([]byte(string(source)[start:strings.Index(string(source)[start:], "\n")+start]), &schemaJson); err != nil {
+		t.Fatalf("Could not read the embedded schema : %s", err.Error())
+	}
+	mustNewSchemaDocument(t, schemaJson)
+}
+
+func TestExportedName(t *testing.T) {
+	tests := map[string]string{"first_name": "FirstName", "light-blue": "LightBlue", "2fa": "N2fa", "id": "Id", "": ""}
+	for name, expected := range tests {
+		if given := exportedName(name); given != expected {
+			t.Errorf("Expects %s for %s, given %s", expected, name, given)
+		}
+	}
+}
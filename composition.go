@@ -0,0 +1,79 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Combination of compiled schema documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"github.com/sigu-399/gojsonreference"
+)
+
+// AllOfDocuments combines compiled schema documents into one that validates a
+// document when all of them do, as an allOf of their root schemas
+// The schemas are shared, not copied, the format checkers added to the documents
+// are kept, their other validation options are not : they must be set on the
+// combined document
+func AllOfDocuments(documents ...*JsonSchemaDocument) (*JsonSchemaDocument, error) {
+	return combineDocuments(documents, (*jsonSchema).AddAllOf)
+}
+
+// AnyOfDocuments combines compiled schema documents into one that validates a
+// document when at least one of them does, as an anyOf of their root schemas,
+// see AllOfDocuments
+func AnyOfDocuments(documents ...*JsonSchemaDocument) (*JsonSchemaDocument, error) {
+	return combineDocuments(documents, (*jsonSchema).AddAnyOf)
+}
+
+func combineDocuments(documents []*JsonSchemaDocument, add func(root *jsonSchema, schema *jsonSchema)) (*JsonSchemaDocument, error) {
+	if len(documents) == 0 {
+		return nil, errors.New("no schema document to combine")
+	}
+
+	documentReference, err := gojsonreference.NewJsonReference("#")
+	if err != nil {
+		return nil, err
+	}
+	d := &JsonSchemaDocument{
+		documentReference: documentReference,
+		rootSchema:        &jsonSchema{property: ROOT_SCHEMA_PROPERTY},
+		pool:              newSchemaPool(),
+		referencePool:     newSchemaReferencePool(),
+	}
+
+	formats := map[string]bool{}
+	for _, document := range documents {
+		add(d.rootSchema, document.rootSchema)
+		for _, format := range document.formats {
+			if !formats[format] {
+				formats[format] = true
+				d.formats = append(d.formats, format)
+			}
+		}
+		for name, checker := range document.getOptions().formatCheckers {
+			d.AddFormatChecker(name, checker)
+		}
+	}
+	return d, nil
+}
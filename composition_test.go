@@ -0,0 +1,78 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the combinations of schema documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCombineDocuments(t *testing.T) {
+
+	base := mustNewSchemaDocument(t, `{"type":"object","required":["id"]}`)
+	policy := mustNewSchemaDocument(t, `{"properties":{"owner":{"format":"team"}}}`)
+	policy.AddFormatChecker("team", FormatCheckerFunc(func(input string) error {
+		if input != "platform" {
+			return errors.New("unknown team")
+		}
+		return nil
+	}))
+
+	allOf, err := AllOfDocuments(base, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`{"id":1,"owner":"platform"}`, true},
+		{`{"owner":"platform"}`, false},
+		{`{"id":1,"owner":"web"}`, false},
+	}
+	for _, test := range tests {
+		if result := allOf.Validate(mustParseJson(t, test.document)); result.IsValid() != test.valid {
+			t.Errorf("Expects %s to be valid %v, given %v", test.document, test.valid, result.GetErrors())
+		}
+	}
+
+	result := allOf.Validate(mustParseJson(t, `{"id":1,"owner":"web"}`))
+	if result.Child(KEY_ALL_OF, "1") == nil || result.Child(KEY_ALL_OF, "0") != nil {
+		t.Errorf("Expects only the second document to fail, given %v", result.Children())
+	}
+
+	anyOf, err := AnyOfDocuments(mustNewSchemaDocument(t, `{"type":"string"}`), mustNewSchemaDocument(t, `{"type":"integer"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !anyOf.Validate(mustParseJson(t, `1`)).IsValid() || anyOf.Validate(mustParseJson(t, `true`)).IsValid() {
+		t.Errorf("Expects a document to be valid when it validates any of the documents")
+	}
+
+	if _, err := AllOfDocuments(); err == nil {
+		t.Errorf("Expects an error when there is no document to combine")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the concurrent use of a schema document, run with -race.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentValidate(t *testing.T) {
+
+	var schema map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"definitions":{"item":{"type":"object","properties":{"id":{"type":"integer","minimum":1},"tag":{"type":"string","format":"tag","default":"none"}},"required":["id"]}},
+		"type":"array",
+		"items":{"$ref":"#/definitions/item"},
+		"maxItems":3
+	}`), &schema)
+
+	schemaDocument, err := NewJsonSchemaDocument(schema)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	schemaDocument.AddFormatChecker("tag", FormatCheckerFunc(func(input string) error {
+		if input == "" {
+			return errors.New("empty tag")
+		}
+		return nil
+	}))
+
+	var wait sync.WaitGroup
+	for i := 0; i != 8; i++ {
+		wait.Add(1)
+		go func(i int) {
+			defer wait.Done()
+			for j := 0; j != 100; j++ {
+				var document interface{}
+				json.Unmarshal([]byte(`[{"id":`+strconv.Itoa(i+j%2)+`},{"id":2,"tag":""}]`), &document)
+
+				result := schemaDocument.Validate(document)
+				expected := 1
+				if i+j%2 == 0 {
+					expected = 2
+				}
+				if len(result.GetErrors()) != expected {
+					t.Errorf("Expects %d errors, given %v", expected, result.GetErrorMessages())
+					return
+				}
+				schemaDocument.ValidateAndSanitize(document)
+				schemaDocument.ValidateAt("/definitions/item", map[string]interface{}{"id": 1.0})
+			}
+		}(i)
+	}
+
+	// options changed while validating
+	wait.Add(1)
+	go func() {
+		defer wait.Done()
+		for j := 0; j != 100; j++ {
+			schemaDocument.SetUseDefaults(j%2 == 0)
+			schemaDocument.SetVerboseBranchErrors(j%2 == 0)
+			schemaDocument.AddFormatChecker("other", FormatCheckerFunc(func(input string) error { return nil }))
+			AddFormatChecker("concurrent", FormatCheckerFunc(func(input string) error { return nil }))
+		}
+		RemoveFormatChecker("concurrent")
+	}()
+
+	wait.Wait()
+}
@@ -0,0 +1,432 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generates documentation from schemas.
+//
+// created          16-10-2026
+
+// Package docgen generates the reference documentation of a json schema
+//
+// Every object schema gets a section with a table of its properties : types,
+// constraints, descriptions and defaults. The schemas referenced by $ref get
+// their own section, linked from the properties using them.
+package docgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"html/template"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const DEFAULT_TITLE = "Schema"
+
+type Options struct {
+	// Title of the documentation, the title of the schema or DEFAULT_TITLE when empty
+	Title string
+	// Example adds a random document matching the schema to the root section
+	Example bool
+}
+
+// Markdown returns the documentation of the schema as markdown
+func Markdown(schema *gojsonschema.JsonSchemaDocument, options Options) ([]byte, error) {
+
+	sections, err := newSections(schema, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var w bytes.Buffer
+	for i, section := range sections {
+		heading := "##"
+		if i == 0 {
+			heading = "#"
+		}
+		fmt.Fprintf(&w, "%s %s\n\n", heading, section.Title)
+		if section.Description != "" {
+			fmt.Fprintf(&w, "%s\n\n", section.Description)
+		}
+		if len(section.Rows) == 0 {
+			fmt.Fprintf(&w, "Type : %s\n\n", markdownType(section.Type, section.Link))
+		} else {
+			w.WriteString("| Property | Type | Required | Constraints | Description |\n")
+			w.WriteString("| --- | --- | --- | --- | --- |\n")
+			for _, row := range section.Rows {
+				required := ""
+				if row.Required {
+					required = "yes"
+				}
+				fmt.Fprintf(&w, "| `%s` | %s | %s | %s | %s |\n",
+					markdownCell(row.Name), markdownType(row.Type, row.Link), required,
+					markdownCell(strings.Join(row.Constraints, ", ")), markdownCell(row.Description))
+			}
+			w.WriteString("\n")
+		}
+		if section.Example != "" {
+			fmt.Fprintf(&w, "Example :\n\n```json\n%s\n```\n\n", section.Example)
+		}
+	}
+
+	return bytes.TrimRight(w.Bytes(), "\n"), nil
+}
+
+// Html returns the documentation of the schema as an html fragment, one section per schema
+func Html(schema *gojsonschema.JsonSchemaDocument, options Options) ([]byte, error) {
+
+	sections, err := newSections(schema, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var w bytes.Buffer
+	if err := htmlTemplate.Execute(&w, sections); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+var htmlTemplate = template.Must(template.New("docgen").Parse(`{{range $i, $section := .}}<section id="{{.Anchor}}">
+{{if eq $i 0}}<h1>{{.Title}}</h1>{{else}}<h2>{{.Title}}</h2>{{end}}
+{{if .Description}}<p>{{.Description}}</p>
+{{end}}{{if .Rows}}<table>
+<thead><tr><th>Property</th><th>Type</th><th>Required</th><th>Constraints</th><th>Description</th></tr></thead>
+<tbody>
+{{range .Rows}}<tr><td><code>{{.Name}}</code></td><td>{{if .Link}}<a href="#{{.Link}}">{{.Type}}</a>{{else}}{{.Type}}{{end}}</td><td>{{if .Required}}yes{{end}}</td><td>{{range $j, $c := .Constraints}}{{if $j}}, {{end}}{{$c}}{{end}}</td><td>{{.Description}}</td></tr>
+{{end}}</tbody>
+</table>
+{{else}}<p>Type : {{if .Link}}<a href="#{{.Link}}">{{.Type}}</a>{{else}}{{.Type}}{{end}}</p>
+{{end}}{{if .Example}}<pre><code>{{.Example}}</code></pre>
+{{end}}</section>
+{{end}}`))
+
+// section documents an object schema, or the root schema whatever its type
+type section struct {
+	Title       string
+	Anchor      string
+	Description string
+	// type of a schema without properties
+	Type string
+	Link string
+	Rows []row
+	// json of a random document matching the schema
+	Example string
+}
+
+type row struct {
+	Name string
+	Type string
+	// anchor of the section documenting the type
+	Link        string
+	Required    bool
+	Constraints []string
+	Description string
+}
+
+type documenter struct {
+	sections []*section
+	// reference of a schema => its section
+	refs    map[string]*section
+	anchors map[string]bool
+}
+
+func newSections(schema *gojsonschema.JsonSchemaDocument, options Options) ([]*section, error) {
+
+	root := schema.Root()
+	title := options.Title
+	if title == "" {
+		title = root.Resolve().Title()
+	}
+	if title == "" {
+		title = DEFAULT_TITLE
+	}
+
+	d := documenter{refs: make(map[string]*section), anchors: make(map[string]bool)}
+	rootSection := d.newSection(root.Resolve(), title)
+	if reference := root.Reference(); reference != "" {
+		d.refs[reference] = rootSection
+	}
+	d.document(root.Resolve(), rootSection)
+
+	if options.Example {
+		example, err := schema.Generate(rand.New(rand.NewSource(1)))
+		if err != nil {
+			return nil, err
+		}
+		exampleJson, err := json.MarshalIndent(example, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		rootSection.Example = string(exampleJson)
+	}
+
+	return d.sections, nil
+}
+
+func (d *documenter) newSection(node *gojsonschema.SchemaNode, title string) *section {
+	s := &section{Title: title, Anchor: d.uniqueAnchor(title), Description: node.Description()}
+	d.sections = append(d.sections, s)
+	return s
+}
+
+// document fills the section of a schema
+func (d *documenter) document(node *gojsonschema.SchemaNode, s *section) {
+
+	properties := node.Properties()
+	if len(properties) == 0 {
+		s.Type, s.Link = d.typeOf(node, s.Title)
+		return
+	}
+
+	var names []string
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	required := node.Constraints().Required
+	for _, name := range names {
+		property := properties[name]
+		r := row{Name: name, Required: isStringInSlice(required, name)}
+		r.Type, r.Link = d.typeOf(property, s.Title+"."+name)
+		r.Constraints = constraints(property.Resolve())
+		r.Description = property.Resolve().Description()
+		if r.Description == "" {
+			r.Description = property.Resolve().Title()
+		}
+		s.Rows = append(s.Rows, r)
+	}
+}
+
+// typeOf returns the type of a schema and the anchor of the section documenting it,
+// name is the title of this section when one is added
+func (d *documenter) typeOf(node *gojsonschema.SchemaNode, name string) (string, string) {
+
+	if reference := node.Reference(); reference != "" {
+		s, ok := d.refs[reference]
+		if !ok {
+			target := node.Resolve()
+			title := target.Title()
+			if title == "" {
+				title = lastPointerToken(target.Pointer())
+			}
+			s = d.newSection(target, title)
+			d.refs[reference] = s
+			d.document(target, s)
+		}
+		return s.Title, s.Anchor
+	}
+
+	types := node.Types()
+	if len(types) == 0 && len(node.Properties()) > 0 {
+		types = []string{gojsonschema.TYPE_OBJECT}
+	}
+	if len(types) == 0 {
+		types = enumTypes(node.Constraints().Enum)
+	}
+
+	// objects with properties and arrays of a single schema get a more precise type
+	if len(types) == 1 {
+		switch types[0] {
+		case gojsonschema.TYPE_OBJECT:
+			if len(node.Properties()) > 0 {
+				title := node.Title()
+				if title == "" {
+					title = name
+				}
+				s := d.newSection(node, title)
+				d.document(node, s)
+				return s.Title, s.Anchor
+			}
+		case gojsonschema.TYPE_ARRAY:
+			if items, tuple := node.Items(); len(items) == 1 && !tuple {
+				itemType, link := d.typeOf(items[0], name+"[]")
+				return "array of " + itemType, link
+			}
+		}
+	}
+
+	var alternatives []*gojsonschema.SchemaNode
+	alternatives = append(alternatives, node.OneOf()...)
+	alternatives = append(alternatives, node.AnyOf()...)
+	if len(types) == 0 && len(alternatives) > 0 {
+		var alternativeTypes []string
+		var link string
+		for i, alternative := range alternatives {
+			alternativeType, alternativeLink := d.typeOf(alternative, name+"."+strconv.Itoa(i))
+			alternativeTypes = append(alternativeTypes, alternativeType)
+			if link == "" {
+				link = alternativeLink
+			}
+		}
+		return strings.Join(alternativeTypes, " or "), link
+	}
+
+	if len(types) == 0 {
+		return "any", ""
+	}
+	return strings.Join(types, " or "), ""
+}
+
+// constraints returns the validation keywords of a schema as readable strings
+func constraints(node *gojsonschema.SchemaNode) []string {
+
+	c := node.Constraints()
+	var list []string
+
+	bound := func(name string, value *float64, exclusive bool) {
+		if value != nil {
+			s := name + " : " + strconv.FormatFloat(*value, 'f', -1, 64)
+			if exclusive {
+				s += " ( exclusive )"
+			}
+			list = append(list, s)
+		}
+	}
+	count := func(name string, value *int) {
+		if value != nil {
+			list = append(list, name+" : "+strconv.Itoa(*value))
+		}
+	}
+
+	bound(gojsonschema.KEY_MINIMUM, c.Minimum, c.ExclusiveMinimum)
+	bound(gojsonschema.KEY_MAXIMUM, c.Maximum, c.ExclusiveMaximum)
+	bound(gojsonschema.KEY_EXCLUSIVE_MINIMUM, c.ExclusiveMinimumValue, false)
+	bound(gojsonschema.KEY_EXCLUSIVE_MAXIMUM, c.ExclusiveMaximumValue, false)
+	bound(gojsonschema.KEY_MULTIPLE_OF, c.MultipleOf, false)
+	count(gojsonschema.KEY_MIN_LENGTH, c.MinLength)
+	count(gojsonschema.KEY_MAX_LENGTH, c.MaxLength)
+	if c.Pattern != "" {
+		list = append(list, gojsonschema.KEY_PATTERN+" : "+c.Pattern)
+	}
+	if c.Format != "" {
+		list = append(list, gojsonschema.KEY_FORMAT+" : "+c.Format)
+	}
+	count(gojsonschema.KEY_MIN_ITEMS, c.MinItems)
+	count(gojsonschema.KEY_MAX_ITEMS, c.MaxItems)
+	if c.UniqueItems {
+		list = append(list, gojsonschema.KEY_UNIQUE_ITEMS)
+	}
+	count(gojsonschema.KEY_MIN_PROPERTIES, c.MinProperties)
+	count(gojsonschema.KEY_MAX_PROPERTIES, c.MaxProperties)
+	if len(c.Enum) > 0 {
+		var values []string
+		for _, value := range c.Enum {
+			values = append(values, jsonString(value))
+		}
+		list = append(list, gojsonschema.KEY_ENUM+" : "+strings.Join(values, ", "))
+	}
+	if value, ok := node.Default(); ok {
+		list = append(list, gojsonschema.KEY_DEFAULT+" : "+jsonString(value))
+	}
+
+	return list
+}
+
+// enumTypes returns the types of the enum values
+func enumTypes(enum []interface{}) []string {
+	var types []string
+	for _, value := range enum {
+		var valueType string
+		switch value.(type) {
+		case nil:
+			valueType = gojsonschema.TYPE_NULL
+		case bool:
+			valueType = gojsonschema.TYPE_BOOLEAN
+		case float64:
+			valueType = gojsonschema.TYPE_NUMBER
+		case string:
+			valueType = gojsonschema.TYPE_STRING
+		case []interface{}:
+			valueType = gojsonschema.TYPE_ARRAY
+		default:
+			valueType = gojsonschema.TYPE_OBJECT
+		}
+		if !isStringInSlice(types, valueType) {
+			types = append(types, valueType)
+		}
+	}
+	return types
+}
+
+func jsonString(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+// uniqueAnchor returns the html anchor of a title : "Pet.owner" => pet-owner
+func (d *documenter) uniqueAnchor(title string) string {
+
+	var anchor strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && anchor.Len() > 0 {
+				anchor.WriteByte('-')
+			}
+			anchor.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	unique := anchor.String()
+	for i := 2; d.anchors[unique]; i++ {
+		unique = anchor.String() + "-" + strconv.Itoa(i)
+	}
+	d.anchors[unique] = true
+	return unique
+}
+
+func markdownType(schemaType string, link string) string {
+	if link == "" {
+		return markdownCell(schemaType)
+	}
+	return "[" + markdownCell(schemaType) + "](#" + link + ")"
+}
+
+// markdownCell escapes the pipes and new lines breaking a table row
+func markdownCell(s string) string {
+	return strings.Replace(strings.Replace(s, "|", "\\|", -1), "\n", "<br>", -1)
+}
+
+func lastPointerToken(pointer string) string {
+	token := pointer[strings.LastIndex(pointer, "/")+1:]
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
+func isStringInSlice(s []string, what string) bool {
+	for i := range s {
+		if s[i] == what {
+			return true
+		}
+	}
+	return false
+}
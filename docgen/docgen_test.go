@@ -0,0 +1,121 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the generation of documentation.
+//
+// created          16-10-2026
+
+package docgen
+
+import (
+	"encoding/json"
+	"github.com/sigu-399/gojsonschema"
+	"strings"
+	"testing"
+)
+
+const docgenSchema = `{
+	"title":"Pet",
+	"description":"A pet of the store",
+	"definitions":{
+		"node":{"description":"A | tree","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/node"}}}}
+	},
+	"type":"object",
+	"required":["name"],
+	"properties":{
+		"name":{"type":"string","minLength":1,"description":"Name of the pet"},
+		"size":{"enum":["small","large"],"default":"small"},
+		"weight":{"type":["number","null"],"minimum":0,"exclusiveMinimum":true},
+		"tree":{"$ref":"#/definitions/node"},
+		"owner":{"type":"object","properties":{"first_name":{"type":"string"}}},
+		"tags":{"type":"array","items":{"type":"string"},"uniqueItems":true}
+	}
+}`
+
+func mustNewSchemaDocument(t *testing.T, s string) *gojsonschema.JsonSchemaDocument {
+	var document interface{}
+	if err := json.Unmarshal([]byte(s), &document); err != nil {
+		t.Fatalf("Could not parse json : %s", err.Error())
+	}
+	schemaDocument, err := gojsonschema.NewJsonSchemaDocument(document)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	return schemaDocument
+}
+
+func TestMarkdown(t *testing.T) {
+
+	markdown, err := Markdown(mustNewSchemaDocument(t, docgenSchema), Options{})
+	if err != nil {
+		t.Fatalf("Could not generate : %s", err.Error())
+	}
+
+	// backquotes of the markdown code are written as quotes
+	expected := strings.Replace(`# Pet
+
+A pet of the store
+
+| Property | Type | Required | Constraints | Description |
+| --- | --- | --- | --- | --- |
+| 'name' | string | yes | minLength : 1 | Name of the pet |
+| 'owner' | [Pet.owner](#pet-owner) |  |  |  |
+| 'size' | string |  | enum : "small", "large", default : "small" |  |
+| 'tags' | array of string |  | uniqueItems |  |
+| 'tree' | [node](#node) |  |  | A \| tree |
+| 'weight' | number or null |  | minimum : 0 ( exclusive ) |  |
+
+## Pet.owner
+
+| Property | Type | Required | Constraints | Description |
+| --- | --- | --- | --- | --- |
+| 'first_name' | string |  |  |  |
+
+## node
+
+A | tree
+
+| Property | Type | Required | Constraints | Description |
+| --- | --- | --- | --- | --- |
+| 'children' | [array of node](#node) |  |  |  |`, "'", "`", -1)
+	if string(markdown) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(markdown))
+	}
+}
+
+func TestHtml(t *testing.T) {
+
+	html, err := Html(mustNewSchemaDocument(t, docgenSchema), Options{Title: "Pets <api>", Example: true})
+	if err != nil {
+		t.Fatalf("Could not generate : %s", err.Error())
+	}
+
+	for _, expected := range []string{
+		`<section id="pets-api">` + "\n" + `<h1>Pets &lt;api&gt;</h1>`,
+		`<tr><td><code>tree</code></td><td><a href="#node">node</a></td><td></td><td></td><td>A | tree</td></tr>`,
+		`<tr><td><code>name</code></td><td>string</td><td>yes</td><td>minLength : 1</td><td>Name of the pet</td></tr>`,
+		`<pre><code>{`,
+	} {
+		if !strings.Contains(string(html), expected) {
+			t.Errorf("Expects the html to contain %s, given :\n%s", expected, string(html))
+		}
+	}
+}
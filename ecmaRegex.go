@@ -0,0 +1,167 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Translates ECMA 262 regular expressions, the dialect used by JSON schema,
+//                  to the RE2 syntax of Go regular expressions.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ECMA 262 white spaces and line terminators, RE2's \s is ASCII only
+const ecmaWhiteSpaces = `\t\n\v\f\r \x{00A0}\x{1680}\x{2000}-\x{200A}\x{2028}\x{2029}\x{202F}\x{205F}\x{3000}\x{FEFF}`
+
+// Translates an ECMA 262 pattern to an equivalent Go pattern
+// Constructs RE2 cannot express ( lookarounds, backreferences ) are reported as errors
+func ecmaToGoRegexp(pattern string) (string, error) {
+
+	var buf bytes.Buffer
+	inClass := false
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch {
+
+		case c == '\\':
+			if i+1 >= len(pattern) {
+				return "", errors.New("pattern cannot end with \\")
+			}
+			i++
+			e := pattern[i]
+			switch e {
+
+			case 'u':
+				// \uXXXX or \u{X...}
+				if i+1 < len(pattern) && pattern[i+1] == '{' {
+					end := strings.IndexByte(pattern[i:], '}')
+					if end == -1 {
+						return "", errors.New("unterminated \\u{ escape")
+					}
+					buf.WriteString(`\x` + pattern[i+1:i+end+1])
+					i += end
+				} else {
+					if i+5 > len(pattern) || !isHexString(pattern[i+1:i+5]) {
+						return "", errors.New("invalid \\u escape")
+					}
+					buf.WriteString(`\x{` + pattern[i+1:i+5] + `}`)
+					i += 4
+				}
+
+			case 'c':
+				// control character \cA => \x{01}
+				if i+1 >= len(pattern) || !isAsciiLetter(pattern[i+1]) {
+					return "", errors.New("invalid \\c escape")
+				}
+				buf.WriteString(fmt.Sprintf(`\x{%02X}`, pattern[i+1]%32))
+				i++
+
+			case 's':
+				if inClass {
+					buf.WriteString(ecmaWhiteSpaces)
+				} else {
+					buf.WriteString(`[` + ecmaWhiteSpaces + `]`)
+				}
+
+			case 'S':
+				if inClass {
+					return "", errors.New(`\S is not supported inside a character class`)
+				}
+				buf.WriteString(`[^` + ecmaWhiteSpaces + `]`)
+
+			case '/':
+				buf.WriteByte('/')
+
+			case '0':
+				buf.WriteString(`\x{00}`)
+
+			case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+				return "", errors.New("backreferences are not supported")
+
+			case 'k':
+				return "", errors.New("named backreferences are not supported")
+
+			default:
+				buf.WriteByte('\\')
+				buf.WriteByte(e)
+			}
+
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+			buf.WriteByte(c)
+
+		case c == '[':
+			// [^] matches anything, [] matches nothing
+			if strings.HasPrefix(pattern[i:], "[^]") {
+				buf.WriteString(`[\x{0}-\x{10FFFF}]`)
+				i += 2
+				continue
+			}
+			if strings.HasPrefix(pattern[i:], "[]") {
+				buf.WriteString(`[^\x{0}-\x{10FFFF}]`)
+				i++
+				continue
+			}
+			inClass = true
+			buf.WriteByte(c)
+
+		case c == '(' && strings.HasPrefix(pattern[i:], "(?="), c == '(' && strings.HasPrefix(pattern[i:], "(?!"),
+			c == '(' && strings.HasPrefix(pattern[i:], "(?<="), c == '(' && strings.HasPrefix(pattern[i:], "(?<!"):
+			return "", errors.New("lookarounds are not supported")
+
+		case c == '(' && strings.HasPrefix(pattern[i:], "(?<"):
+			buf.WriteString("(?P<")
+			i += 2
+
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	if inClass {
+		return "", errors.New("unterminated character class")
+	}
+
+	return buf.String(), nil
+}
+
+func isHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+func isAsciiLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,106 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the ECMA 262 regular expressions translation.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEcmaToGoRegexp(t *testing.T) {
+
+	tests := []struct {
+		pattern string
+		value   string
+		matches bool
+	}{
+		{`^\u00e9+$`, "éé", true},
+		{`^\u{1F600}$`, "😀", true},
+		{`^\s$`, " ", true},
+		{`^[\s]$`, " ", true},
+		{`^\S+$`, "a ", false},
+		{`^\cJ$`, "\n", true},
+		{`^[^]$`, "\n", true},
+		{`a[]`, "a", false},
+		{`^\/$`, "/", true},
+		{`^(?<year>\d{4})$`, "2013", true},
+		{`^\d+$`, "١٢", false},
+	}
+
+	for _, test := range tests {
+		schemaDocument, err := NewJsonSchemaDocumentWithOptions(map[string]interface{}{"pattern": test.pattern}, SchemaOptions{EcmaRegex: true})
+		if err != nil {
+			t.Errorf("Could not parse pattern %s : %s", test.pattern, err.Error())
+			continue
+		}
+		if result := schemaDocument.Validate(test.value); result.IsValid() != test.matches {
+			t.Errorf("Expects %s to match %q %t", test.pattern, test.value, test.matches)
+		}
+	}
+
+	for _, pattern := range []string{`a(?=b)`, `(?<!a)b`, `(a)\1`, `[a`, `\u12`} {
+		if _, err := ecmaToGoRegexp(pattern); err == nil {
+			t.Errorf("Expects %s to be rejected", pattern)
+		}
+	}
+}
+
+func TestEcmaPatternText(t *testing.T) {
+
+	schema := map[string]interface{}{
+		"pattern":           `^\u00e9\d$`,
+		"patternProperties": map[string]interface{}{`^\u00e9`: map[string]interface{}{"type": "string"}},
+	}
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(schema, SchemaOptions{EcmaRegex: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the pattern is written as in the schema, not as translated to RE2
+	marshalled, err := json.Marshal(schemaDocument)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(marshalled), `"pattern":"^\\u00e9\\d$"`) {
+		t.Errorf("Expects the pattern of the schema, given %s", marshalled)
+	}
+
+	data, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if result := loaded.Validate("é1"); !result.IsValid() {
+		t.Errorf("Expects the loaded pattern to be translated, given %v", result.GetErrorMessages())
+	}
+	if result := loaded.Validate(map[string]interface{}{"é": 1}); result.IsValid() {
+		t.Errorf("Expects the loaded pattern property to be translated")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Stable codes of the kinds of validation errors.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+// ErrorType is the kind of a validation error, its values are stable so
+// that programs can branch on them instead of matching the descriptions
+type ErrorType string
+
+const (
+	ERROR_TYPE_INVALID_VALUE         ErrorType = "invalid_value"
+	ERROR_TYPE_TYPE                  ErrorType = "type"
+	ERROR_TYPE_ENUM                  ErrorType = "enum"
+	ERROR_TYPE_ANY_OF                ErrorType = "any_of"
+	ERROR_TYPE_ONE_OF_NONE           ErrorType = "one_of_none"
+	ERROR_TYPE_ONE_OF_MULTIPLE       ErrorType = "one_of_multiple"
+	ERROR_TYPE_ALL_OF                ErrorType = "all_of"
+	ERROR_TYPE_NOT                   ErrorType = "not"
+	ERROR_TYPE_DEPENDENCY            ErrorType = "dependency"
+	ERROR_TYPE_DISCRIMINATOR_MISSING ErrorType = "discriminator_missing"
+	ERROR_TYPE_DISCRIMINATOR_UNKNOWN ErrorType = "discriminator_unknown"
+	ERROR_TYPE_ADDITIONAL_ITEMS      ErrorType = "additional_items"
+	ERROR_TYPE_MIN_ITEMS             ErrorType = "min_items"
+	ERROR_TYPE_MAX_ITEMS             ErrorType = "max_items"
+	ERROR_TYPE_UNIQUE_ITEMS          ErrorType = "unique_items"
+	ERROR_TYPE_REQUIRED              ErrorType = "required"
+	ERROR_TYPE_ADDITIONAL_PROPERTY   ErrorType = "additional_property"
+	ERROR_TYPE_MIN_PROPERTIES        ErrorType = "min_properties"
+	ERROR_TYPE_MAX_PROPERTIES        ErrorType = "max_properties"
+	ERROR_TYPE_MIN_LENGTH            ErrorType = "min_length"
+	ERROR_TYPE_MAX_LENGTH            ErrorType = "max_length"
+	ERROR_TYPE_PATTERN               ErrorType = "pattern"
+	ERROR_TYPE_FORMAT                ErrorType = "format"
+	ERROR_TYPE_MULTIPLE_OF           ErrorType = "multiple_of"
+	ERROR_TYPE_MINIMUM               ErrorType = "minimum"
+	ERROR_TYPE_EXCLUSIVE_MINIMUM     ErrorType = "exclusive_minimum"
+	ERROR_TYPE_MAXIMUM               ErrorType = "maximum"
+	ERROR_TYPE_EXCLUSIVE_MAXIMUM     ErrorType = "exclusive_maximum"
+	ERROR_TYPE_DEPRECATED            ErrorType = "deprecated"
+	ERROR_TYPE_READ_ONLY             ErrorType = "read_only"
+	ERROR_TYPE_WRITE_ONLY            ErrorType = "write_only"
+	ERROR_TYPE_UNKNOWN_FIELD         ErrorType = "unknown_field"
+)
+
+// Types of the errors of the keywords, the errors of other kinds of a keyword
+// set their type where they are added
+var keywordErrorTypes = map[string]ErrorType{
+	KEY_TYPE:                  ERROR_TYPE_TYPE,
+	KEY_ENUM:                  ERROR_TYPE_ENUM,
+	KEY_ANY_OF:                ERROR_TYPE_ANY_OF,
+	KEY_ONE_OF:                ERROR_TYPE_ONE_OF_NONE,
+	KEY_ALL_OF:                ERROR_TYPE_ALL_OF,
+	KEY_NOT:                   ERROR_TYPE_NOT,
+	KEY_DEPENDENCIES:          ERROR_TYPE_DEPENDENCY,
+	KEY_DISCRIMINATOR:         ERROR_TYPE_DISCRIMINATOR_UNKNOWN,
+	KEY_ADDITIONAL_ITEMS:      ERROR_TYPE_ADDITIONAL_ITEMS,
+	KEY_MIN_ITEMS:             ERROR_TYPE_MIN_ITEMS,
+	KEY_MAX_ITEMS:             ERROR_TYPE_MAX_ITEMS,
+	KEY_UNIQUE_ITEMS:          ERROR_TYPE_UNIQUE_ITEMS,
+	KEY_REQUIRED:              ERROR_TYPE_REQUIRED,
+	KEY_ADDITIONAL_PROPERTIES: ERROR_TYPE_ADDITIONAL_PROPERTY,
+	KEY_MIN_PROPERTIES:        ERROR_TYPE_MIN_PROPERTIES,
+	KEY_MAX_PROPERTIES:        ERROR_TYPE_MAX_PROPERTIES,
+	KEY_MIN_LENGTH:            ERROR_TYPE_MIN_LENGTH,
+	KEY_MAX_LENGTH:            ERROR_TYPE_MAX_LENGTH,
+	KEY_PATTERN:               ERROR_TYPE_PATTERN,
+	KEY_FORMAT:                ERROR_TYPE_FORMAT,
+	KEY_MULTIPLE_OF:           ERROR_TYPE_MULTIPLE_OF,
+	KEY_MINIMUM:               ERROR_TYPE_MINIMUM,
+	KEY_MAXIMUM:               ERROR_TYPE_MAXIMUM,
+	KEY_EXCLUSIVE_MINIMUM:     ERROR_TYPE_EXCLUSIVE_MINIMUM,
+	KEY_EXCLUSIVE_MAXIMUM:     ERROR_TYPE_EXCLUSIVE_MAXIMUM,
+	KEY_DEPRECATED:            ERROR_TYPE_DEPRECATED,
+	KEY_READ_ONLY:             ERROR_TYPE_READ_ONLY,
+	KEY_WRITE_ONLY:            ERROR_TYPE_WRITE_ONLY,
+
+	// the fields Kubernetes prunes
+	KEY_PRESERVE_UNKNOWN_FIELDS: ERROR_TYPE_UNKNOWN_FIELD,
+}
@@ -0,0 +1,157 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Records the keyword evaluations of a validation.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TraceEntry is the outcome of one keyword of a schema on one location of the document
+type TraceEntry struct {
+	// json reference of the keyword, ex: schema.json#/properties/age/minimum
+	SchemaLocation string
+	// location in the document, ex: ROOT.age
+	InstanceLocation string
+	Keyword          string
+	Valid            bool
+}
+
+// EvaluationTrace lists the keyword evaluations in the order they completed,
+// the keywords of a sub-schema come before the keyword that applied it
+type EvaluationTrace []TraceEntry
+
+// String dumps the trace, one evaluation per line
+func (t EvaluationTrace) String() string {
+	var lines []string
+	for _, entry := range t {
+		outcome := "valid"
+		if !entry.Valid {
+			outcome = "invalid"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", entry.InstanceLocation, entry.SchemaLocation, outcome))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// When set, Validate records every keyword evaluation, including those of the
+// anyOf / oneOf branches that were discarded, see GetTrace
+// Meant for debugging, it slows the validation down
+func (d *JsonSchemaDocument) SetTrace(trace bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.trace = trace
+	})
+}
+
+// GetTrace returns the keyword evaluations, nil unless SetTrace was set
+func (v *ValidationResult) GetTrace() EvaluationTrace {
+	if v.trace == nil {
+		return nil
+	}
+	return *v.trace
+}
+
+// Options of one validation, with the trace it records to
+func (o *validationOptions) withTrace() *validationOptions {
+	if o == nil || !o.trace {
+		return o
+	}
+	traceOptions := *o
+	traceOptions.evaluationTrace = &EvaluationTrace{}
+	return &traceOptions
+}
+
+func (v *ValidationResult) tracing() bool {
+	return v.options != nil && v.options.evaluationTrace != nil
+}
+
+// Keeps the keyword failing in the schema being evaluated, for the trace and the hook
+func (v *ValidationResult) traceFailure(keyword string) {
+	if v.tracing() || v.hooked() {
+		v.failedKeywords = append(v.failedKeywords, keyword)
+	}
+}
+
+// Records the keywords of a schema evaluated on a location, a keyword is
+// invalid when it failed since the evaluation started, failedFrom being the
+// number of failures then
+func (v *ValidationResult) traceSchema(schema *jsonSchema, context *jsonContext, failedFrom int) {
+	failed := v.failedKeywords[failedFrom:]
+	location := (&SchemaNode{schema: schema}).Document() + "#" + schema.pointer
+	instanceLocation := context.String()
+	for _, keyword := range schema.keywords() {
+		*v.options.evaluationTrace = append(*v.options.evaluationTrace, TraceEntry{
+			SchemaLocation:   location + "/" + escapeJsonPointerToken(keyword),
+			InstanceLocation: instanceLocation,
+			Keyword:          keyword,
+			Valid:            !isStringInSlice(failed, keyword),
+		})
+	}
+}
+
+// keywords returns the validation keywords of the schema
+func (s *jsonSchema) keywords() []string {
+	var keywords []string
+	add := func(present bool, keyword string) {
+		if present {
+			keywords = append(keywords, keyword)
+		}
+	}
+	add(s.refSchema != nil, KEY_REF)
+	if s.refSchema != nil {
+		// the other keywords are ignored
+		return keywords
+	}
+	add(len(s.types.types) > 0, KEY_TYPE)
+	add(len(s.anyOf) > 0, KEY_ANY_OF)
+	add(s.discriminator != nil, KEY_DISCRIMINATOR)
+	add(len(s.oneOf) > 0, KEY_ONE_OF)
+	add(len(s.allOf) > 0, KEY_ALL_OF)
+	add(s.not != nil, KEY_NOT)
+	add(len(s.dependencies) > 0, KEY_DEPENDENCIES)
+	add(s.enum != nil, KEY_ENUM)
+	add(len(s.itemsChildren) > 0, KEY_ITEMS)
+	add(s.additionalItems != nil, KEY_ADDITIONAL_ITEMS)
+	add(s.minItems != nil, KEY_MIN_ITEMS)
+	add(s.maxItems != nil, KEY_MAX_ITEMS)
+	add(s.uniqueItems, KEY_UNIQUE_ITEMS)
+	add(s.minProperties != nil, KEY_MIN_PROPERTIES)
+	add(s.maxProperties != nil, KEY_MAX_PROPERTIES)
+	add(len(s.required) > 0, KEY_REQUIRED)
+	add(len(s.propertiesChildren) > 0, KEY_PROPERTIES)
+	add(len(s.patternProperties) > 0, KEY_PATTERN_PROPERTIES)
+	add(s.additionalProperties != nil, KEY_ADDITIONAL_PROPERTIES)
+	add(s.minLength != nil, KEY_MIN_LENGTH)
+	add(s.maxLength != nil, KEY_MAX_LENGTH)
+	add(s.pattern != nil, KEY_PATTERN)
+	add(s.format != nil, KEY_FORMAT)
+	add(s.multipleOf != nil, KEY_MULTIPLE_OF)
+	add(s.minimum != nil, KEY_MINIMUM)
+	add(s.maximum != nil, KEY_MAXIMUM)
+	add(s.exclusiveMinimumValue != nil, KEY_EXCLUSIVE_MINIMUM)
+	add(s.exclusiveMaximumValue != nil, KEY_EXCLUSIVE_MAXIMUM)
+	return keywords
+}
@@ -0,0 +1,77 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the evaluation trace.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestEvaluationTrace(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"definitions":{"positive":{"minimum":0}},
+		"type":"object",
+		"properties":{
+			"age":{"$ref":"#/definitions/positive"},
+			"name":{"anyOf":[{"type":"number"},{"maxLength":3}]}
+		},
+		"required":["name"]
+	}`)
+
+	document := mustParseJson(t, `{"age":-1,"name":"abc"}`)
+	if result := schemaDocument.Validate(document); result.GetTrace() != nil {
+		t.Errorf("Expects no trace by default")
+	}
+
+	schemaDocument.SetTrace(true)
+	result := schemaDocument.Validate(document)
+
+	expected := []string{
+		`ROOT.name #/properties/name/anyOf/0/type invalid`,
+		`ROOT.name #/properties/name/anyOf/1/maxLength valid`,
+		`ROOT.name #/properties/name/anyOf valid`,
+		`ROOT.age #/definitions/positive/minimum invalid`,
+		`ROOT.age #/properties/age/$ref invalid`,
+		`ROOT #/type valid`,
+		`ROOT #/required valid`,
+		`ROOT #/properties invalid`,
+	}
+	// properties are evaluated in no particular order, the evaluations of a location keep theirs
+	given := strings.Split(result.GetTrace().String(), "\n")
+	sort.SliceStable(given, func(i, j int) bool {
+		return strings.Fields(given[i])[0] > strings.Fields(given[j])[0]
+	})
+	if strings.Join(given, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects trace :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), result.GetTrace().String())
+	}
+
+	// each validation has its own trace
+	if trace := schemaDocument.Validate(mustParseJson(t, `{"name":1}`)).GetTrace(); len(trace) != 5 {
+		t.Errorf("Expects 5 evaluations, given :\n%s", trace.String())
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Checkers of the "format" keyword, and the registry they are looked up from.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FormatChecker checks a string against a format, the error explains why it does not match
+type FormatChecker interface {
+	CheckFormat(input string) error
+}
+
+// FormatCheckerFunc adapts a function to a FormatChecker
+type FormatCheckerFunc func(input string) error
+
+func (f FormatCheckerFunc) CheckFormat(input string) error {
+	return f(input)
+}
+
+var (
+	formatCheckersMutex sync.RWMutex
+	formatCheckers      = map[string]FormatChecker{
+		"date-time": DateTimeFormatChecker{AllowLeapSecond: true},
+		"date":      FormatCheckerFunc(checkDateFormat),
+		"time":      TimeFormatChecker{AllowLeapSecond: true},
+
+		"email":        FormatCheckerFunc(checkEmailFormat),
+		"idn-email":    FormatCheckerFunc(checkIdnEmailFormat),
+		"hostname":     FormatCheckerFunc(checkHostnameFormat),
+		"idn-hostname": FormatCheckerFunc(checkIdnHostnameFormat),
+		"ipv4":         FormatCheckerFunc(checkIpv4Format),
+		"ipv6":         FormatCheckerFunc(checkIpv6Format),
+
+		"uuid":                  FormatCheckerFunc(checkUuidFormat),
+		"uri":                   FormatCheckerFunc(checkUriFormat),
+		"uri-reference":         FormatCheckerFunc(checkUriReferenceFormat),
+		"uri-template":          FormatCheckerFunc(checkUriTemplateFormat),
+		"json-pointer":          FormatCheckerFunc(checkJsonPointerFormat),
+		"relative-json-pointer": FormatCheckerFunc(checkRelativeJsonPointerFormat),
+		"regex":                 FormatCheckerFunc(checkRegexFormat),
+		"duration":              FormatCheckerFunc(checkDurationFormat),
+	}
+)
+
+// Registers a checker for a format, replacing the existing one if any
+func AddFormatChecker(name string, checker FormatChecker) {
+	formatCheckersMutex.Lock()
+	defer formatCheckersMutex.Unlock()
+	formatCheckers[name] = checker
+}
+
+func RemoveFormatChecker(name string) {
+	formatCheckersMutex.Lock()
+	defer formatCheckersMutex.Unlock()
+	delete(formatCheckers, name)
+}
+
+func getFormatChecker(name string) (FormatChecker, bool) {
+	formatCheckersMutex.RLock()
+	defer formatCheckersMutex.RUnlock()
+	checker, ok := formatCheckers[name]
+	return checker, ok
+}
+
+// RFC 3339 : a full-date, a full-time and date-time made of both
+var (
+	fullDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	fullTimeRegexp = regexp.MustCompile(`^(\d{2}):(\d{2}):(\d{2})(\.\d+)?([zZ]|[+-]\d{2}:\d{2})$`)
+)
+
+func checkDateFormat(input string) error {
+	if !fullDateRegexp.MatchString(input) {
+		return errors.New("expects a full-date as YYYY-MM-DD")
+	}
+	_, err := time.Parse("2006-01-02", input)
+	return parseTimeError(err)
+}
+
+// DateTimeFormatChecker checks RFC 3339 date-times, "2013-02-26T20:04:05.12+02:00"
+type DateTimeFormatChecker struct {
+	// Accept the 60th second of a minute, the leap second of RFC 3339
+	AllowLeapSecond bool
+}
+
+func (c DateTimeFormatChecker) CheckFormat(input string) error {
+	separator := strings.IndexAny(input, "tT")
+	if separator == -1 {
+		return errors.New("expects a date and a time separated by T")
+	}
+	if err := checkDateFormat(input[:separator]); err != nil {
+		return err
+	}
+	return TimeFormatChecker{AllowLeapSecond: c.AllowLeapSecond}.CheckFormat(input[separator+1:])
+}
+
+// TimeFormatChecker checks RFC 3339 full-times, "20:04:05.12+02:00"
+type TimeFormatChecker struct {
+	// Accept the 60th second of a minute, the leap second of RFC 3339
+	AllowLeapSecond bool
+}
+
+func (c TimeFormatChecker) CheckFormat(input string) error {
+	parts := fullTimeRegexp.FindStringSubmatch(input)
+	if parts == nil {
+		return errors.New("expects a full-time as hh:mm:ss with a Z or ±hh:mm offset")
+	}
+
+	seconds := parts[3]
+	leapSecond := seconds == "60"
+	if leapSecond {
+		if !c.AllowLeapSecond {
+			return errors.New("leap seconds are not allowed")
+		}
+		seconds = "59"
+	}
+
+	parsed, err := time.Parse("15:04:05Z07:00", parts[1]+":"+parts[2]+":"+seconds+strings.ToUpper(parts[5]))
+	if err != nil {
+		return parseTimeError(err)
+	}
+	// a leap second is inserted at the end of a UTC day
+	if leapSecond {
+		if utc := parsed.UTC(); utc.Hour() != 23 || utc.Minute() != 59 {
+			return errors.New("leap seconds are only allowed at 23:59:60 UTC")
+		}
+	}
+	return nil
+}
+
+// Keeps the reason of a time.Parse error only
+func parseTimeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var parseError *time.ParseError
+	if errors.As(err, &parseError) && parseError.Message != "" {
+		return errors.New(strings.TrimPrefix(parseError.Message, ": "))
+	}
+	return err
+}
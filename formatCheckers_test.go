@@ -0,0 +1,231 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the format checkers.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestFormatCheckers(t *testing.T) {
+
+	tests := []struct {
+		format string
+		value  string
+		valid  bool
+	}{
+		{"date-time", "1963-06-19T08:30:06.283185Z", true},
+		{"date-time", "1963-06-19t08:30:06z", true},
+		{"date-time", "1990-12-31T15:59:60-08:00", true},
+		{"date-time", "1990-12-31T23:59:60Z", true},
+		{"date-time", "1990-12-31T15:59:60Z", false},
+		{"date-time", "1990-12-31T23:58:60Z", false},
+		{"date-time", "1990-12-31T23:59:60+01:00", false},
+		{"date-time", "1963-06-19T08:30:06+05:45", true},
+		{"date-time", "1963-06-19 08:30:06Z", false},
+		{"date-time", "1963-06-19T08:30:06", false},
+		{"date-time", "1963-02-30T08:30:06Z", false},
+		{"date-time", "06/19/1963 08:30:06 PST", false},
+		{"date", "1963-06-19", true},
+		{"date", "1963-6-19", false},
+		{"date", "2021-02-29", false},
+		{"date", "2020-02-29", true},
+		{"time", "08:30:06.283185Z", true},
+		{"time", "23:59:60Z", true},
+		{"time", "12:59:60Z", false},
+		{"time", "24:00:00Z", false},
+		{"time", "08:30:06 PST", false},
+		{"time", "08:30:06+25:00", false},
+		{"email", "joe.bloggs@example.com", true},
+		{"email", `"joe bloggs"@example.com`, true},
+		{"email", "joe@[127.0.0.1]", true},
+		{"email", "joe@[IPv6:::1]", true},
+		{"email", ".joe@example.com", false},
+		{"email", "joe..bloggs@example.com", false},
+		{"email", "joe@-example.com", false},
+		{"email", "2962", false},
+		{"email", "pelé@example.com", false},
+		{"idn-email", "실례@실례.테스트", true},
+		{"idn-email", "joe@example.com", true},
+		{"idn-email", "2962", false},
+		{"hostname", "www.example.com", true},
+		{"hostname", "xn--4gbwdl.xn--wgbh1c", true},
+		{"hostname", "xn--X", false},
+		{"hostname", "-a-host-name-that-starts-with--", false},
+		{"hostname", "not_a_valid_host_name", false},
+		{"hostname", "a-vvvvvvvvvvvvvvvveeeeeeeeeeeeeeeerrrrrrrrrrrrrrrryyyyyyyyyyyyyyyy-long-host-name-component", false},
+		{"hostname", "실례.테스트", false},
+		{"idn-hostname", "실례.테스트", true},
+		{"idn-hostname", "xn--ihqwcrb4cv8a8dqg056pqjye", true},
+		{"idn-hostname", "〮실례.테스트", false},
+		{"idn-hostname", "̀hello", false},
+		{"ipv4", "192.168.0.1", true},
+		{"ipv4", "127.0.0.0.1", false},
+		{"ipv4", "256.256.256.256", false},
+		{"ipv4", "087.10.0.1", false},
+		{"ipv4", "::1", false},
+		{"ipv6", "::1", true},
+		{"ipv6", "::abef", true},
+		{"ipv6", "12345::", false},
+		{"ipv6", "1:1:1:1:1:1:1:1:1:1", false},
+		{"ipv6", "127.0.0.1", false},
+		{"uuid", "2eb8aa08-aa98-11ea-b4aa-73b441d16380", true},
+		{"uuid", "2eb8aa08-aa98-11ea-b4aa-73b441d1638", false},
+		{"uuid", "2eb8aa08aa9811eab4aa73b441d16380", false},
+		{"uri", "http://foo.bar/?baz=qux#quux", true},
+		{"uri", "urn:oasis:names:specification:docbook:dtd:xml:4.1.2", true},
+		{"uri", "http://[2001:db8::7]/c=GB?objectClass?one", true},
+		{"uri", "//foo.bar/?baz=qux#quux", false},
+		{"uri", "http:// shouldfail.com", false},
+		{"uri", `http://host/\path`, false},
+		{"uri-reference", "/abc", true},
+		{"uri-reference", "#fragment", true},
+		{"uri-reference", `#frag\ment`, false},
+		{"uri-template", "http://example.com/dictionary/{term:1}/{term}", true},
+		{"uri-template", "{+path}/here{?x,y}", true},
+		{"uri-template", "http://example.com/dictionary/{term:1}/{term", false},
+		{"uri-template", "http://example.com/{te rm}", false},
+		{"json-pointer", "", true},
+		{"json-pointer", "/foo/bar~0/baz~1/%a", true},
+		{"json-pointer", "/foo/bar~", false},
+		{"json-pointer", "#/foo", false},
+		{"relative-json-pointer", "1", true},
+		{"relative-json-pointer", "0#", true},
+		{"relative-json-pointer", "1/foo/bar", true},
+		{"relative-json-pointer", "/foo/bar", false},
+		{"relative-json-pointer", "01/a", false},
+		{"regex", "([abc])+\\s+$", true},
+		{"regex", "^(abc]", false},
+		{"duration", "P4DT12H30M5S", true},
+		{"duration", "P4W", true},
+		{"duration", "PT1M", true},
+		{"duration", "P", false},
+		{"duration", "P1YT", false},
+		{"duration", "PT1D", false},
+		{"duration", "P2W1D", false},
+	}
+
+	for _, test := range tests {
+		schemaDocument := mustNewSchemaDocument(t, `{"format":"`+test.format+`"}`)
+		result := schemaDocument.Validate(test.value)
+		if result.IsValid() != test.valid {
+			t.Errorf("Expects %s %q to be valid %t, given %v", test.format, test.value, test.valid, result.GetErrorMessages())
+		}
+	}
+
+	if err := (TimeFormatChecker{}).CheckFormat("23:59:60Z"); err == nil {
+		t.Errorf("Expects leap seconds to be rejected")
+	}
+}
+
+func TestPunycode(t *testing.T) {
+
+	tests := map[string]string{
+		"bücher":            "bcher-kva",
+		"例え":                "r8jz45g",
+		"ليهمابتكلموشعربي؟": "egbpdaj6bu4bxfgehfvwxn",
+	}
+
+	for decoded, encoded := range tests {
+		if given := punycodeEncode(decoded); given != encoded {
+			t.Errorf("Expects %s to encode to %s, given %s", decoded, encoded, given)
+		}
+		if given, err := punycodeDecode(encoded); err != nil || given != decoded {
+			t.Errorf("Expects %s to decode to %s, given %s %v", encoded, decoded, given, err)
+		}
+	}
+}
+
+func TestFormatAnnotation(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"a":{"format":"date"},"b":{"format":"ipv4"}}}`)
+	document := mustParseJson(t, `{"a":"2013-02-30","b":"127.0.0.1"}`)
+
+	if result := schemaDocument.Validate(document); result.IsValid() || len(result.GetAnnotations()) != 0 {
+		t.Errorf("Expects format to be asserted, given %v", result.GetErrorMessages())
+	}
+
+	schemaDocument.SetFormatAssertion(false)
+	result := schemaDocument.Validate(document)
+	if !result.IsValid() {
+		t.Errorf("Expects format to be an annotation, given %v", result.GetErrorMessages())
+	}
+	if len(result.GetAnnotations()) != 2 {
+		t.Fatalf("Expects 2 annotations, given %d", len(result.GetAnnotations()))
+	}
+	for _, annotation := range result.GetAnnotations() {
+		if (annotation.Value == "date") != (annotation.Description != "") {
+			t.Errorf("Expects only the date annotation to describe a mismatch, given %+v", annotation)
+		}
+	}
+}
+
+func TestUnknownFormats(t *testing.T) {
+
+	schema := mustParseJson(t, `{"properties":{"a":{"format":"emial"},"b":{"format":"email"},"c":{"format":"emial"}}}`)
+
+	schemaDocument, err := NewJsonSchemaDocument(schema)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	if unknownFormats := schemaDocument.GetUnknownFormats(); len(unknownFormats) != 1 || unknownFormats[0] != "emial" {
+		t.Errorf("Expects emial to be unknown, given %v", unknownFormats)
+	}
+
+	if _, err := NewJsonSchemaDocumentWithOptions(schema, SchemaOptions{StrictFormats: true}); err == nil {
+		t.Errorf("Expects an unknown format to be an error in strict mode")
+	}
+}
+
+func TestDocumentFormatCheckers(t *testing.T) {
+
+	looseEmail := FormatCheckerFunc(func(input string) error {
+		return nil
+	})
+
+	schema := mustParseJson(t, `{"properties":{"a":{"format":"email"},"b":{"format":"color"}}}`)
+	document := mustParseJson(t, `{"a":"not an email","b":"blue"}`)
+
+	strictDocument := mustNewSchemaDocument(t, `{"properties":{"a":{"format":"email"},"b":{"format":"color"}}}`)
+	looseDocument, err := NewJsonSchemaDocumentWithOptions(schema, SchemaOptions{StrictFormats: true, FormatCheckers: map[string]FormatChecker{"email": looseEmail, "color": looseEmail}})
+	if err != nil {
+		t.Fatalf("Expects the document format checkers to be known, given %s", err.Error())
+	}
+
+	if strictDocument.Validate(document).IsValid() {
+		t.Errorf("Expects the registered email checker to be used")
+	}
+	if result := looseDocument.Validate(document); !result.IsValid() {
+		t.Errorf("Expects the document email checker to be used, given %v", result.GetErrorMessages())
+	}
+
+	strictDocument.AddFormatChecker("email", nil)
+	if result := strictDocument.Validate(document); !result.IsValid() {
+		t.Errorf("Expects the email format to be disabled, given %v", result.GetErrorMessages())
+	}
+	if unknownFormats := strictDocument.GetUnknownFormats(); len(unknownFormats) != 2 {
+		t.Errorf("Expects email and color to be unknown, given %v", unknownFormats)
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Converts Go values ( structs, typed maps and slices... ) to the json values
+//                  produced by encoding/json, following the same rules and json tags.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Tells if a value already is one of the values produced by json.Unmarshal
+// ( json.Number being produced by a json.Decoder using UseNumber )
+func isJsonValue(value interface{}) bool {
+	switch value.(type) {
+	case nil, map[string]interface{}, []interface{}, float64, json.Number, string, bool:
+		return true
+	}
+	return false
+}
+
+// Converts a Go value to its json equivalent, only the first level is converted
+// when the value is already a json value, its children are converted when validated
+func toJsonValue(value interface{}) (interface{}, error) {
+	if isJsonValue(value) {
+		return value, nil
+	}
+	return (&goValueConverter{}).reflectToJsonValue(reflect.ValueOf(value))
+}
+
+// Converts the Go values, the pointers, maps and slices being converted are
+// kept to report a cycle instead of recursing forever
+type goValueConverter struct {
+	visiting map[goValueVisit]bool
+}
+
+// A slice is identified by its length too, a shorter slice of the same array is not a cycle
+type goValueVisit struct {
+	kind   reflect.Kind
+	ptr    uintptr
+	length int
+}
+
+func newGoValueVisit(rValue reflect.Value) goValueVisit {
+	visit := goValueVisit{kind: rValue.Kind(), ptr: rValue.Pointer()}
+	if visit.kind == reflect.Slice {
+		visit.length = rValue.Len()
+	}
+	return visit
+}
+
+// Marks a pointer, map or slice as being converted, false when it already is
+func (c *goValueConverter) enter(rValue reflect.Value) bool {
+	visit := newGoValueVisit(rValue)
+	if c.visiting == nil {
+		c.visiting = make(map[goValueVisit]bool)
+	}
+	if c.visiting[visit] {
+		return false
+	}
+	c.visiting[visit] = true
+	return true
+}
+
+func (c *goValueConverter) leave(rValue reflect.Value) {
+	delete(c.visiting, newGoValueVisit(rValue))
+}
+
+func (c *goValueConverter) reflectToJsonValue(rValue reflect.Value) (interface{}, error) {
+
+	if !rValue.IsValid() {
+		return nil, nil
+	}
+
+	// types with their own json representation, the methods of the pointer
+	// receivers are used when the value is addressable, as encoding/json does
+	if rValue.Type().Implements(jsonMarshalerType) || rValue.Type().Implements(textMarshalerType) {
+		if (rValue.Kind() == reflect.Ptr || rValue.Kind() == reflect.Interface) && rValue.IsNil() {
+			return nil, nil
+		}
+		return marshalToJsonValue(rValue.Interface())
+	}
+	if rValue.Kind() != reflect.Ptr && rValue.CanAddr() && rValue.CanInterface() {
+		pointerType := reflect.PointerTo(rValue.Type())
+		if pointerType.Implements(jsonMarshalerType) || pointerType.Implements(textMarshalerType) {
+			return marshalToJsonValue(rValue.Addr().Interface())
+		}
+	}
+
+	switch rValue.Kind() {
+
+	case reflect.Ptr, reflect.Interface:
+		if rValue.IsNil() {
+			return nil, nil
+		}
+		if rValue.Kind() == reflect.Interface {
+			return c.reflectToJsonValue(rValue.Elem())
+		}
+		if !c.enter(rValue) {
+			return nil, errors.New(fmt.Sprintf("%s cannot be converted to json, it contains a cycle", rValue.Type()))
+		}
+		defer c.leave(rValue)
+		return c.reflectToJsonValue(rValue.Elem())
+
+	case reflect.Bool:
+		return rValue.Bool(), nil
+
+	// integers are kept as json.Number, a float64 would lose the precision of large ones
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Number(strconv.FormatInt(rValue.Int(), 10)), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return json.Number(strconv.FormatUint(rValue.Uint(), 10)), nil
+
+	// a float32 is written with the digits of a float32, as encoding/json does
+	case reflect.Float32:
+		return json.Number(formatJsonFloat(rValue.Float(), 32)), nil
+
+	case reflect.Float64:
+		return rValue.Float(), nil
+
+	case reflect.String:
+		return rValue.String(), nil
+
+	case reflect.Slice:
+		if rValue.IsNil() {
+			return nil, nil
+		}
+		if rValue.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(rValue.Bytes()), nil
+		}
+		if !c.enter(rValue) {
+			return nil, errors.New(fmt.Sprintf("%s cannot be converted to json, it contains a cycle", rValue.Type()))
+		}
+		defer c.leave(rValue)
+		fallthrough
+
+	case reflect.Array:
+		array := make([]interface{}, rValue.Len())
+		for i := range array {
+			element, err := c.reflectToJsonValue(rValue.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			array[i] = element
+		}
+		return array, nil
+
+	case reflect.Map:
+		if rValue.IsNil() {
+			return nil, nil
+		}
+		if !c.enter(rValue) {
+			return nil, errors.New(fmt.Sprintf("%s cannot be converted to json, it contains a cycle", rValue.Type()))
+		}
+		defer c.leave(rValue)
+		m := make(map[string]interface{}, rValue.Len())
+		iter := rValue.MapRange()
+		for iter.Next() {
+			key, err := mapKeyToString(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			element, err := c.reflectToJsonValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			m[key] = element
+		}
+		return m, nil
+
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		err := c.structToJsonValue(rValue, m)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("%s cannot be converted to json", rValue.Type()))
+}
+
+// A field of a struct or of its embedded structs
+type goField struct {
+	name      string
+	index     []int
+	tagged    bool
+	omitEmpty bool
+	// the ,string option, for the booleans, numbers and strings only
+	quoted bool
+}
+
+// Copies the exported fields of a struct, named after their json tag
+// The fields of embedded structs are promoted as encoding/json does: the least
+// nested field of a name wins, then the tagged one, otherwise none of them is copied
+func (c *goValueConverter) structToJsonValue(rValue reflect.Value, m map[string]interface{}) error {
+
+	var names []string
+	fieldsByName := make(map[string][]goField)
+	collectGoFields(rValue.Type(), nil, make(map[reflect.Type]bool), func(field goField) {
+		if _, ok := fieldsByName[field.name]; !ok {
+			names = append(names, field.name)
+		}
+		fieldsByName[field.name] = append(fieldsByName[field.name], field)
+	})
+
+	for _, name := range names {
+		field, ok := dominantGoField(fieldsByName[name])
+		if !ok {
+			continue
+		}
+		// behind a nil embedded pointer
+		fieldValue, ok := goFieldValue(rValue, field.index)
+		if !ok {
+			continue
+		}
+
+		if field.omitEmpty && isEmptyGoValue(fieldValue) {
+			continue
+		}
+
+		value, err := c.reflectToJsonValue(fieldValue)
+		if err != nil {
+			return err
+		}
+		if field.quoted {
+			value, err = quoteJsonValue(value)
+			if err != nil {
+				return err
+			}
+		}
+		m[name] = value
+	}
+
+	return nil
+}
+
+// Calls add for the json fields of a struct and, recursively, of its embedded structs
+func collectGoFields(rType reflect.Type, index []int, visited map[reflect.Type]bool, add func(goField)) {
+
+	if visited[rType] {
+		return
+	}
+	visited[rType] = true
+	defer delete(visited, rType)
+
+	for i := 0; i != rType.NumField(); i++ {
+		field := rType.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, tagOptions, _ := strings.Cut(tag, ",")
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		if field.Anonymous && tagName == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				collectGoFields(fieldType, fieldIndex, visited, add)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tagName != "" {
+			name = tagName
+		}
+		options := strings.Split(tagOptions, ",")
+		add(goField{
+			name:      name,
+			index:     fieldIndex,
+			tagged:    tagName != "",
+			omitEmpty: isStringInSlice(options, "omitempty"),
+			quoted:    isStringInSlice(options, "string") && isQuotableGoType(field.Type),
+		})
+	}
+}
+
+// Tells if the ,string option applies to a field of the type
+func isQuotableGoType(rType reflect.Type) bool {
+	if rType.Name() == "" && rType.Kind() == reflect.Ptr {
+		rType = rType.Elem()
+	}
+	// the types marshalling themselves ignore the option
+	if rType.Implements(jsonMarshalerType) || rType.Implements(textMarshalerType) ||
+		reflect.PointerTo(rType).Implements(jsonMarshalerType) || reflect.PointerTo(rType).Implements(textMarshalerType) {
+		return false
+	}
+	switch rType.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Writes a boolean, number or string value in a json string, a string is
+// quoted a second time
+func quoteJsonValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case bool:
+		return strconv.FormatBool(value), nil
+	case json.Number:
+		return string(value), nil
+	case float64:
+		return formatJsonFloat(value, 64), nil
+	case string:
+		quoted, err := json.Marshal(value)
+		return string(quoted), err
+	}
+	return value, nil
+}
+
+// The empty values omitted by the omitempty option, as encoding/json sees them :
+// a struct is never empty, an array is empty when of length 0
+func isEmptyGoValue(rValue reflect.Value) bool {
+	switch rValue.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rValue.Len() == 0
+	case reflect.Bool:
+		return !rValue.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rValue.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rValue.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rValue.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return rValue.IsNil()
+	}
+	return false
+}
+
+// Formats a float as encoding/json does : decimal, or with an exponent below
+// 1e-6 and from 1e21
+func formatJsonFloat(f float64, bits int) string {
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21)) {
+		format = 'e'
+	}
+	text := strconv.FormatFloat(f, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(text); n >= 4 && text[n-4] == 'e' && text[n-3] == '-' && text[n-2] == '0' {
+			text = text[:n-2] + text[n-1:]
+		}
+	}
+	return text
+}
+
+// The field encoding/json writes among the fields of a name, ok is false when
+// they conflict
+func dominantGoField(fields []goField) (dominant goField, ok bool) {
+	depth := len(fields[0].index)
+	var candidates []goField
+	for _, field := range fields {
+		if len(field.index) < depth {
+			depth, candidates = len(field.index), nil
+		}
+		if len(field.index) == depth {
+			candidates = append(candidates, field)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+	var tagged []goField
+	for _, field := range candidates {
+		if field.tagged {
+			tagged = append(tagged, field)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return goField{}, false
+}
+
+// The value of a promoted field, ok is false when an embedded pointer on its way is nil
+func goFieldValue(rValue reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && rValue.Kind() == reflect.Ptr {
+			if rValue.IsNil() {
+				return reflect.Value{}, false
+			}
+			rValue = rValue.Elem()
+		}
+		rValue = rValue.Field(fieldIndex)
+	}
+	return rValue, true
+}
+
+func mapKeyToString(key reflect.Value) (string, error) {
+	if key.Kind() == reflect.String {
+		return key.String(), nil
+	}
+	if textMarshaler, ok := key.Interface().(encoding.TextMarshaler); ok {
+		text, err := textMarshaler.MarshalText()
+		return string(text), err
+	}
+	switch key.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(key.Uint(), 10), nil
+	}
+	return "", errors.New(fmt.Sprintf("%s cannot be used as a json object key", key.Type()))
+}
+
+// Falls back on encoding/json for the types implementing their own marshalling
+func marshalToJsonValue(value interface{}) (interface{}, error) {
+	mBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	// the integers above 2^53 are kept exact
+	decoder := json.NewDecoder(bytes.NewReader(mBytes))
+	decoder.UseNumber()
+	var document interface{}
+	err = decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+	return document, nil
+}
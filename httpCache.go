@@ -0,0 +1,188 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Disk cache of the remote schemas, following the http caching headers.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// httpCacheEntry is the metadata of a cached remote document, its body is stored next to it
+type httpCacheEntry struct {
+	Url          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	Expires      time.Time `json:"expires"`
+}
+
+// getCachedHttpBody returns the body of a remote document from the cache directory while it is fresh,
+// otherwise it is revalidated with If-None-Match / If-Modified-Since, the cached body is returned
+// when the server cannot be reached
+func getCachedHttpBody(client *http.Client, cacheDirectory string, url string, maxBytes int) ([]byte, error) {
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	hash := sha256.Sum256([]byte(url))
+	name := filepath.Join(cacheDirectory, hex.EncodeToString(hash[:]))
+
+	var entry httpCacheEntry
+	body, err := ioutil.ReadFile(name + ".body")
+	cached := err == nil
+	if cached {
+		var meta []byte
+		meta, err = ioutil.ReadFile(name + ".meta")
+		cached = err == nil && json.Unmarshal(meta, &entry) == nil && entry.Url == url
+	}
+	if cached && time.Now().Before(entry.Expires) {
+		return body, nil
+	}
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cached && entry.ETag != "" {
+		request.Header.Set("If-None-Match", entry.ETag)
+	}
+	if cached && entry.LastModified != "" {
+		request.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	resp, err := client.Do(request)
+	if err != nil {
+		if cached {
+			return body, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached:
+		// the cached body is still valid
+	case resp.StatusCode == http.StatusOK:
+		body, err = readLimited(resp.Body, maxBytes)
+		if err != nil {
+			return nil, err
+		}
+		entry = httpCacheEntry{Url: url, ETag: resp.Header.Get("ETag"), LastModified: resp.Header.Get("Last-Modified")}
+	default:
+		return nil, newHttpStatusError(resp)
+	}
+
+	expires, store := httpCacheExpiration(resp.Header)
+	if !store {
+		return body, nil
+	}
+	entry.Expires = expires
+	if err := writeHttpCacheEntry(cacheDirectory, name, entry, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// httpCacheExpiration returns when a response must be revalidated and whether it can be stored
+func httpCacheExpiration(header http.Header) (time.Time, bool) {
+
+	now := time.Now()
+
+	// no-store and no-cache win over max-age, wherever they are
+	noCache := false
+	maxAge := -1
+	for _, directive := range strings.Split(strings.Join(header.Values("Cache-Control"), ","), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store":
+			return now, false
+		case directive == "no-cache":
+			noCache = true
+		case strings.HasPrefix(directive, "max-age="):
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil && maxAge == -1 {
+				maxAge = seconds
+			}
+		}
+	}
+	if noCache {
+		return now, true
+	}
+	if maxAge >= 0 {
+		return now.Add(time.Duration(maxAge) * time.Second), true
+	}
+
+	if expires, err := http.ParseTime(header.Get("Expires")); err == nil {
+		return expires, true
+	}
+
+	// without freshness information the response is revalidated on the next load
+	return now, true
+}
+
+// writeHttpCacheEntry writes the body and metadata of a cache entry, through renames so
+// concurrent processes never read a partial file
+func writeHttpCacheEntry(cacheDirectory string, name string, entry httpCacheEntry, body []byte) error {
+
+	if err := os.MkdirAll(cacheDirectory, 0755); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []struct {
+		extension string
+		content   []byte
+	}{{".body", body}, {".meta", meta}} {
+		temporary, err := ioutil.TempFile(cacheDirectory, filepath.Base(name)+".*")
+		if err != nil {
+			return err
+		}
+		_, err = temporary.Write(file.content)
+		if closeErr := temporary.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(temporary.Name(), name+file.extension)
+		}
+		if err != nil {
+			os.Remove(temporary.Name())
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the disk cache of remote schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHttpCache(t *testing.T) {
+
+	var requests, notModified int32
+	cacheControl := "max-age=3600"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", cacheControl)
+		w.Write([]byte(`{"type":"string"}`))
+	}))
+
+	cacheDirectory := t.TempDir()
+	load := func() {
+		schemaDocument, err := NewJsonSchemaDocumentWithOptions(server.URL+"/schema.json", SchemaOptions{CacheDirectory: cacheDirectory})
+		if err != nil {
+			t.Fatalf("Could not load the schema : %s", err.Error())
+		}
+		if schemaDocument.Validate(1.0).IsValid() {
+			t.Errorf("Expects the cached schema to be used")
+		}
+	}
+
+	// fresh responses are not requested again
+	load()
+	load()
+	if requests != 1 {
+		t.Errorf("Expects a single request while the schema is fresh, given %d", requests)
+	}
+
+	// expired responses are revalidated with their etag
+	cacheControl = "no-cache"
+	cacheDirectory = t.TempDir()
+	load()
+	load()
+	if requests != 3 || notModified != 1 {
+		t.Errorf("Expects the schema to be revalidated, given %d requests and %d not modified", requests, notModified)
+	}
+
+	// the cached schema is used when the server is down
+	server.Close()
+	load()
+}
+
+func TestHttpCacheExpiration(t *testing.T) {
+
+	tests := []struct {
+		cacheControl []string
+		stored       bool
+		fresh        bool
+	}{
+		{[]string{"max-age=60"}, true, true},
+		{[]string{"max-age=60, no-store"}, false, false},
+		{[]string{"max-age=60", "no-store"}, false, false},
+		{[]string{"max-age=60, no-cache"}, true, false},
+		{nil, true, false},
+	}
+
+	for _, test := range tests {
+		header := http.Header{"Cache-Control": test.cacheControl}
+		expires, stored := httpCacheExpiration(header)
+		if stored != test.stored {
+			t.Errorf("Expects %v to be stored %t", test.cacheControl, test.stored)
+		}
+		if fresh := expires.After(time.Now()); stored && fresh != test.fresh {
+			t.Errorf("Expects %v to be fresh %t", test.cacheControl, test.fresh)
+		}
+	}
+}
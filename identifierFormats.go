@@ -0,0 +1,143 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Format checkers for identifiers : uuids, uris, json pointers, regexes and durations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// RFC 3986 : a scheme, then unreserved, reserved or percent encoded characters
+	uriSchemeRegexp     = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*:`)
+	uriCharactersRegexp = regexp.MustCompile(`^([A-Za-z0-9\-._~:/?#\[\]@!$&'()*+,;=]|%[0-9A-Fa-f]{2})*$`)
+
+	// RFC 6570 : literals and {expressions}
+	uriTemplateLiteralRegexp    = regexp.MustCompile(`^([^\x00-\x20"'%<>\\^` + "`" + `{|}]|%[0-9A-Fa-f]{2})*$`)
+	uriTemplateExpressionRegexp = regexp.MustCompile(`^[+#./;?&=,!@|]?(([A-Za-z0-9_]|%[0-9A-Fa-f]{2})(\.?([A-Za-z0-9_]|%[0-9A-Fa-f]{2}))*(:[1-9][0-9]{0,3}|\*)?)(,([A-Za-z0-9_]|%[0-9A-Fa-f]{2})(\.?([A-Za-z0-9_]|%[0-9A-Fa-f]{2}))*(:[1-9][0-9]{0,3}|\*)?)*$`)
+
+	jsonPointerRegexp         = regexp.MustCompile(`^(/([^/~]|~[01])*)*$`)
+	relativeJsonPointerRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*)(#|(/([^/~]|~[01])*)*)$`)
+
+	// ISO 8601 durations, as in RFC 3339 appendix A
+	durationRegexp = regexp.MustCompile(`^P((\d+Y)?(\d+M)?(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?|\d+W)$`)
+)
+
+func checkUuidFormat(input string) error {
+	if !uuidRegexp.MatchString(input) {
+		return errors.New("expects 32 hexadecimal digits as 8-4-4-4-12")
+	}
+	return nil
+}
+
+// RFC 3986 absolute URIs
+func checkUriFormat(input string) error {
+	if !uriSchemeRegexp.MatchString(input) {
+		return errors.New("expects an absolute URI starting with a scheme")
+	}
+	return checkUriReferenceFormat(input)
+}
+
+// RFC 3986 URIs or relative references
+func checkUriReferenceFormat(input string) error {
+	if !uriCharactersRegexp.MatchString(input) {
+		return errors.New("has characters that must be percent encoded")
+	}
+	uri, err := url.Parse(input)
+	if err != nil {
+		return errors.New(strings.TrimPrefix(err.Error(), fmt.Sprintf("parse %q: ", input)))
+	}
+	// brackets only enclose an IPv6 host
+	if strings.ContainsAny(strings.Replace(input, "["+strings.Trim(uri.Host, "[]")+"]", "", 1), "[]") {
+		return errors.New("has brackets outside of an IPv6 host")
+	}
+	return nil
+}
+
+// RFC 6570 URI templates
+func checkUriTemplateFormat(input string) error {
+	for rest := input; rest != ""; {
+		open := strings.IndexByte(rest, '{')
+		literal := rest
+		if open != -1 {
+			literal = rest[:open]
+		}
+		if !uriTemplateLiteralRegexp.MatchString(literal) {
+			return errors.New(fmt.Sprintf("literal %s has characters that must be percent encoded", literal))
+		}
+		if open == -1 {
+			break
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end == -1 {
+			return errors.New("expression is not closed by }")
+		}
+		expression := rest[open+1 : open+end]
+		if !uriTemplateExpressionRegexp.MatchString(expression) {
+			return errors.New(fmt.Sprintf("expression {%s} is not valid", expression))
+		}
+		rest = rest[open+end+1:]
+	}
+	return nil
+}
+
+// RFC 6901 JSON pointers
+func checkJsonPointerFormat(input string) error {
+	if !jsonPointerRegexp.MatchString(input) {
+		return errors.New("expects / separated tokens where ~ is escaped as ~0 and / as ~1")
+	}
+	return nil
+}
+
+func checkRelativeJsonPointerFormat(input string) error {
+	if !relativeJsonPointerRegexp.MatchString(input) {
+		return errors.New("expects a non negative integer followed by # or a JSON pointer")
+	}
+	return nil
+}
+
+// ECMA 262 regular expressions
+func checkRegexFormat(input string) error {
+	goPattern, err := ecmaToGoRegexp(input)
+	if err != nil {
+		return err
+	}
+	_, err = regexp.Compile(goPattern)
+	return err
+}
+
+// ISO 8601 durations, ex: P3Y6M4DT12H30M5S
+func checkDurationFormat(input string) error {
+	if !durationRegexp.MatchString(input) || input == "P" || strings.HasSuffix(input, "T") {
+		return errors.New("expects an ISO 8601 duration as PnYnMnDTnHnMnS or PnW")
+	}
+	return nil
+}
@@ -0,0 +1,560 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generates random documents matching a schema.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"time"
+)
+
+const (
+	// candidates generated for a schema before giving up
+	GENERATE_MAX_ATTEMPTS = 100
+	// depth from which optional properties and items are no longer generated
+	GENERATE_MAX_DEPTH = 8
+
+	// range of the numbers without bounds, and extra length of the strings and arrays without maximum
+	generateNumberRange = 100
+	generateExtraLength = 8
+	generateExtraItems  = 3
+
+	generateCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Generate returns a random document matching the schema, for fuzzing and test fixtures
+// Each value is checked against its schema and generated again when it does not match,
+// an error is returned when no matching value is found ( contradicting or too restrictive schemas )
+func (d *JsonSchemaDocument) Generate(r *rand.Rand) (interface{}, error) {
+	options := *d.getOptions()
+	options.useDefaults = false
+	options.removeAdditional = false
+	g := instanceGenerator{rand: r, options: &options}
+	return g.generate(d.rootSchema, 0)
+}
+
+type instanceGenerator struct {
+	rand    *rand.Rand
+	options *validationOptions
+}
+
+// generate returns a value matching the schema
+func (g *instanceGenerator) generate(schema *jsonSchema, depth int) (interface{}, error) {
+
+	if depth > 2*GENERATE_MAX_DEPTH {
+		return nil, errors.New(fmt.Sprintf("schema %s is too deep to generate a value", schema.pointer))
+	}
+
+	for attempt := 0; attempt != GENERATE_MAX_ATTEMPTS; attempt++ {
+		candidate, err := g.candidate(schema, depth)
+		if err != nil {
+			return nil, err
+		}
+		if schema.Validate(candidate, consJsonContext(DEFAULT_ROOT_NAME, nil), g.options).IsValid() {
+			return candidate, nil
+		}
+	}
+
+	return nil, errors.New(fmt.Sprintf("could not generate a value matching the schema %s", schema.pointer))
+}
+
+// candidate returns a value that should match the schema, not and oneOf exclusions are left to the caller check
+func (g *instanceGenerator) candidate(schema *jsonSchema, depth int) (interface{}, error) {
+
+	if schema.refSchema != nil {
+		return g.generate(schema.refSchema, depth+1)
+	}
+
+	if len(schema.enum) > 0 {
+		var value interface{}
+		err := json.Unmarshal([]byte(schema.enum[g.rand.Intn(len(schema.enum))]), &value)
+		return value, err
+	}
+
+	sources := generateSources(schema)
+	schemaType := g.chooseType(sources)
+
+	// the type is left to a oneOf / anyOf branch
+	if schemaType == "" {
+		branches := schema.oneOf
+		if len(branches) == 0 {
+			branches = schema.anyOf
+		}
+		if len(branches) > 0 {
+			return g.generate(branches[g.rand.Intn(len(branches))], depth+1)
+		}
+		schemaType = []string{TYPE_NULL, TYPE_BOOLEAN, TYPE_INTEGER, TYPE_STRING}[g.rand.Intn(4)]
+	}
+
+	switch schemaType {
+	case TYPE_OBJECT:
+		return g.generateObject(sources, depth)
+	case TYPE_ARRAY:
+		return g.generateArray(sources, depth)
+	case TYPE_STRING:
+		return g.generateString(sources), nil
+	case TYPE_INTEGER, TYPE_NUMBER:
+		return g.generateNumber(sources, schemaType == TYPE_INTEGER), nil
+	case TYPE_BOOLEAN:
+		return g.rand.Intn(2) == 1, nil
+	}
+	return nil, nil
+}
+
+// generateSources returns the schema and the schemas of its allOf, their keywords all apply to the value
+func generateSources(schema *jsonSchema) []*jsonSchema {
+	for schema.refSchema != nil {
+		schema = schema.refSchema
+	}
+	sources := []*jsonSchema{schema}
+	for _, allOf := range schema.allOf {
+		sources = append(sources, generateSources(allOf)...)
+	}
+	return sources
+}
+
+// chooseType picks one of the types allowed by every source, inferred from the keywords
+// when no type is given, empty when nothing tells the type
+func (g *instanceGenerator) chooseType(sources []*jsonSchema) string {
+
+	var types []string
+	for _, source := range sources {
+		if !source.types.HasTypeInSchema() {
+			continue
+		}
+		if types == nil {
+			types = source.types.types
+			continue
+		}
+		var allowed []string
+		for _, t := range types {
+			if source.types.HasType(t) || (t == TYPE_INTEGER && source.types.HasType(TYPE_NUMBER)) {
+				allowed = append(allowed, t)
+			}
+		}
+		types = allowed
+	}
+	if len(types) > 0 {
+		return types[g.rand.Intn(len(types))]
+	}
+
+	for _, source := range sources {
+		switch {
+		case len(source.propertiesChildren) > 0 || len(source.required) > 0 || source.minProperties != nil:
+			return TYPE_OBJECT
+		case len(source.itemsChildren) > 0 || source.minItems != nil:
+			return TYPE_ARRAY
+		case source.pattern != nil || source.format != nil || source.minLength != nil:
+			return TYPE_STRING
+		case source.minimum != nil || source.maximum != nil || source.multipleOf != nil:
+			return TYPE_NUMBER
+		}
+	}
+	return ""
+}
+
+func (g *instanceGenerator) generateObject(sources []*jsonSchema, depth int) (interface{}, error) {
+
+	properties := make(map[string]*jsonSchema)
+	var names, required []string
+	minProperties, maxProperties := 0, -1
+	additionalAllowed := true
+	var additionalSchema *jsonSchema
+	for _, source := range sources {
+		for _, property := range source.propertiesChildren {
+			if _, ok := properties[property.property]; !ok {
+				properties[property.property] = property
+				names = append(names, property.property)
+			}
+		}
+		for _, name := range source.required {
+			if !isStringInSlice(required, name) {
+				required = append(required, name)
+			}
+		}
+		if source.minProperties != nil && *source.minProperties > minProperties {
+			minProperties = *source.minProperties
+		}
+		if source.maxProperties != nil && (maxProperties < 0 || *source.maxProperties < maxProperties) {
+			maxProperties = *source.maxProperties
+		}
+		switch additionalProperties := source.additionalProperties.(type) {
+		case bool:
+			additionalAllowed = additionalAllowed && additionalProperties
+		case *jsonSchema:
+			additionalSchema = additionalProperties
+		}
+	}
+
+	// required properties, then optional ones in a random order
+	include := append([]string(nil), required...)
+	for _, i := range g.rand.Perm(len(names)) {
+		if !isStringInSlice(include, names[i]) && depth < GENERATE_MAX_DEPTH && g.rand.Intn(2) == 0 {
+			include = append(include, names[i])
+		}
+	}
+	for _, i := range g.rand.Perm(len(names)) {
+		if len(include) >= minProperties {
+			break
+		}
+		if !isStringInSlice(include, names[i]) {
+			include = append(include, names[i])
+		}
+	}
+	for i := 0; len(include) < minProperties && additionalAllowed; i++ {
+		if name := fmt.Sprintf("property%d", i); !isStringInSlice(include, name) {
+			include = append(include, name)
+		}
+	}
+	if maxProperties >= 0 && len(include) > maxProperties && maxProperties >= len(required) {
+		include = include[:maxProperties]
+	}
+
+	// property dependencies
+	for i := 0; i < len(include); i++ {
+		for _, source := range sources {
+			dependency, _ := source.dependencies[include[i]].([]string)
+			for _, name := range dependency {
+				if !isStringInSlice(include, name) {
+					include = append(include, name)
+				}
+			}
+		}
+	}
+
+	object := make(map[string]interface{}, len(include))
+	for _, name := range include {
+		var value interface{}
+		var err error
+		if property, ok := properties[name]; ok {
+			value, err = g.generate(property, depth+1)
+		} else if additionalSchema != nil {
+			value, err = g.generate(additionalSchema, depth+1)
+		} else {
+			value = g.generateString(nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		object[name] = value
+	}
+
+	return object, nil
+}
+
+func (g *instanceGenerator) generateArray(sources []*jsonSchema, depth int) (interface{}, error) {
+
+	minItems, maxItems := 0, -1
+	unique := false
+	var items []*jsonSchema
+	tuple := false
+	var additionalItems interface{}
+	for _, source := range sources {
+		if source.minItems != nil && *source.minItems > minItems {
+			minItems = *source.minItems
+		}
+		if source.maxItems != nil && (maxItems < 0 || *source.maxItems < maxItems) {
+			maxItems = *source.maxItems
+		}
+		unique = unique || source.uniqueItems
+		if items == nil && len(source.itemsChildren) > 0 {
+			items = source.itemsChildren
+			tuple = !source.itemsChildrenIsSingleSchema
+			additionalItems = source.additionalItems
+		}
+	}
+	if tuple && additionalItems == false && (maxItems < 0 || len(items) < maxItems) {
+		maxItems = len(items)
+	}
+	if maxItems < 0 {
+		maxItems = minItems + generateExtraItems
+	}
+	if depth >= GENERATE_MAX_DEPTH || maxItems < minItems {
+		maxItems = minItems
+	}
+
+	length := minItems + g.rand.Intn(maxItems-minItems+1)
+	array := make([]interface{}, 0, length)
+	generated := make(map[string]bool)
+
+	for len(array) != length {
+		var itemSchema *jsonSchema
+		switch {
+		case tuple && len(array) < len(items):
+			itemSchema = items[len(array)]
+		case tuple:
+			itemSchema, _ = additionalItems.(*jsonSchema)
+		case len(items) > 0:
+			itemSchema = items[0]
+		}
+
+		var item interface{}
+		var err error
+		for attempt := 0; attempt != GENERATE_MAX_ATTEMPTS; attempt++ {
+			if itemSchema != nil {
+				item, err = g.generate(itemSchema, depth+1)
+			} else {
+				item = g.generateString(nil)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !unique {
+				break
+			}
+			if itemJson, _ := marshalToString(item); !generated[*itemJson] {
+				generated[*itemJson] = true
+				break
+			}
+		}
+		array = append(array, item)
+	}
+
+	return array, nil
+}
+
+func (g *instanceGenerator) generateString(sources []*jsonSchema) string {
+
+	minLength, maxLength := 0, -1
+	for _, source := range sources {
+		if source.minLength != nil && *source.minLength > minLength {
+			minLength = *source.minLength
+		}
+		if source.maxLength != nil && (maxLength < 0 || *source.maxLength < maxLength) {
+			maxLength = *source.maxLength
+		}
+	}
+
+	for _, source := range sources {
+		if source.pattern != nil {
+			if re, err := syntax.Parse(re2Pattern(source.pattern), syntax.Perl); err == nil {
+				return g.generateRegexp(re.Simplify())
+			}
+		}
+	}
+	for _, source := range sources {
+		if source.format != nil {
+			if value, ok := g.generateFormat(*source.format); ok {
+				return value
+			}
+		}
+	}
+
+	if maxLength < 0 {
+		maxLength = minLength + generateExtraLength
+	}
+	if maxLength < minLength {
+		maxLength = minLength
+	}
+	return g.randomString(minLength + g.rand.Intn(maxLength-minLength+1))
+}
+
+func (g *instanceGenerator) randomString(length int) string {
+	var s strings.Builder
+	for i := 0; i != length; i++ {
+		s.WriteByte(generateCharacters[g.rand.Intn(len(generateCharacters))])
+	}
+	return s.String()
+}
+
+// generateRegexp returns a string matched by a regular expression
+func (g *instanceGenerator) generateRegexp(re *syntax.Regexp) string {
+
+	switch re.Op {
+
+	case syntax.OpLiteral:
+		return string(re.Rune)
+
+	case syntax.OpCharClass:
+		return string(g.generateRune(re.Rune))
+
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return string(generateCharacters[g.rand.Intn(len(generateCharacters))])
+
+	case syntax.OpCapture:
+		return g.generateRegexp(re.Sub[0])
+
+	case syntax.OpConcat:
+		var s strings.Builder
+		for _, sub := range re.Sub {
+			s.WriteString(g.generateRegexp(sub))
+		}
+		return s.String()
+
+	case syntax.OpAlternate:
+		return g.generateRegexp(re.Sub[g.rand.Intn(len(re.Sub))])
+
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+		if max < 0 {
+			max = min + generateExtraItems
+		}
+		var s strings.Builder
+		for i := min + g.rand.Intn(max-min+1); i != 0; i-- {
+			s.WriteString(g.generateRegexp(re.Sub[0]))
+		}
+		return s.String()
+	}
+
+	// anchors, word boundaries and empty matches
+	return ""
+}
+
+// generateRune picks a rune in a character class, printable ascii runes are preferred
+func (g *instanceGenerator) generateRune(ranges []rune) rune {
+
+	var printable []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < ' ' {
+			lo = ' '
+		}
+		if hi > '~' {
+			hi = '~'
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+	if len(ranges) < 2 {
+		return 'a'
+	}
+
+	i := 2 * g.rand.Intn(len(ranges)/2)
+	return ranges[i] + rune(g.rand.Intn(int(ranges[i+1]-ranges[i])+1))
+}
+
+// generateFormat returns a string of a known format
+func (g *instanceGenerator) generateFormat(format string) (string, bool) {
+
+	date := time.Date(1970+g.rand.Intn(100), time.Month(1+g.rand.Intn(12)), 1+g.rand.Intn(28), g.rand.Intn(24), g.rand.Intn(60), g.rand.Intn(60), 0, time.UTC)
+	word := strings.ToLower(g.randomString(1 + g.rand.Intn(generateExtraLength)))
+
+	switch format {
+	case "date-time":
+		return date.Format(time.RFC3339), true
+	case "date":
+		return date.Format("2006-01-02"), true
+	case "time":
+		return date.Format("15:04:05Z"), true
+	case "email", "idn-email":
+		return word + "@example.com", true
+	case "hostname", "idn-hostname":
+		return word + ".example.com", true
+	case "ipv4":
+		return fmt.Sprintf("%d.%d.%d.%d", g.rand.Intn(256), g.rand.Intn(256), g.rand.Intn(256), g.rand.Intn(256)), true
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x:%x", g.rand.Intn(0x10000), g.rand.Intn(0x10000)), true
+	case "uuid":
+		return fmt.Sprintf("%08x-%04x-4%03x-%04x-%012x", g.rand.Uint32(), g.rand.Intn(0x10000), g.rand.Intn(0x1000), 0x8000|g.rand.Intn(0x4000), g.rand.Int63n(1<<48)), true
+	case "uri":
+		return "https://example.com/" + word, true
+	case "uri-reference":
+		return "/" + word, true
+	case "json-pointer":
+		return "/" + word, true
+	case "duration":
+		return fmt.Sprintf("P%dD", 1+g.rand.Intn(30)), true
+	}
+	return "", false
+}
+
+func (g *instanceGenerator) generateNumber(sources []*jsonSchema, integer bool) float64 {
+
+	lo, hi := math.Inf(-1), math.Inf(1)
+	var multipleOf *float64
+	for _, source := range sources {
+		if source.minimum != nil && *source.minimum >= lo {
+			lo = *source.minimum
+			if source.exclusiveMinimum {
+				lo = math.Nextafter(lo, math.Inf(1))
+			}
+		}
+		if source.maximum != nil && *source.maximum <= hi {
+			hi = *source.maximum
+			if source.exclusiveMaximum {
+				hi = math.Nextafter(hi, math.Inf(-1))
+			}
+		}
+		if source.exclusiveMinimumValue != nil && *source.exclusiveMinimumValue >= lo {
+			lo = math.Nextafter(*source.exclusiveMinimumValue, math.Inf(1))
+		}
+		if source.exclusiveMaximumValue != nil && *source.exclusiveMaximumValue <= hi {
+			hi = math.Nextafter(*source.exclusiveMaximumValue, math.Inf(-1))
+		}
+		if multipleOf == nil {
+			multipleOf = source.multipleOf
+		}
+	}
+
+	switch {
+	case math.IsInf(lo, -1) && math.IsInf(hi, 1):
+		lo, hi = -generateNumberRange, generateNumberRange
+	case math.IsInf(lo, -1):
+		lo = hi - generateNumberRange
+	case math.IsInf(hi, 1):
+		hi = lo + generateNumberRange
+	}
+
+	step := 0.0
+	if integer {
+		step = 1
+	}
+	if multipleOf != nil {
+		step = *multipleOf
+		if integer && step != math.Trunc(step) {
+			step = step * math.Ceil(1/step)
+		}
+	}
+
+	if step > 0 {
+		kLo, kHi := math.Ceil(lo/step), math.Floor(hi/step)
+		if kHi < kLo {
+			return lo
+		}
+		return (kLo + math.Floor(g.rand.Float64()*(kHi-kLo+1))) * step
+	}
+
+	// two decimals are enough and keep the documents readable
+	value := math.Round((lo+g.rand.Float64()*(hi-lo))*100) / 100
+	return math.Max(lo, math.Min(hi, value))
+}
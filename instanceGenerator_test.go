@@ -0,0 +1,81 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the generation of random documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, `{
+		"definitions":{"tag":{"type":"string","pattern":"^[a-z]{2,5}(-[0-9]+)?$"}},
+		"type":"object",
+		"required":["id","name","kind","tags","price"],
+		"properties":{
+			"id":{"type":"string","format":"uuid"},
+			"name":{"type":"string","minLength":3,"maxLength":10},
+			"kind":{"enum":["a","b",{"c":1}]},
+			"tags":{"type":"array","items":{"$ref":"#/definitions/tag"},"minItems":1,"uniqueItems":true},
+			"price":{"type":"number","minimum":0,"exclusiveMinimum":true,"maximum":10},
+			"quantity":{"type":"integer","multipleOf":5,"minimum":12,"maximum":40},
+			"created":{"type":"string","format":"date-time"},
+			"contact":{"oneOf":[{"type":"string","format":"email"},{"type":"integer"}]},
+			"size":{"allOf":[{"type":"object","properties":{"w":{"type":"integer"}},"required":["w"]},{"required":["h"],"properties":{"h":{"type":"number","maximum":-1}}}]},
+			"point":{"type":"array","items":[{"type":"number"},{"type":"number"}],"additionalItems":false,"minItems":2}
+		},
+		"dependencies":{"quantity":["created"]},
+		"additionalProperties":false
+	}`))
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i != 200; i++ {
+		document, err := schemaDocument.Generate(r)
+		if err != nil {
+			t.Fatalf("Could not generate a document : %s", err.Error())
+		}
+		if result := schemaDocument.Validate(document); !result.IsValid() {
+			t.Fatalf("Expects the generated document %v to be valid, given %v", document, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestGenerateImpossible(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, `{"properties":{"a":{"type":"string","not":{"type":"string"}}},"required":["a"]}`))
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	_, err = schemaDocument.Generate(rand.New(rand.NewSource(1)))
+	if err == nil || err.Error() != "could not generate a value matching the schema /properties/a" {
+		t.Errorf("Expects an error for a schema no value matches, given %v", err)
+	}
+}
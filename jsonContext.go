@@ -24,16 +24,26 @@
 
 package gojsonschema
 
-import "bytes"
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
 
 // jsonContext implements a persistent linked-list of strings
 type jsonContext struct {
 	head string
 	tail *jsonContext
+	// the head is an array index
+	index bool
 }
 
 func consJsonContext(head string, tail *jsonContext) *jsonContext {
-	return &jsonContext{head, tail}
+	return &jsonContext{head: head, tail: tail}
+}
+
+func consJsonIndexContext(index int, tail *jsonContext) *jsonContext {
+	return &jsonContext{head: strconv.Itoa(index), tail: tail, index: true}
 }
 
 // String displays the context in reverse.
@@ -50,7 +60,10 @@ func (c *jsonContext) String() string {
 func (c *jsonContext) stringLen() int {
 	length := 0
 	if c.tail != nil {
-		length = c.tail.stringLen() + 1 // add 1 for "."
+		length = c.tail.stringLen()
+		if !c.tail.isUnnamedRoot() {
+			length++ // add 1 for "."
+		}
 	}
 
 	length += len(c.head)
@@ -60,12 +73,74 @@ func (c *jsonContext) stringLen() int {
 func (c *jsonContext) writeStringToBuffer(buf *bytes.Buffer) {
 	if c.tail != nil {
 		c.tail.writeStringToBuffer(buf)
-		buf.WriteString(".")
+		if !c.tail.isUnnamedRoot() {
+			buf.WriteString(".")
+		}
 	}
 
 	buf.WriteString(c.head)
 }
-	
-	
-	
 
+// The root of a document whose name is empty, see SetRootName
+func (c *jsonContext) isUnnamedRoot() bool {
+	return c.tail == nil && c.head == ""
+}
+
+// pointer returns the json pointer of the context, ex: /items/0 for ROOT.items.0
+func (c *jsonContext) pointer() string {
+	if c == nil {
+		return ""
+	}
+	var pointer strings.Builder
+	for _, token := range c.tokens()[1:] {
+		pointer.WriteString("/")
+		pointer.WriteString(escapeJsonPointerToken(token))
+	}
+	return pointer.String()
+}
+
+// path returns the elements of the context after the root, the array indexes as int
+func (c *jsonContext) path() []interface{} {
+	var path []interface{}
+	for current := c; current != nil && current.tail != nil; current = current.tail {
+		var element interface{} = current.head
+		if current.index {
+			element, _ = strconv.Atoi(current.head)
+		}
+		path = append([]interface{}{element}, path...)
+	}
+	return path
+}
+
+// tokens returns the elements of the context, from the root to the head
+func (c *jsonContext) tokens() []string {
+	var tokens []string
+	for current := c; current != nil; current = current.tail {
+		tokens = append([]string{current.head}, tokens...)
+	}
+	return tokens
+}
+
+// compare orders two contexts element by element, array indexes are compared
+// numerically so that ROOT.2 comes before ROOT.10
+func (c *jsonContext) compare(other *jsonContext) int {
+	a, b := c.tokens(), other.tokens()
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		ai, errA := strconv.Atoi(a[i])
+		bi, errB := strconv.Atoi(b[i])
+		if errA == nil && errB == nil && ai != bi {
+			if ai < bi {
+				return -1
+			}
+			return 1
+		}
+		if a[i] < b[i] {
+			return -1
+		}
+		return 1
+	}
+	return len(a) - len(b)
+}
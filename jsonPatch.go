@@ -0,0 +1,310 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Applies patches ( RFC 6902 ) and merge patches ( RFC 7396 ) to json documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	JSON_PATCH_ADD     = "add"
+	JSON_PATCH_REMOVE  = "remove"
+	JSON_PATCH_REPLACE = "replace"
+	JSON_PATCH_MOVE    = "move"
+	JSON_PATCH_COPY    = "copy"
+	JSON_PATCH_TEST    = "test"
+)
+
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  *string     `json:"path"`
+	From  *string     `json:"from"`
+	Value interface{} `json:"value"`
+}
+
+// parseJsonPatch decodes a json patch document, a list of operations
+func parseJsonPatch(patch []byte) ([]jsonPatchOperation, error) {
+
+	var operations []jsonPatchOperation
+	err := json.Unmarshal(patch, &operations)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, operation := range operations {
+		if operation.Path == nil {
+			return nil, errors.New(fmt.Sprintf("json patch operation %d has no path", i))
+		}
+		if (operation.Op == JSON_PATCH_MOVE || operation.Op == JSON_PATCH_COPY) && operation.From == nil {
+			return nil, errors.New(fmt.Sprintf("json patch operation %d has no from", i))
+		}
+	}
+
+	return operations, nil
+}
+
+// applyJsonPatch applies the operations to a copy of the document
+// Returns the patched document and the pointers of the changed values
+func applyJsonPatch(document interface{}, operations []jsonPatchOperation) (interface{}, []string, error) {
+
+	patched := copyJson(document)
+	var changed []string
+
+	for i, operation := range operations {
+
+		path, err := parseJsonPointerTokens(*operation.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch operation.Op {
+
+		case JSON_PATCH_ADD:
+			patched, err = jsonPatchAdd(patched, path, copyJson(operation.Value))
+			changed = append(changed, *operation.Path)
+
+		case JSON_PATCH_REMOVE:
+			patched, _, err = jsonPatchRemove(patched, path)
+			changed = append(changed, *operation.Path)
+
+		case JSON_PATCH_REPLACE:
+			patched, _, err = jsonPatchRemove(patched, path)
+			if err == nil {
+				patched, err = jsonPatchAdd(patched, path, copyJson(operation.Value))
+			}
+			changed = append(changed, *operation.Path)
+
+		case JSON_PATCH_MOVE, JSON_PATCH_COPY:
+			var from []string
+			from, err = parseJsonPointerTokens(*operation.From)
+			if err != nil {
+				return nil, nil, err
+			}
+			var value interface{}
+			if operation.Op == JSON_PATCH_MOVE {
+				if strings.HasPrefix(*operation.Path+"/", *operation.From+"/") && *operation.Path != *operation.From {
+					return nil, nil, errors.New(fmt.Sprintf("json patch operation %d moves a value into one of its children", i))
+				}
+				patched, value, err = jsonPatchRemove(patched, from)
+				changed = append(changed, *operation.From)
+			} else {
+				value, err = jsonPatchGet(patched, from)
+				value = copyJson(value)
+			}
+			if err == nil {
+				patched, err = jsonPatchAdd(patched, path, value)
+			}
+			changed = append(changed, *operation.Path)
+
+		case JSON_PATCH_TEST:
+			var value interface{}
+			value, err = jsonPatchGet(patched, path)
+			if err == nil {
+				var equal bool
+				equal, err = jsonEquals(value, operation.Value)
+				if err == nil && !equal {
+					err = errors.New(fmt.Sprintf("value at %s is not the tested value", *operation.Path))
+				}
+			}
+
+		default:
+			err = errors.New(fmt.Sprintf("unknown json patch operation %s", operation.Op))
+		}
+
+		if err != nil {
+			return nil, nil, errors.New(fmt.Sprintf("json patch operation %d failed : %s", i, err.Error()))
+		}
+	}
+
+	return patched, changed, nil
+}
+
+// jsonEquals compares two json values, the numbers by their value
+func jsonEquals(a interface{}, b interface{}) (bool, error) {
+	aString, err := marshalToCanonicalString(a)
+	if err != nil {
+		return false, err
+	}
+	bString, err := marshalToCanonicalString(b)
+	if err != nil {
+		return false, err
+	}
+	return *aString == *bString, nil
+}
+
+// jsonPatchIndex parses an array index token, "-" is the end of the array
+func jsonPatchIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, errors.New(fmt.Sprintf("%s is not a valid array index", token))
+	}
+	if index > length || (index == length && !allowEnd) {
+		return 0, errors.New(fmt.Sprintf("array index %d is out of bounds", index))
+	}
+	return index, nil
+}
+
+// jsonPatchGet returns the value at path
+func jsonPatchGet(node interface{}, path []string) (interface{}, error) {
+
+	for _, token := range path {
+		switch container := node.(type) {
+		case map[string]interface{}:
+			value, ok := container[token]
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("property %s does not exist", token))
+			}
+			node = value
+		case []interface{}:
+			index, err := jsonPatchIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+			node = container[index]
+		default:
+			return nil, errors.New(fmt.Sprintf("cannot access %s in a simple value", token))
+		}
+	}
+
+	return node, nil
+}
+
+// jsonPatchUpdate calls update on the container of the last token of path,
+// and returns node with the updated container in place
+func jsonPatchUpdate(node interface{}, path []string, update func(container interface{}, token string) (interface{}, error)) (interface{}, error) {
+
+	if len(path) == 1 {
+		return update(node, path[0])
+	}
+
+	child, err := jsonPatchGet(node, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	child, err = jsonPatchUpdate(child, path[1:], update)
+	if err != nil {
+		return nil, err
+	}
+
+	switch container := node.(type) {
+	case map[string]interface{}:
+		container[path[0]] = child
+	case []interface{}:
+		index, _ := jsonPatchIndex(path[0], len(container), false)
+		container[index] = child
+	}
+	return node, nil
+}
+
+// jsonPatchAdd adds or replaces the value at path
+func jsonPatchAdd(node interface{}, path []string, value interface{}) (interface{}, error) {
+
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return jsonPatchUpdate(node, path, func(container interface{}, token string) (interface{}, error) {
+		switch container := container.(type) {
+		case map[string]interface{}:
+			container[token] = value
+			return container, nil
+		case []interface{}:
+			index, err := jsonPatchIndex(token, len(container), true)
+			if err != nil {
+				return nil, err
+			}
+			container = append(container, nil)
+			copy(container[index+1:], container[index:])
+			container[index] = value
+			return container, nil
+		}
+		return nil, errors.New(fmt.Sprintf("cannot add %s to a simple value", token))
+	})
+}
+
+// jsonPatchRemove removes the value at path, and returns it
+func jsonPatchRemove(node interface{}, path []string) (interface{}, interface{}, error) {
+
+	if len(path) == 0 {
+		return nil, node, nil
+	}
+
+	var removed interface{}
+	node, err := jsonPatchUpdate(node, path, func(container interface{}, token string) (interface{}, error) {
+		switch container := container.(type) {
+		case map[string]interface{}:
+			value, ok := container[token]
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("property %s does not exist", token))
+			}
+			removed = value
+			delete(container, token)
+			return container, nil
+		case []interface{}:
+			index, err := jsonPatchIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+			removed = container[index]
+			return append(container[:index], container[index+1:]...), nil
+		}
+		return nil, errors.New(fmt.Sprintf("cannot remove %s from a simple value", token))
+	})
+
+	return node, removed, err
+}
+
+// applyJsonMergePatch merges patch into a copy of the document, null values
+// of the patch remove properties
+func applyJsonMergePatch(document interface{}, patch interface{}) interface{} {
+
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return copyJson(patch)
+	}
+
+	merged, ok := copyJson(document).(map[string]interface{})
+	if !ok {
+		merged = make(map[string]interface{})
+	}
+
+	for key, value := range patchMap {
+		if value == nil {
+			delete(merged, key)
+		} else {
+			merged[key] = applyJsonMergePatch(merged[key], value)
+		}
+	}
+
+	return merged
+}
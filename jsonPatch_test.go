@@ -0,0 +1,134 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for json patches and merge patches.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestApplyJsonPatch(t *testing.T) {
+
+	tests := []struct {
+		document string
+		patch    string
+		expected string
+	}{
+		{`{"a":[1,2]}`, `[{"op":"add","path":"/a/1","value":3}]`, `{"a":[1,3,2]}`},
+		{`{"a":[1,2]}`, `[{"op":"add","path":"/a/-","value":3}]`, `{"a":[1,2,3]}`},
+		{`{"a":[1,2]}`, `[{"op":"remove","path":"/a/0"}]`, `{"a":[2]}`},
+		{`{"a":{"b":1}}`, `[{"op":"replace","path":"/a/b","value":[]}]`, `{"a":{"b":[]}}`},
+		{`{"a":{"b":1},"c":{}}`, `[{"op":"move","from":"/a/b","path":"/c/d"}]`, `{"a":{},"c":{"d":1}}`},
+		{`{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"add","path":"/c/x","value":2}]`, `{"a":{"b":1},"c":{"b":1,"x":2}}`},
+		{`{"a/b":1}`, `[{"op":"test","path":"/a~1b","value":1},{"op":"replace","path":"","value":true}]`, `true`},
+	}
+
+	for _, test := range tests {
+		operations, err := parseJsonPatch([]byte(test.patch))
+		if err != nil {
+			t.Errorf("Could not parse %s : %s", test.patch, err.Error())
+			continue
+		}
+		patched, _, err := applyJsonPatch(mustParseJson(t, test.document), operations)
+		if err != nil {
+			t.Errorf("Could not apply %s : %s", test.patch, err.Error())
+			continue
+		}
+		if given := *mustMarshalToString(t, patched); given != test.expected {
+			t.Errorf("Expects %s for %s, given %s", test.expected, test.patch, given)
+		}
+	}
+
+	failures := []string{
+		`[{"op":"replace","path":"/missing","value":1}]`,
+		`[{"op":"add","path":"/a/3","value":1}]`,
+		`[{"op":"remove","path":"/a/01"}]`,
+		`[{"op":"move","from":"/a","path":"/a/0"}]`,
+		`[{"op":"test","path":"/a","value":[2,1]}]`,
+		`[{"op":"unknown","path":"/a"}]`,
+		`[{"op":"copy","path":"/b"}]`,
+	}
+
+	for _, patch := range failures {
+		operations, err := parseJsonPatch([]byte(patch))
+		if err == nil {
+			_, _, err = applyJsonPatch(mustParseJson(t, `{"a":[1,2]}`), operations)
+		}
+		if err == nil {
+			t.Errorf("Expects %s to be an error", patch)
+		}
+	}
+}
+
+func TestApplyJsonMergePatch(t *testing.T) {
+
+	// examples from RFC 7396
+	tests := []struct {
+		document string
+		patch    string
+		expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, test := range tests {
+		merged := applyJsonMergePatch(mustParseJson(t, test.document), mustParseJson(t, test.patch))
+		if given := *mustMarshalToString(t, merged); given != test.expected {
+			t.Errorf("Expects %s for %s, given %s", test.expected, test.patch, given)
+		}
+	}
+}
+
+func TestValidateMergePatch(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["name"],"properties":{"port":{"type":"integer"}}}`)
+	document := mustParseJson(t, `{"name":"x","port":80}`)
+
+	merged, result, err := schemaDocument.ValidateMergePatch(document, []byte(`{"name":null,"port":"80"}`))
+	if err != nil {
+		t.Fatalf("Could not validate : %s", err.Error())
+	}
+	if given := *mustMarshalToString(t, merged); given != `{"port":"80"}` {
+		t.Errorf("Expects the merged document, given %s", given)
+	}
+	if len(result.GetErrors()) != 2 {
+		t.Errorf("Expects 2 errors, given %v", result.GetErrorMessages())
+	}
+	if given := *mustMarshalToString(t, document); given != `{"name":"x","port":80}` {
+		t.Errorf("Expects the original document to be untouched, given %s", given)
+	}
+}
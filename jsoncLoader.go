@@ -0,0 +1,152 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Loads hand-written json with comments and trailing commas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+)
+
+// Helper function to read a json with comments ( // and /* */ ) and trailing
+// commas from bytes
+func GetJsoncDocument(content []byte) (interface{}, error) {
+
+	stripped, err := stripJsonComments(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var document interface{}
+	err = json.Unmarshal(stripped, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// Helper function to read a json with comments and trailing commas from a filepath
+func GetFileJsonc(filepath string) (interface{}, error) {
+
+	bodyBuff, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetJsoncDocument(bodyBuff)
+}
+
+// isJsoncFilename tells if a file should be loaded as json with comments
+func isJsoncFilename(filename string) bool {
+	lowerFilename := strings.ToLower(filename)
+	return strings.HasSuffix(lowerFilename, ".jsonc") || strings.HasSuffix(lowerFilename, ".json5")
+}
+
+// stripJsonComments removes comments and trailing commas, leaving strings untouched
+// Comments are replaced by spaces so that offsets in decoding errors still match
+func stripJsonComments(content []byte) ([]byte, error) {
+
+	stripped := make([]byte, 0, len(content))
+
+	// position in stripped of a comma that may turn out to be trailing
+	pendingComma := -1
+	// last character that is neither a space nor part of a comment
+	var lastSignificant byte
+
+	for i := 0; i < len(content); i++ {
+
+		c := content[i]
+
+		switch {
+
+		case c == '"':
+			pendingComma = -1
+			start := i
+			for i++; i < len(content) && content[i] != '"'; i++ {
+				if content[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(content) {
+				return nil, errors.New("unterminated string")
+			}
+			stripped = append(stripped, content[start:i+1]...)
+			lastSignificant = c
+
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for ; i < len(content) && content[i] != '\n'; i++ {
+				stripped = append(stripped, ' ')
+			}
+			if i < len(content) {
+				stripped = append(stripped, '\n')
+			}
+
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			end := strings.Index(string(content[i+2:]), "*/")
+			if end < 0 {
+				return nil, errors.New("unterminated comment")
+			}
+			for _, commented := range content[i : i+end+4] {
+				if commented == '\n' {
+					stripped = append(stripped, '\n')
+				} else {
+					stripped = append(stripped, ' ')
+				}
+			}
+			i += end + 3
+
+		case c == ',':
+			// only a comma following a value can be trailing
+			if lastSignificant != ',' && lastSignificant != '[' && lastSignificant != '{' && lastSignificant != 0 {
+				pendingComma = len(stripped)
+			} else {
+				pendingComma = -1
+			}
+			stripped = append(stripped, c)
+			lastSignificant = c
+
+		case c == '}' || c == ']':
+			if pendingComma >= 0 {
+				stripped[pendingComma] = ' '
+			}
+			pendingComma = -1
+			stripped = append(stripped, c)
+			lastSignificant = c
+
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			stripped = append(stripped, c)
+
+		default:
+			pendingComma = -1
+			stripped = append(stripped, c)
+			lastSignificant = c
+		}
+	}
+
+	return stripped, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the json with comments loader.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestStripJsonComments(t *testing.T) {
+
+	tests := []struct {
+		content  string
+		expected string
+	}{
+		{"{\"a\":1, // one\n\"b\":[1,2,],}", `{"a":1,"b":[1,2]}`},
+		{`{/* "a":1, */ "b":"// not /* a comment */",}`, `{"b":"// not /* a comment */"}`},
+		{`{"c":"quote \" , }", /* , */ }`, `{"c":"quote \" , }"}`},
+		{`[1 , /* last */ ]`, `[1]`},
+	}
+
+	for _, test := range tests {
+		document, err := GetJsoncDocument([]byte(test.content))
+		if err != nil {
+			t.Errorf("Could not parse %q : %s", test.content, err.Error())
+			continue
+		}
+		if given := *mustMarshalToString(t, document); given != test.expected {
+			t.Errorf("Expects %s, given %s", test.expected, given)
+		}
+	}
+
+	for _, content := range []string{`{"a":1 /* never closed`, `{"a":"never closed}`, `[,]`} {
+		if _, err := GetJsoncDocument([]byte(content)); err == nil {
+			t.Errorf("Expects %q to be an error", content)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Hooks called around the evaluations of the keywords.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+// KeywordEvaluation is a keyword of a schema evaluated on a location of the document
+type KeywordEvaluation struct {
+	// json reference of the keyword, ex: schema.json#/properties/age/minimum
+	SchemaLocation string
+	// json pointer of the location in the document, ex: /age
+	InstancePointer string
+	Keyword         string
+	// value of the document at the location, it must not be modified
+	Value interface{}
+}
+
+// KeywordHook is called around the evaluation of each keyword, see SetKeywordHook
+// The keywords of a schema are announced together, before the schema is
+// evaluated on a location, the keywords of its sub-schemas are evaluated in
+// between. Its methods are called by the validating goroutines, concurrently
+type KeywordHook interface {
+	// Called before the keyword is evaluated, the returned function, if not
+	// nil, is called with the outcome of the evaluation
+	BeforeKeyword(evaluation KeywordEvaluation) func(valid bool)
+}
+
+// KeywordHookFunc adapts a function to a KeywordHook
+type KeywordHookFunc func(evaluation KeywordEvaluation) func(valid bool)
+
+func (f KeywordHookFunc) BeforeKeyword(evaluation KeywordEvaluation) func(valid bool) {
+	return f(evaluation)
+}
+
+// Calls hook around the keyword evaluations, for auditing or custom metrics
+// for example, nil stops the calls
+func (d *JsonSchemaDocument) SetKeywordHook(hook KeywordHook) {
+	d.setOptions(func(options *validationOptions) {
+		options.keywordHook = hook
+	})
+}
+
+func (v *ValidationResult) hooked() bool {
+	return v.options != nil && v.options.keywordHook != nil
+}
+
+// The outcome of a keyword evaluation, for its hook
+type keywordOutcome struct {
+	keyword string
+	after   func(valid bool)
+}
+
+// Calls the hook before the evaluation of the keywords of the schema on a
+// location, returns the functions to call with their outcome
+func (v *ValidationResult) beforeKeywords(schema *jsonSchema, value interface{}, context *jsonContext) []keywordOutcome {
+	var outcomes []keywordOutcome
+	location := (&SchemaNode{schema: schema}).Document() + "#" + schema.pointer
+	instancePointer := context.pointer()
+	for _, keyword := range schema.keywords() {
+		after := v.options.keywordHook.BeforeKeyword(KeywordEvaluation{
+			SchemaLocation:  location + "/" + escapeJsonPointerToken(keyword),
+			InstancePointer: instancePointer,
+			Keyword:         keyword,
+			Value:           value,
+		})
+		if after != nil {
+			outcomes = append(outcomes, keywordOutcome{keyword, after})
+		}
+	}
+	return outcomes
+}
+
+// Calls the functions returned by beforeKeywords, a keyword is invalid when it
+// failed since the evaluation started, failedFrom being the number of failures then
+func (v *ValidationResult) afterKeywords(outcomes []keywordOutcome, failedFrom int) {
+	failed := v.failedKeywords[failedFrom:]
+	for _, outcome := range outcomes {
+		outcome.after(!isStringInSlice(failed, outcome.keyword))
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the keyword hooks.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestKeywordHook(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["name"],"properties":{"age":{"type":"integer","minimum":0}}}`)
+
+	var announced []string
+	outcomes := map[string]bool{}
+	schemaDocument.SetKeywordHook(KeywordHookFunc(func(evaluation KeywordEvaluation) func(valid bool) {
+		key := evaluation.InstancePointer + " " + evaluation.SchemaLocation
+		announced = append(announced, key)
+		if evaluation.Keyword == KEY_MINIMUM && evaluation.Value.(float64) != -1 {
+			t.Errorf("Expects the value of the location, given %v", evaluation.Value)
+		}
+		return func(valid bool) {
+			outcomes[key] = valid
+		}
+	}))
+
+	schemaDocument.Validate(mustParseJson(t, `{"age":-1}`))
+
+	expected := map[string]bool{
+		" #/required":                   false,
+		" #/properties":                 false,
+		"/age #/properties/age/type":    true,
+		"/age #/properties/age/minimum": false,
+	}
+	if len(announced) != len(expected) || len(outcomes) != len(expected) {
+		t.Fatalf("Expects %d evaluations, given %v and %v", len(expected), announced, outcomes)
+	}
+	for key, valid := range expected {
+		if outcome, ok := outcomes[key]; !ok || outcome != valid {
+			t.Errorf("Expects %s to be valid %v, given %v", key, valid, outcomes)
+		}
+	}
+
+	schemaDocument.SetKeywordHook(nil)
+	announced = nil
+	schemaDocument.Validate(mustParseJson(t, `{"age":-1}`))
+	if len(announced) != 0 {
+		t.Errorf("Expects no call once the hook is removed, given %v", announced)
+	}
+}
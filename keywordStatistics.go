@@ -0,0 +1,129 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Counts of the failing keywords across validations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"sort"
+	"sync"
+)
+
+// KeywordStatistics counts the errors of many validations by schema location
+// and keyword, to see which constraints the documents fail most often
+// It is safe for concurrent use and may be shared by several schema documents
+type KeywordStatistics struct {
+	mutex       sync.Mutex
+	validations int64
+	counts      map[keywordFailure]int64
+}
+
+// KeywordFailureCount is the number of errors of a keyword of a schema
+type KeywordFailureCount struct {
+	// json reference of the schema, ex: schema.json#/properties/age
+	SchemaLocation string
+	Keyword        string
+	Count          int64
+}
+
+type keywordFailure struct {
+	schema  *jsonSchema
+	keyword string
+}
+
+func NewKeywordStatistics() *KeywordStatistics {
+	return &KeywordStatistics{counts: make(map[keywordFailure]int64)}
+}
+
+// Adds the errors of every validation to statistics, nil stops them
+func (d *JsonSchemaDocument) SetKeywordStatistics(statistics *KeywordStatistics) {
+	d.setOptions(func(options *validationOptions) {
+		options.keywordStatistics = statistics
+	})
+}
+
+// Validations returns the number of validations counted
+func (s *KeywordStatistics) Validations() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.validations
+}
+
+// Failures returns the error counts, the most frequent first
+func (s *KeywordStatistics) Failures() []KeywordFailureCount {
+	s.mutex.Lock()
+	failures := make([]KeywordFailureCount, 0, len(s.counts))
+	for failure, count := range s.counts {
+		failures = append(failures, KeywordFailureCount{
+			SchemaLocation: (&SchemaNode{schema: failure.schema}).Document() + "#" + failure.schema.pointer,
+			Keyword:        failure.keyword,
+			Count:          count,
+		})
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(failures, func(i, j int) bool {
+		if failures[i].Count != failures[j].Count {
+			return failures[i].Count > failures[j].Count
+		}
+		if failures[i].SchemaLocation != failures[j].SchemaLocation {
+			return failures[i].SchemaLocation < failures[j].SchemaLocation
+		}
+		return failures[i].Keyword < failures[j].Keyword
+	})
+	return failures
+}
+
+// Reset forgets the counts
+func (s *KeywordStatistics) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.validations = 0
+	s.counts = make(map[keywordFailure]int64)
+}
+
+func (s *KeywordStatistics) add(result *ValidationResult) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.validations++
+	for _, resultError := range result.resultErrors {
+		if resultError.schema != nil {
+			s.counts[keywordFailure{schema: resultError.schema, keyword: resultError.Keyword}]++
+		}
+	}
+}
+
+func (v *ValidationResult) countingFailures() bool {
+	return v.options != nil && v.options.keywordStatistics != nil
+}
+
+// Gives the errors added since errorsFrom, and not located yet, to schema :
+// the errors are located in the innermost schema validated
+func (v *ValidationResult) locateErrors(schema *jsonSchema, errorsFrom int) {
+	for _, resultError := range v.resultErrors[errorsFrom:] {
+		if resultError.schema == nil {
+			resultError.schema = schema
+		}
+	}
+}
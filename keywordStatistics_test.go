@@ -0,0 +1,73 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the keyword statistics.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestKeywordStatistics(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"age": {"$ref": "#/definitions/age"}
+		},
+		"definitions": {"age": {"type": "integer", "minimum": 0}}
+	}`)
+	statistics := NewKeywordStatistics()
+	schemaDocument.SetKeywordStatistics(statistics)
+
+	for _, document := range []string{`{"name":"a"}`, `{"name":"b","age":-1}`, `{"age":-2}`, `{"name":"ok","age":1}`, `[]`} {
+		schemaDocument.Validate(mustParseJson(t, document))
+	}
+
+	var failures []string
+	for _, failure := range statistics.Failures() {
+		failures = append(failures, fmt.Sprintf("%s %s %d", failure.SchemaLocation, failure.Keyword, failure.Count))
+	}
+	expected := []string{
+		`#/definitions/age minimum 2`,
+		`#/properties/name minLength 2`,
+		`# required 1`,
+		`# type 1`,
+	}
+	if strings.Join(failures, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects failures :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(failures, "\n"))
+	}
+	if statistics.Validations() != 5 {
+		t.Errorf("Expects 5 validations, given %d", statistics.Validations())
+	}
+
+	statistics.Reset()
+	if len(statistics.Failures()) != 0 || statistics.Validations() != 0 {
+		t.Errorf("Expects no statistics after a reset")
+	}
+}
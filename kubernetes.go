@@ -0,0 +1,148 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Structural schemas of Kubernetes custom resources.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Keywords of draft 4 the structural schemas of Kubernetes do not have
+var kubernetesUnsupportedKeywords = []string{KEY_REF, KEY_DEFINITIONS, KEY_PATTERN_PROPERTIES, KEY_DEPENDENCIES, KEY_ADDITIONAL_ITEMS}
+
+// Keywords of the skeleton of a structural schema, the schemas under allOf,
+// anyOf, oneOf and not only validate values
+var kubernetesSkeletonKeywords = []string{KEY_TYPE, KEY_DEFAULT, KEY_ADDITIONAL_PROPERTIES, KEY_NULLABLE, KEY_INT_OR_STRING, KEY_PRESERVE_UNKNOWN_FIELDS}
+
+// Fields of the root of a custom resource, they are never pruned
+var kubernetesRootFields = []string{"apiVersion", "kind", "metadata"}
+
+// Checks that a schema of a custom resource is structural, as the API server does
+func checkKubernetesKeywords(m map[string]interface{}, currentSchema *jsonSchema) error {
+
+	for _, keyword := range kubernetesUnsupportedKeywords {
+		if existsMapKey(m, keyword) {
+			return errors.New(fmt.Sprintf("%s is not supported by Kubernetes", keyword))
+		}
+	}
+	if uniqueItems, _ := m[KEY_UNIQUE_ITEMS].(bool); uniqueItems {
+		return errors.New("uniqueItems cannot be true in a Kubernetes schema")
+	}
+
+	skeleton := currentSchema.kubernetesSkeleton()
+	if skeleton == nil {
+		if existsMapKey(m, KEY_PROPERTIES) && existsMapKey(m, KEY_ADDITIONAL_PROPERTIES) {
+			return errors.New("properties and additionalProperties cannot both be set in a structural schema")
+		}
+		if m[KEY_ADDITIONAL_PROPERTIES] == false {
+			return errors.New("additionalProperties cannot be false in a structural schema")
+		}
+		return nil
+	}
+
+	for _, keyword := range kubernetesSkeletonKeywords {
+		if !existsMapKey(m, keyword) {
+			continue
+		}
+		// the types of x-kubernetes-int-or-string can be spelled out under anyOf
+		if keyword == KEY_TYPE && skeleton.intOrString && (m[KEY_TYPE] == TYPE_INTEGER || m[KEY_TYPE] == TYPE_STRING) {
+			continue
+		}
+		return errors.New(fmt.Sprintf("%s cannot be under allOf, anyOf, oneOf or not in a structural schema", keyword))
+	}
+	return nil
+}
+
+// Interprets the Kubernetes keywords of a schema, once its type is parsed
+func (d *JsonSchemaDocument) parseKubernetesKeywords(m map[string]interface{}, currentSchema *jsonSchema) error {
+
+	for _, keyword := range []string{KEY_NULLABLE, KEY_INT_OR_STRING, KEY_PRESERVE_UNKNOWN_FIELDS} {
+		if existsMapKey(m, keyword) && !isKind(m[keyword], reflect.Bool) {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, keyword, STRING_BOOLEAN))
+		}
+	}
+	if existsMapKey(m, KEY_EXAMPLE) && currentSchema.examples == nil {
+		currentSchema.examples = []interface{}{m[KEY_EXAMPLE]}
+	}
+
+	currentSchema.intOrString, _ = m[KEY_INT_OR_STRING].(bool)
+	currentSchema.preserveUnknownFields, _ = m[KEY_PRESERVE_UNKNOWN_FIELDS].(bool)
+	if currentSchema.kubernetesSkeleton() != nil {
+		return nil
+	}
+
+	if currentSchema.intOrString {
+		if currentSchema.types.HasTypeInSchema() {
+			return errors.New("type cannot be set with x-kubernetes-int-or-string")
+		}
+		currentSchema.types.Add(TYPE_INTEGER)
+		currentSchema.types.Add(TYPE_STRING)
+	} else if !currentSchema.types.HasTypeInSchema() && !currentSchema.preserveUnknownFields {
+		return errors.New("type is required in a structural schema")
+	}
+	if currentSchema.parent == nil && (len(currentSchema.types.types) != 1 || !currentSchema.types.HasType(TYPE_OBJECT)) {
+		return errors.New("the type of a custom resource must be object")
+	}
+
+	// a schema without a type already accepts null
+	if nullable, _ := m[KEY_NULLABLE].(bool); nullable && currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_NULL) {
+		currentSchema.types.Add(TYPE_NULL)
+	}
+
+	currentSchema.prunesUnknownFields = !currentSchema.preserveUnknownFields && !existsMapKey(m, KEY_ADDITIONAL_PROPERTIES)
+	return nil
+}
+
+// Returns the schema of the skeleton a schema under allOf, anyOf, oneOf or not
+// validates the values of, nil when the schema is part of the skeleton
+func (s *jsonSchema) kubernetesSkeleton() *jsonSchema {
+	var skeleton *jsonSchema
+	for ; s.parent != nil; s = s.parent {
+		keyword := strings.SplitN(strings.TrimPrefix(s.pointer, s.parent.pointer+"/"), "/", 2)[0]
+		if keyword == KEY_ALL_OF || keyword == KEY_ANY_OF || keyword == KEY_ONE_OF || keyword == KEY_NOT {
+			skeleton = s.parent
+		}
+	}
+	return skeleton
+}
+
+// Prunes the fields of an object the structural schema does not declare, as
+// the API server does : they are removed with SetRemoveAdditional, reported as
+// warnings otherwise
+func (v *jsonSchema) pruneUnknownFields(currentSchema *jsonSchema, value map[string]interface{}, result *ValidationResult, context *jsonContext) {
+	for field := range value {
+		if currentSchema.HasProperty(field) || currentSchema.parent == nil && isStringInSlice(kubernetesRootFields, field) {
+			continue
+		}
+		if result.options.removeAdditional {
+			delete(value, field)
+			continue
+		}
+		result.addWarning(context, KEY_PRESERVE_UNKNOWN_FIELDS, "unknown field %s of %s is pruned", field, currentSchema.property)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the Kubernetes dialect.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testKubernetesSchema = `{
+	"type":"object",
+	"properties":{
+		"spec":{
+			"type":"object",
+			"required":["port"],
+			"properties":{
+				"port":{"x-kubernetes-int-or-string":true,"anyOf":[{"type":"integer","minimum":1},{"type":"string","pattern":"^[a-z]+$"}]},
+				"replicas":{"type":"integer","nullable":true},
+				"labels":{"type":"object","additionalProperties":{"type":"string"}},
+				"config":{"type":"object","x-kubernetes-preserve-unknown-fields":true}
+			}
+		}
+	}
+}`
+
+func TestKubernetesDialect(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, testKubernetesSchema), SchemaOptions{Dialect: DIALECT_KUBERNETES})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, document := range []string{
+		`{"spec":{"port":8080,"replicas":null}}`,
+		`{"spec":{"port":"http","labels":{"app":"web"},"config":{"any":{"thing":1}}}}`,
+	} {
+		if result := schemaDocument.Validate(mustParseJson(t, document)); !result.IsValid() || result.HasWarnings() {
+			t.Errorf("Expects %s to be valid, given %v %v", document, result.GetErrorMessages(), result.GetWarnings())
+		}
+	}
+	for _, document := range []string{`{"spec":{"port":0}}`, `{"spec":{"port":true}}`, `{"spec":{"port":"HTTP"}}`} {
+		if result := schemaDocument.Validate(mustParseJson(t, document)); result.IsValid() {
+			t.Errorf("Expects %s to be invalid", document)
+		}
+	}
+
+	port := schemaDocument.Root().Properties()["spec"].Properties()["port"]
+	if !port.IntOrString() || len(port.Types()) != 2 {
+		t.Errorf("Expects x-kubernetes-int-or-string to allow integers and strings, given %v", port.Types())
+	}
+}
+
+func TestKubernetesPruning(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, testKubernetesSchema), SchemaOptions{Dialect: DIALECT_KUBERNETES})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document := `{"apiVersion":"v1","kind":"Test","metadata":{"name":"a"},"status":{},"spec":{"port":1,"unknown":true,"config":{"kept":true}}}`
+	result := schemaDocument.Validate(mustParseJson(t, document))
+	if !result.IsValid() || len(result.GetWarnings()) != 2 {
+		t.Errorf("Expects the unknown fields to be reported as warnings, given %v %v", result.GetErrorMessages(), result.GetWarnings())
+	}
+	for _, warning := range result.GetWarnings() {
+		if warning.Type != ERROR_TYPE_UNKNOWN_FIELD {
+			t.Errorf("Expects an unknown field warning, given %s", warning.Type)
+		}
+	}
+
+	pruned, result := schemaDocument.ValidateAndSanitize(mustParseJson(t, document))
+	if !result.IsValid() || result.HasWarnings() {
+		t.Errorf("Expects the pruned document to be valid, given %v %v", result.GetErrorMessages(), result.GetWarnings())
+	}
+	prunedJson, _ := json.Marshal(pruned)
+	if string(prunedJson) != `{"apiVersion":"v1","kind":"Test","metadata":{"name":"a"},"spec":{"config":{"kept":true},"port":1}}` {
+		t.Errorf("Expects the unknown fields to be pruned, given %s", prunedJson)
+	}
+}
+
+func TestKubernetesStructuralSchema(t *testing.T) {
+
+	for _, schema := range []string{
+		`{"type":"string"}`,
+		`{"type":"object","properties":{"a":{}}}`,
+		`{"type":"object","properties":{"a":{"type":"string"}},"additionalProperties":{"type":"string"}}`,
+		`{"type":"object","additionalProperties":false}`,
+		`{"type":"object","properties":{"a":{"type":"string","x-kubernetes-int-or-string":true}}}`,
+		`{"type":"object","properties":{"a":{"type":"string"}},"anyOf":[{"properties":{"a":{"type":"string"}}}]}`,
+		`{"type":"object","properties":{"a":{"type":"string"}},"allOf":[{"default":{}}]}`,
+		`{"type":"object","properties":{"a":{"type":"array","uniqueItems":true}}}`,
+		`{"type":"object","properties":{"a":{"$ref":"#/properties/b"},"b":{"type":"string"}}}`,
+	} {
+		if _, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, schema), SchemaOptions{Dialect: DIALECT_KUBERNETES}); err == nil {
+			t.Errorf("Expects %s not to be a structural schema", schema)
+		}
+		if _, err := NewJsonSchemaDocument(mustParseJson(t, schema)); err != nil {
+			t.Errorf("Expects %s to be a json schema, given %s", schema, err.Error())
+		}
+	}
+}
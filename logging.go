@@ -0,0 +1,73 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Structured logs of the schema loading and of the validations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Messages of the logged events
+const (
+	LOG_REMOTE_SCHEMA_FETCHED = "jsonschema: remote schema fetched"
+	LOG_SCHEMA_COMPILED       = "jsonschema: schema compiled"
+	LOG_VALIDATION_FAILED     = "jsonschema: validation failed"
+)
+
+// Keys of the attributes of the logged events
+const (
+	LOG_KEY_URL       = "url"
+	LOG_KEY_SCHEMA    = "schema"
+	LOG_KEY_DOCUMENTS = "documents"
+	LOG_KEY_DURATION  = "duration"
+	LOG_KEY_ERRORS    = "errors"
+	LOG_KEY_KEYWORDS  = "keywords"
+)
+
+// Logs the failed validations to logger, at the info level, nil stops them
+// The schema loading is logged by the Logger of SchemaOptions : fetched
+// documents at the debug level, compiled schema at the info level
+func (d *JsonSchemaDocument) SetLogger(logger *slog.Logger) {
+	d.setOptions(func(options *validationOptions) {
+		options.logger = logger
+	})
+}
+
+func (v *ValidationResult) log(ctx context.Context, logger *slog.Logger, schemaId string) {
+	if !logger.Enabled(ctx, slog.LevelInfo) {
+		return
+	}
+	keywords := []string{}
+	for _, resultError := range v.resultErrors {
+		if !isStringInSlice(keywords, resultError.Keyword) {
+			keywords = append(keywords, resultError.Keyword)
+		}
+	}
+	logger.LogAttrs(ctx, slog.LevelInfo, LOG_VALIDATION_FAILED,
+		slog.String(LOG_KEY_SCHEMA, schemaId),
+		slog.Int(LOG_KEY_ERRORS, len(v.resultErrors)),
+		slog.Any(LOG_KEY_KEYWORDS, keywords))
+}
@@ -0,0 +1,80 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the structured logs.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"object","required":["name"],"properties":{"name":{"type":"string"}}}`))
+	}))
+	defer server.Close()
+
+	buffer := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, attribute slog.Attr) slog.Attr {
+			if attribute.Key == slog.TimeKey || attribute.Key == LOG_KEY_DURATION {
+				return slog.Attr{}
+			}
+			return attribute
+		},
+	}))
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(server.URL+"/user.json", SchemaOptions{Logger: logger})
+	if err != nil {
+		t.Fatalf("Could not load the schema : %s", err.Error())
+	}
+	schemaDocument.Validate(mustParseJson(t, `{"name":"a"}`))
+	schemaDocument.Validate(mustParseJson(t, `{"name":1,"age":2}`))
+	schemaDocument.Validate(mustParseJson(t, `[]`))
+
+	expected := []string{
+		`level=DEBUG msg="jsonschema: remote schema fetched" url=` + server.URL + `/user.json`,
+		`level=INFO msg="jsonschema: schema compiled" schema=` + server.URL + `/user.json documents=1`,
+		`level=INFO msg="jsonschema: validation failed" schema=` + server.URL + `/user.json errors=1 keywords=[type]`,
+		`level=INFO msg="jsonschema: validation failed" schema=` + server.URL + `/user.json errors=1 keywords=[type]`,
+	}
+	if given := strings.TrimSpace(buffer.String()); given != strings.Join(expected, "\n") {
+		t.Errorf("Expects logs :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), given)
+	}
+
+	// the failed validations are not logged anymore
+	buffer.Reset()
+	schemaDocument.SetLogger(nil)
+	schemaDocument.Validate(mustParseJson(t, `[]`))
+	if buffer.Len() != 0 {
+		t.Errorf("Expects no logs, given %s", buffer.String())
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Metrics hooks of the validations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"time"
+)
+
+// Metrics receives the measures of the validations of a document, see SetMetrics
+// The methods are called by the validating goroutines, concurrently
+type Metrics interface {
+	// Called once per validation, with its duration
+	ObserveValidation(valid bool, duration time.Duration)
+	// Called for each error of a failed validation
+	ObserveKeywordFailure(keyword string)
+}
+
+// Sends the measures of each validation to metrics ( counters, histograms... ),
+// nil stops them
+// See the prometheusmetrics package for prometheus collectors
+func (d *JsonSchemaDocument) SetMetrics(metrics Metrics) {
+	d.setOptions(func(options *validationOptions) {
+		options.metrics = metrics
+	})
+}
+
+func (v *ValidationResult) observe(metrics Metrics, start time.Time) {
+	metrics.ObserveValidation(v.IsValid(), time.Since(start))
+	for _, resultError := range v.resultErrors {
+		metrics.ObserveKeywordFailure(resultError.Keyword)
+	}
+}
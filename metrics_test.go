@@ -0,0 +1,74 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the metrics hooks.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMetrics struct {
+	mutex       sync.Mutex
+	validations map[bool]int
+	failures    map[string]int
+}
+
+func (m *testMetrics) ObserveValidation(valid bool, duration time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.validations[valid]++
+}
+
+func (m *testMetrics) ObserveKeywordFailure(keyword string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.failures[keyword]++
+}
+
+func TestMetrics(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"age":{"minimum":0}},"required":["name"]}`)
+	metrics := &testMetrics{validations: make(map[bool]int), failures: make(map[string]int)}
+	schemaDocument.SetMetrics(metrics)
+
+	schemaDocument.Validate(mustParseJson(t, `{"name":"a","age":1}`))
+	schemaDocument.Validate(mustParseJson(t, `{"age":-1}`))
+	schemaDocument.ValidateBytes([]byte(`{"age":-2}`))
+
+	if metrics.validations[true] != 1 || metrics.validations[false] != 2 {
+		t.Errorf("Expects 1 valid and 2 invalid validations, given %v", metrics.validations)
+	}
+	if metrics.failures[KEY_MINIMUM] != 2 || metrics.failures[KEY_REQUIRED] != 2 || len(metrics.failures) != 2 {
+		t.Errorf("Expects 2 minimum and 2 required failures, given %v", metrics.failures)
+	}
+
+	schemaDocument.SetMetrics(nil)
+	schemaDocument.Validate(mustParseJson(t, `{"age":-1}`))
+	if metrics.validations[false] != 2 {
+		t.Errorf("Expects no validation measured once the metrics are removed")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Echo adapter of the validation middleware.
+//
+// created          16-10-2026
+
+// Package echoschema validates echo requests against json schemas.
+package echoschema
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/sigu-399/gojsonschema"
+	"github.com/sigu-399/gojsonschema/middleware"
+)
+
+// ValidateRequest returns an echo middleware validating request bodies against the schema,
+// invalid requests are answered with a middleware.ErrorBody and its middleware.RequestStatus
+func ValidateRequest(schema *gojsonschema.JsonSchemaDocument) echo.MiddlewareFunc {
+	return ValidateRequestWithOptions(schema, middleware.RequestOptions{})
+}
+
+// ValidateRequestWithOptions is ValidateRequest with a maximum body size
+func ValidateRequestWithOptions(schema *gojsonschema.JsonSchemaDocument, options middleware.RequestOptions) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if errorBody := middleware.ValidateRequestBodyWithOptions(schema, c.Request(), options); errorBody != nil {
+				return c.JSON(middleware.RequestStatus(errorBody), errorBody)
+			}
+			return next(c)
+		}
+	}
+}
+
+// Errors answers the errors of a validation result
+func Errors(c echo.Context, status int, message string, result *gojsonschema.ValidationResult) error {
+	return c.JSON(status, middleware.NewErrorBody(message, result))
+}
@@ -0,0 +1,75 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the echo adapter.
+//
+// created          16-10-2026
+
+package echoschema
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/sigu-399/gojsonschema"
+	"github.com/sigu-399/gojsonschema/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateRequest(t *testing.T) {
+
+	var document interface{}
+	json.Unmarshal([]byte(`{"required":["name"],"properties":{"name":{"type":"string"}}}`), &document)
+	schemaDocument, err := gojsonschema.NewJsonSchemaDocument(document)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	router := echo.New()
+	router.POST("/users", func(c echo.Context) error {
+		return c.String(http.StatusCreated, "created")
+	}, ValidateRequestWithOptions(schemaDocument, middleware.RequestOptions{MaxBodySize: 32}))
+
+	tests := []struct {
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{`{"name":"gopher"}`, http.StatusCreated, `created`},
+		{`{"name":1}`, http.StatusBadRequest, `{"message":"request body does not match the schema","errors":[{"field":"ROOT.name","keyword":"type","description":"name must be of type string"}]}`},
+		{`{"name":"a name longer than the body limit"}`, http.StatusRequestEntityTooLarge, `{"message":"request body is too large"}`},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(test.body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		if recorder.Code != test.expectedStatus {
+			t.Errorf("Expects status %d for %s, given %d", test.expectedStatus, test.body, recorder.Code)
+		}
+		if given := strings.TrimSpace(recorder.Body.String()); given != test.expectedBody {
+			t.Errorf("Expects body %s for %s, given %s", test.expectedBody, test.body, given)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Json rendering of validation errors.
+//
+// created          16-10-2026
+
+// Package middleware validates http requests and responses against json schemas.
+// The middlewares have the func(http.Handler) http.Handler signature used by
+// chi and most net/http routers, see ginschema and echoschema for gin and echo.
+package middleware
+
+import (
+	"encoding/json"
+	"github.com/sigu-399/gojsonschema"
+	"net/http"
+)
+
+// ErrorBody is the json body answered when a document is not valid
+type ErrorBody struct {
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is one validation error of an ErrorBody
+type FieldError struct {
+	Field       string `json:"field"`
+	Keyword     string `json:"keyword,omitempty"`
+	Description string `json:"description"`
+	Suggestion  string `json:"suggestion,omitempty"`
+}
+
+// NewErrorBody builds the error body of a validation result
+func NewErrorBody(message string, result *gojsonschema.ValidationResult) *ErrorBody {
+
+	body := &ErrorBody{Message: message}
+	if result != nil {
+		for _, resultError := range result.GetErrors() {
+			body.Errors = append(body.Errors, FieldError{Field: resultError.Context, Keyword: resultError.Keyword, Description: resultError.Description, Suggestion: resultError.Suggestion})
+		}
+	}
+	return body
+}
+
+// WriteErrorBody answers the error body as json with the given status
+func WriteErrorBody(w http.ResponseWriter, status int, body *ErrorBody) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
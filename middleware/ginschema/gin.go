@@ -0,0 +1,55 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Gin adapter of the validation middleware.
+//
+// created          16-10-2026
+
+// Package ginschema validates gin requests against json schemas.
+package ginschema
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sigu-399/gojsonschema"
+	"github.com/sigu-399/gojsonschema/middleware"
+)
+
+// ValidateRequest returns a gin middleware validating request bodies against the schema,
+// invalid requests are aborted with a middleware.ErrorBody and its middleware.RequestStatus
+func ValidateRequest(schema *gojsonschema.JsonSchemaDocument) gin.HandlerFunc {
+	return ValidateRequestWithOptions(schema, middleware.RequestOptions{})
+}
+
+// ValidateRequestWithOptions is ValidateRequest with a maximum body size
+func ValidateRequestWithOptions(schema *gojsonschema.JsonSchemaDocument, options middleware.RequestOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if errorBody := middleware.ValidateRequestBodyWithOptions(schema, c.Request, options); errorBody != nil {
+			c.AbortWithStatusJSON(middleware.RequestStatus(errorBody), errorBody)
+			return
+		}
+		c.Next()
+	}
+}
+
+// AbortWithErrors aborts the request answering the errors of a validation result
+func AbortWithErrors(c *gin.Context, status int, message string, result *gojsonschema.ValidationResult) {
+	c.AbortWithStatusJSON(status, middleware.NewErrorBody(message, result))
+}
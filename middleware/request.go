@@ -0,0 +1,146 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of http request bodies.
+//
+// created          16-10-2026
+
+package middleware
+
+import (
+	"bytes"
+	"github.com/sigu-399/gojsonschema"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	MESSAGE_INVALID_JSON         = "request body is not valid json"
+	MESSAGE_INVALID_REQUEST_BODY = "request body does not match the schema"
+)
+
+const (
+	MESSAGE_MISSING_REQUEST_BODY   = "request body is missing"
+	MESSAGE_REQUEST_BODY_TOO_LARGE = "request body is too large"
+	MESSAGE_UNSUPPORTED_MEDIA_TYPE = "request body is not json"
+
+	DEFAULT_MAX_REQUEST_BODY_SIZE = 1 << 20
+)
+
+// Methods whose requests carry a document, an empty body is a missing document
+var documentMethods = map[string]bool{http.MethodPost: true, http.MethodPut: true, http.MethodPatch: true}
+
+// RequestOptions tells how the request bodies are read
+type RequestOptions struct {
+	// Larger bodies are rejected, DEFAULT_MAX_REQUEST_BODY_SIZE when 0
+	MaxBodySize int64
+}
+
+// ValidateRequest returns a middleware validating request bodies against the schema
+// Invalid requests are answered with an ErrorBody and the status of RequestStatus, valid ones are
+// passed to the next handler with their body untouched
+// POST, PUT and PATCH requests must have a json body, the requests of other
+// methods ( GET, DELETE... ) are only validated when they have one
+func ValidateRequest(schema *gojsonschema.JsonSchemaDocument) func(http.Handler) http.Handler {
+	return ValidateRequestWithOptions(schema, RequestOptions{})
+}
+
+// ValidateRequestWithOptions is ValidateRequest with a maximum body size
+func ValidateRequestWithOptions(schema *gojsonschema.JsonSchemaDocument, options RequestOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if errorBody := ValidateRequestBodyWithOptions(schema, r, options); errorBody != nil {
+				WriteErrorBody(w, RequestStatus(errorBody), errorBody)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequestStatus returns the status answering a request rejected with the error body,
+// 413 for a body too large, 415 for a body that is not json and 400 otherwise
+func RequestStatus(errorBody *ErrorBody) int {
+	switch errorBody.Message {
+	case MESSAGE_REQUEST_BODY_TOO_LARGE:
+		return http.StatusRequestEntityTooLarge
+	case MESSAGE_UNSUPPORTED_MEDIA_TYPE:
+		return http.StatusUnsupportedMediaType
+	}
+	return http.StatusBadRequest
+}
+
+// RequestValidator wraps a handler, validating request bodies against the schema
+func RequestValidator(schema *gojsonschema.JsonSchemaDocument, next http.Handler) http.Handler {
+	return ValidateRequest(schema)(next)
+}
+
+// ValidateRequestBody validates the body of a request against the schema,
+// and returns the ErrorBody to answer when it is not valid
+// The body is read and replaced so that it can be read again by handlers
+func ValidateRequestBody(schema *gojsonschema.JsonSchemaDocument, r *http.Request) *ErrorBody {
+	return ValidateRequestBodyWithOptions(schema, r, RequestOptions{})
+}
+
+// ValidateRequestBodyWithOptions is ValidateRequestBody with a maximum body size
+func ValidateRequestBodyWithOptions(schema *gojsonschema.JsonSchemaDocument, r *http.Request, options RequestOptions) *ErrorBody {
+
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		maxBodySize := options.MaxBodySize
+		if maxBodySize <= 0 {
+			maxBodySize = DEFAULT_MAX_REQUEST_BODY_SIZE
+		}
+		// one more byte tells a body of the maximum size from a larger one
+		var err error
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		r.Body.Close()
+		if err != nil {
+			return &ErrorBody{Message: err.Error()}
+		}
+		if int64(len(body)) > maxBodySize {
+			return &ErrorBody{Message: MESSAGE_REQUEST_BODY_TOO_LARGE}
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if documentMethods[r.Method] {
+			return &ErrorBody{Message: MESSAGE_MISSING_REQUEST_BODY}
+		}
+		return nil
+	}
+
+	if !isJsonContentType(r.Header.Get("Content-Type")) {
+		return &ErrorBody{Message: MESSAGE_UNSUPPORTED_MEDIA_TYPE}
+	}
+
+	result, err := schema.ValidateBytes(body)
+	if err != nil {
+		return &ErrorBody{Message: MESSAGE_INVALID_JSON}
+	}
+	if !result.IsValid() {
+		return NewErrorBody(MESSAGE_INVALID_REQUEST_BODY, result)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the request validation.
+//
+// created          16-10-2026
+
+package middleware
+
+import (
+	"encoding/json"
+	"github.com/sigu-399/gojsonschema"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func mustNewSchemaDocument(t *testing.T, s string) *gojsonschema.JsonSchemaDocument {
+	var document interface{}
+	if err := json.Unmarshal([]byte(s), &document); err != nil {
+		t.Fatalf("Could not parse json : %s", err.Error())
+	}
+	schemaDocument, err := gojsonschema.NewJsonSchemaDocument(document)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	return schemaDocument
+}
+
+func TestValidateRequest(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["name"],"properties":{"name":{"type":"string"}}}`)
+
+	handler := ValidateRequest(schemaDocument)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+
+	tests := []struct {
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{`{"name":"gopher"}`, http.StatusOK, `{"name":"gopher"}`},
+		{`{"name":1}`, http.StatusBadRequest, `{"message":"request body does not match the schema","errors":[{"field":"ROOT.name","keyword":"type","description":"name must be of type string"}]}`},
+		{`{"name":`, http.StatusBadRequest, `{"message":"request body is not valid json"}`},
+	}
+
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body)))
+		if recorder.Code != test.expectedStatus {
+			t.Errorf("Expects status %d for %s, given %d", test.expectedStatus, test.body, recorder.Code)
+		}
+		if given := strings.TrimSpace(recorder.Body.String()); given != test.expectedBody {
+			t.Errorf("Expects body %s for %s, given %s", test.expectedBody, test.body, given)
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expects a request without body not to be validated, given %d", recorder.Code)
+	}
+}
+
+func TestValidateRequestStatus(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["name"]}`)
+	handler := ValidateRequestWithOptions(schemaDocument, RequestOptions{MaxBodySize: 16})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tests := []struct {
+		body           string
+		contentType    string
+		expectedStatus int
+	}{
+		{`{"name":"a"}`, "application/json", http.StatusOK},
+		{`{}`, "application/json", http.StatusBadRequest},
+		{`{"name":"a very long name"}`, "application/json", http.StatusRequestEntityTooLarge},
+		{`{"name":"a"}`, "text/plain", http.StatusUnsupportedMediaType},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+		request.Header.Set("Content-Type", test.contentType)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != test.expectedStatus {
+			t.Errorf("Expects status %d for %s %s, given %d", test.expectedStatus, test.contentType, test.body, recorder.Code)
+		}
+	}
+}
+
+func TestValidateRequestBody(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["name"]}`)
+	options := RequestOptions{MaxBodySize: 16}
+
+	tests := []struct {
+		method          string
+		body            string
+		contentType     string
+		expectedMessage string
+	}{
+		{http.MethodPost, ``, "", MESSAGE_MISSING_REQUEST_BODY},
+		{http.MethodPut, " \n", "application/json", MESSAGE_MISSING_REQUEST_BODY},
+		{http.MethodDelete, ``, "", ""},
+		{http.MethodPost, `{"name":"a very long name"}`, "application/json", MESSAGE_REQUEST_BODY_TOO_LARGE},
+		{http.MethodPost, `{"name":"a"}`, "text/plain", MESSAGE_UNSUPPORTED_MEDIA_TYPE},
+		{http.MethodPatch, `{"name":"a"}`, "application/merge-patch+json", ""},
+	}
+
+	for _, test := range tests {
+		var request *http.Request
+		if test.body == "" {
+			request = httptest.NewRequest(test.method, "/", nil)
+		} else {
+			request = httptest.NewRequest(test.method, "/", strings.NewReader(test.body))
+		}
+		if test.contentType != "" {
+			request.Header.Set("Content-Type", test.contentType)
+		}
+		errorBody := ValidateRequestBodyWithOptions(schemaDocument, request, options)
+		if test.expectedMessage == "" && errorBody != nil || test.expectedMessage != "" && (errorBody == nil || errorBody.Message != test.expectedMessage) {
+			t.Errorf("Expects %q for %s %q, given %v", test.expectedMessage, test.method, test.body, errorBody)
+		}
+	}
+}
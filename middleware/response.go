@@ -0,0 +1,132 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of http response bodies.
+//
+// created          16-10-2026
+
+package middleware
+
+import (
+	"bytes"
+	"github.com/sigu-399/gojsonschema"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	MESSAGE_INVALID_RESPONSE_BODY = "response body does not match the schema"
+)
+
+// ResponseOptions tells what to do with responses violating the schema
+type ResponseOptions struct {
+	// Called for each violation, err is set when the body is not valid json
+	// Defaults to logging the violation
+	OnViolation func(r *http.Request, result *gojsonschema.ValidationResult, err error)
+
+	// Answers a 500 with an ErrorBody instead of the invalid response
+	Fail bool
+}
+
+// ValidateResponse returns a middleware validating successful ( 2xx ) json
+// responses against the schema, meant for development and tests as responses
+// are buffered until the handler returns
+func ValidateResponse(schema *gojsonschema.JsonSchemaDocument, options ResponseOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return ResponseValidator(schema, options, next)
+	}
+}
+
+// ResponseValidator wraps a handler, validating its responses against the schema
+func ResponseValidator(schema *gojsonschema.JsonSchemaDocument, options ResponseOptions, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		if hasJsonResponseBody(r, recorder, w.Header().Get("Content-Type")) {
+
+			result, err := schema.ValidateBytes(recorder.body.Bytes())
+			if err != nil || !result.IsValid() {
+
+				if options.OnViolation != nil {
+					options.OnViolation(r, result, err)
+				} else {
+					logViolation(r, result, err)
+				}
+
+				if options.Fail {
+					w.Header().Del("Content-Length")
+					WriteErrorBody(w, http.StatusInternalServerError, NewErrorBody(MESSAGE_INVALID_RESPONSE_BODY, result))
+					return
+				}
+			}
+		}
+
+		w.WriteHeader(recorder.status)
+		w.Write(recorder.body.Bytes())
+	})
+}
+
+// responseRecorder buffers the status and body written by a handler
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// hasJsonResponseBody tells if a successful response has a json body to validate,
+// a response without content type or without body ( 204, 304, HEAD ) has none
+func hasJsonResponseBody(r *http.Request, recorder *responseRecorder, contentType string) bool {
+	if recorder.status < 200 || recorder.status >= 300 || recorder.status == http.StatusNoContent {
+		return false
+	}
+	if r.Method == http.MethodHead || recorder.body.Len() == 0 {
+		return false
+	}
+	return contentType != "" && isJsonContentType(contentType)
+}
+
+// isJsonContentType tells if a request or response body is json, bodies without
+// content type are considered json
+func isJsonContentType(contentType string) bool {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	return mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func logViolation(r *http.Request, result *gojsonschema.ValidationResult, err error) {
+	if err != nil {
+		log.Printf("%s %s : response is not valid json : %s", r.Method, r.URL.Path, err.Error())
+		return
+	}
+	for _, message := range result.GetErrorMessages() {
+		log.Printf("%s %s : response does not match the schema : %s", r.Method, r.URL.Path, message)
+	}
+}
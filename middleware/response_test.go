@@ -0,0 +1,114 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the response validation.
+//
+// created          16-10-2026
+
+package middleware
+
+import (
+	"github.com/sigu-399/gojsonschema"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateResponse(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["id"]}`)
+
+	responses := map[string]struct {
+		status      int
+		contentType string
+		body        string
+	}{
+		"/valid":     {http.StatusOK, "application/json", `{"id":1}`},
+		"/invalid":   {http.StatusCreated, "application/json; charset=utf-8", `{}`},
+		"/error":     {http.StatusNotFound, "application/json", `{}`},
+		"/text":      {http.StatusOK, "text/plain", `hello`},
+		"/malformed": {http.StatusOK, "application/problem+json", `{`},
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := responses[r.URL.Path]
+		w.Header().Set("Content-Type", response.contentType)
+		w.WriteHeader(response.status)
+		w.Write([]byte(response.body))
+	})
+
+	var violations []string
+	options := ResponseOptions{OnViolation: func(r *http.Request, result *gojsonschema.ValidationResult, err error) {
+		violations = append(violations, r.URL.Path)
+	}}
+
+	for _, path := range []string{"/valid", "/invalid", "/error", "/text", "/malformed"} {
+		recorder := httptest.NewRecorder()
+		ValidateResponse(schemaDocument, options)(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		if recorder.Code != responses[path].status || recorder.Body.String() != responses[path].body {
+			t.Errorf("Expects the response of %s to be untouched, given %d %s", path, recorder.Code, recorder.Body.String())
+		}
+	}
+	if strings.Join(violations, ",") != "/invalid,/malformed" {
+		t.Errorf("Expects violations on /invalid and /malformed, given %v", violations)
+	}
+
+	options.Fail = true
+	recorder := httptest.NewRecorder()
+	ValidateResponse(schemaDocument, options)(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/invalid", nil))
+	expected := `{"message":"response body does not match the schema","errors":[{"field":"ROOT","keyword":"required","description":"id property is required"}]}`
+	if recorder.Code != http.StatusInternalServerError || strings.TrimSpace(recorder.Body.String()) != expected {
+		t.Errorf("Expects a 500 with %s, given %d %s", expected, recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestValidateResponseWithoutBody(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["id"]}`)
+
+	tests := []struct {
+		method      string
+		status      int
+		contentType string
+		body        string
+	}{
+		{http.MethodDelete, http.StatusNoContent, "", ""},
+		{http.MethodGet, http.StatusOK, "application/json", ""},
+		{http.MethodHead, http.StatusOK, "application/json", ""},
+		// without content type the body is not known to be json
+		{http.MethodGet, http.StatusOK, "", `{}`},
+	}
+
+	for _, test := range tests {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if test.contentType != "" {
+				w.Header().Set("Content-Type", test.contentType)
+			}
+			w.WriteHeader(test.status)
+			w.Write([]byte(test.body))
+		})
+		recorder := httptest.NewRecorder()
+		ValidateResponse(schemaDocument, ResponseOptions{Fail: true})(handler).ServeHTTP(recorder, httptest.NewRequest(test.method, "/", nil))
+		if recorder.Code != test.status || recorder.Body.String() != test.body {
+			t.Errorf("Expects the %d response to %s to be untouched, given %d %s", test.status, test.method, recorder.Code, recorder.Body.String())
+		}
+	}
+}
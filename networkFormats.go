@@ -0,0 +1,198 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Format checkers for emails, host names and ip addresses.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// RFC 5321 dot-atom and quoted-string local parts
+	emailDotAtomRegexp      = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+/=?^_`{|}~-]+(\\.[A-Za-z0-9!#$%&'*+/=?^_`{|}~-]+)*$")
+	emailQuotedStringRegexp = regexp.MustCompile(`^"([\x20\x21\x23-\x5B\x5D-\x7E]|\\[\x20-\x7E])*"$`)
+
+	hostnameLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?$`)
+)
+
+func checkIpv4Format(input string) error {
+	ip := net.ParseIP(input)
+	if ip == nil || ip.To4() == nil || strings.Contains(input, ":") {
+		return errors.New("expects 4 decimal numbers from 0 to 255 separated by dots")
+	}
+	return nil
+}
+
+func checkIpv6Format(input string) error {
+	if !strings.Contains(input, ":") || net.ParseIP(input) == nil {
+		return errors.New("expects an IPv6 address")
+	}
+	return nil
+}
+
+// RFC 1034 host names, labels of letters, digits and hyphens
+func checkHostnameFormat(input string) error {
+	return checkHostname(input, false)
+}
+
+// RFC 5890 internationalized host names, labels may be unicode or punycode
+func checkIdnHostnameFormat(input string) error {
+	return checkHostname(input, true)
+}
+
+func checkHostname(input string, idn bool) error {
+
+	// a fully qualified name may end with a dot
+	input = strings.TrimSuffix(input, ".")
+	if input == "" {
+		return errors.New("expects at least one label")
+	}
+
+	length := 0
+	for _, label := range strings.Split(input, ".") {
+		asciiLabel, err := checkHostnameLabel(label, idn)
+		if err != nil {
+			return err
+		}
+		length += len(asciiLabel) + 1
+	}
+	if length-1 > 253 {
+		return errors.New("expects at most 253 characters")
+	}
+
+	return nil
+}
+
+// Checks a label and returns its ASCII form
+func checkHostnameLabel(label string, idn bool) (string, error) {
+
+	asciiLabel := label
+	unicodeLabel := label
+
+	if !isAsciiString(label) {
+		if !idn {
+			return "", errors.New(fmt.Sprintf("label %s has non ASCII characters", label))
+		}
+		asciiLabel = "xn--" + punycodeEncode(label)
+	} else if strings.HasPrefix(strings.ToLower(label), "xn--") {
+		decoded, err := punycodeDecode(label[4:])
+		if err != nil || decoded == "" || isAsciiString(decoded) {
+			return "", errors.New(fmt.Sprintf("label %s is not valid punycode", label))
+		}
+		unicodeLabel = decoded
+	} else if len(label) >= 4 && label[2:4] == "--" {
+		return "", errors.New(fmt.Sprintf("label %s cannot have hyphens in 3rd and 4th position", label))
+	}
+
+	if len(asciiLabel) == 0 || len(asciiLabel) > 63 {
+		return "", errors.New(fmt.Sprintf("label %s must have from 1 to 63 characters", label))
+	}
+
+	if isAsciiString(unicodeLabel) {
+		if !hostnameLabelRegexp.MatchString(unicodeLabel) {
+			return "", errors.New(fmt.Sprintf("label %s must be letters, digits or hyphens not starting nor ending with a hyphen", label))
+		}
+		return asciiLabel, nil
+	}
+
+	// IDNA 2008 : letters, marks and digits, a label cannot start with a combining mark
+	first, _ := utf8.DecodeRuneInString(unicodeLabel)
+	if unicode.IsMark(first) {
+		return "", errors.New(fmt.Sprintf("label %s cannot start with a combining mark", label))
+	}
+	if strings.HasPrefix(unicodeLabel, "-") || strings.HasSuffix(unicodeLabel, "-") {
+		return "", errors.New(fmt.Sprintf("label %s cannot start nor end with a hyphen", label))
+	}
+	for _, r := range unicodeLabel {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '-') || unicode.IsUpper(r) {
+			return "", errors.New(fmt.Sprintf("label %s has a disallowed character %q", label, r))
+		}
+	}
+
+	return asciiLabel, nil
+}
+
+// RFC 5321 email addresses
+func checkEmailFormat(input string) error {
+	return checkEmail(input, false)
+}
+
+// RFC 6531 email addresses, the local part and the domain may be unicode
+func checkIdnEmailFormat(input string) error {
+	return checkEmail(input, true)
+}
+
+func checkEmail(input string, idn bool) error {
+
+	at := strings.LastIndexByte(input, '@')
+	if at == -1 {
+		return errors.New("expects a local part and a domain separated by @")
+	}
+	localPart, domain := input[:at], input[at+1:]
+
+	if localPart == "" || len(localPart) > 64 {
+		return errors.New("expects a local part from 1 to 64 characters")
+	}
+
+	asciiLocalPart := localPart
+	if idn {
+		// UTF-8 characters are allowed where ASCII letters are
+		asciiLocalPart = strings.Map(func(r rune) rune {
+			if r >= utf8.RuneSelf && !unicode.IsControl(r) && !unicode.IsSpace(r) {
+				return 'a'
+			}
+			return r
+		}, localPart)
+	}
+	if !emailDotAtomRegexp.MatchString(asciiLocalPart) && !emailQuotedStringRegexp.MatchString(asciiLocalPart) {
+		return errors.New(fmt.Sprintf("local part %s is not valid", localPart))
+	}
+
+	// address literals, [192.168.0.1] or [IPv6:::1]
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		literal := domain[1 : len(domain)-1]
+		if strings.HasPrefix(literal, "IPv6:") {
+			return checkIpv6Format(literal[5:])
+		}
+		return checkIpv4Format(literal)
+	}
+
+	return checkHostname(domain, idn)
+}
+
+func isAsciiString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,338 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Numeric values of a document, either float64 or json.Number.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"cmp"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// numberValue is a numeric json value, compared against the float64 values of a schema
+type numberValue interface {
+	isInteger() bool
+	// Tells if the number is written without a fraction nor an exponent
+	isIntegerLiteral() bool
+	// -1, 0 or +1 if the value is lower, equal or greater than the bound,
+	// exact is the value of the bound when it is known, see exactNumber
+	compare(bound float64, exact *big.Rat) int
+	isMultipleOf(divisor float64, exact *big.Rat) bool
+	String() string
+}
+
+// Returns the numeric value of a json value, if any
+func newNumberValue(value interface{}) (numberValue, bool) {
+	switch value := value.(type) {
+	case float64:
+		return float64Number(value), true
+	case json.Number:
+		if !isJsonNumberLiteral(string(value)) {
+			return nil, false
+		}
+		return &jsonNumber{literal: string(value)}, true
+	}
+	return nil, false
+}
+
+// Same as newNumberValue, float64 values are also made exact when the
+// arbitrary precision option is set
+func (o *validationOptions) newNumberValue(value interface{}) (numberValue, bool) {
+	if f, ok := value.(float64); ok && o != nil && o.arbitraryPrecision {
+		return ratNumber{rat: float64ToRat(f), literal: validationErrorFormatNumber(f)}, true
+	}
+	return newNumberValue(value)
+}
+
+// float64Number is a number decoded by encoding/json
+type float64Number float64
+
+func (n float64Number) isInteger() bool {
+	return isFloat64AnInteger(float64(n))
+}
+
+// The json text of a float64 is not known
+func (n float64Number) isIntegerLiteral() bool {
+	return n.isInteger()
+}
+
+// A float64 is compared to the float64 of the bound, 0.1 is then equal to 0.1
+func (n float64Number) compare(bound float64, exact *big.Rat) int {
+	switch {
+	case float64(n) < bound:
+		return -1
+	case float64(n) > bound:
+		return 1
+	}
+	return 0
+}
+
+// Divides the decimal representations, a float64 division drifts
+// ex: 19.99 / 0.01 = 1998.9999999999998
+func (n float64Number) isMultipleOf(divisor float64, exact *big.Rat) bool {
+	quotient := float64(n) / divisor
+	if isFloat64AnInteger(quotient) {
+		return true
+	}
+	// a quotient that far from an integer cannot be one once exact
+	if math.Abs(quotient-math.Round(quotient)) > 1e-6*math.Max(1, math.Abs(quotient)) {
+		return false
+	}
+	return new(big.Rat).Quo(float64ToRat(float64(n)), float64ToRat(divisor)).IsInt()
+}
+
+func (n float64Number) String() string {
+	return validationErrorFormatNumber(float64(n))
+}
+
+// ratNumber is an exact number, decoded from a json.Number
+// Integers are not limited to the 53 bits of a float64 mantissa
+type ratNumber struct {
+	rat     *big.Rat
+	literal string
+}
+
+func (n ratNumber) isInteger() bool {
+	return n.rat.IsInt()
+}
+
+func (n ratNumber) isIntegerLiteral() bool {
+	return n.rat.IsInt() && !strings.ContainsAny(n.literal, ".eE")
+}
+
+// Integers are compared as int64 with an integer bound, the shortest decimal
+// representation of a large bound is not its value, 2^63 - 1024 is written
+// 9.223372036854775e+18
+func (n ratNumber) compare(bound float64, exact *big.Rat) int {
+	if exact != nil {
+		return n.rat.Cmp(exact)
+	}
+	if integerBound, ok := float64ToInt64(bound); ok && n.rat.IsInt() && n.rat.Num().IsInt64() {
+		return cmp.Compare(n.rat.Num().Int64(), integerBound)
+	}
+	return n.rat.Cmp(float64ToRat(bound))
+}
+
+func (n ratNumber) isMultipleOf(divisor float64, exact *big.Rat) bool {
+	if exact == nil {
+		exact = float64ToRat(divisor)
+	}
+	return new(big.Rat).Quo(n.rat, exact).IsInt()
+}
+
+func (n ratNumber) String() string {
+	return n.literal
+}
+
+// jsonNumber is a json.Number, its exact value is only computed when a keyword
+// compares it, it is expensive for a large exponent ( 1e999999 )
+type jsonNumber struct {
+	literal string
+	exact   *ratNumber
+}
+
+// The exact value, or the closest float64 when the exponent is out of the
+// range of big.Rat
+func (n *jsonNumber) value() numberValue {
+	if n.exact == nil {
+		rat, ok := new(big.Rat).SetString(n.literal)
+		if !ok {
+			f, _ := strconv.ParseFloat(n.literal, 64)
+			return float64Number(f)
+		}
+		n.exact = &ratNumber{rat: rat, literal: n.literal}
+	}
+	return *n.exact
+}
+
+// Tells from the literal whether the number is an integer : the digits without
+// their trailing zeros, shifted by the exponent, have no fraction
+func (n *jsonNumber) isInteger() bool {
+	mantissa, exponent, _ := strings.Cut(strings.ToLower(strings.TrimPrefix(n.literal, "-")), "e")
+	integerPart, fraction, _ := strings.Cut(mantissa, ".")
+	digits := strings.TrimRight(integerPart+fraction, "0")
+	if strings.Trim(digits, "0") == "" {
+		return true
+	}
+	shift := len(integerPart+fraction) - len(digits) - len(fraction)
+	if exponent == "" {
+		return shift >= 0
+	}
+	e, err := strconv.Atoi(exponent)
+	if err != nil {
+		// beyond the int range
+		return !strings.HasPrefix(exponent, "-")
+	}
+	return e >= -shift
+}
+
+func (n *jsonNumber) isIntegerLiteral() bool {
+	return n.isInteger() && !strings.ContainsAny(n.literal, ".eE")
+}
+
+func (n *jsonNumber) compare(bound float64, exact *big.Rat) int {
+	return n.value().compare(bound, exact)
+}
+
+func (n *jsonNumber) isMultipleOf(divisor float64, exact *big.Rat) bool {
+	return n.value().isMultipleOf(divisor, exact)
+}
+
+func (n *jsonNumber) String() string {
+	return n.literal
+}
+
+// Tells if a string is a number of the json grammar
+func isJsonNumberLiteral(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	integerPart := len(s) - len(strings.TrimLeft(s, "0123456789"))
+	if integerPart == 0 || integerPart > 1 && s[0] == '0' {
+		return false
+	}
+	s = s[integerPart:]
+	if strings.HasPrefix(s, ".") {
+		fraction := len(s[1:]) - len(strings.TrimLeft(s[1:], "0123456789"))
+		if fraction == 0 {
+			return false
+		}
+		s = s[1+fraction:]
+	}
+	if strings.HasPrefix(s, "e") || strings.HasPrefix(s, "E") {
+		s = s[1:]
+		if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+			s = s[1:]
+		}
+		exponent := len(s) - len(strings.TrimLeft(s, "0123456789"))
+		if exponent == 0 {
+			return false
+		}
+		s = s[exponent:]
+	}
+	return s == ""
+}
+
+// Writes a number literal as its significant digits and an exponent, without
+// computing its value : 2.50, 25e-1 and 0.25e1 are all 25e-1, 1.0 is 1
+func canonicalNumberLiteral(literal string) string {
+	sign := ""
+	if strings.HasPrefix(literal, "-") {
+		sign, literal = "-", literal[1:]
+	}
+	mantissa, exponentText, _ := strings.Cut(strings.ToLower(literal), "e")
+	integerPart, fraction, _ := strings.Cut(mantissa, ".")
+	exponent := int64(0)
+	if exponentText != "" {
+		var err error
+		if exponent, err = strconv.ParseInt(exponentText, 10, 64); err != nil {
+			return sign + literal
+		}
+	}
+
+	digits := strings.TrimLeft(integerPart+fraction, "0")
+	if digits == "" {
+		return "0"
+	}
+	significant := strings.TrimRight(digits, "0")
+	exponent += int64(len(digits)-len(significant)) - int64(len(fraction))
+	if exponent == 0 {
+		return sign + significant
+	}
+	return sign + significant + "e" + strconv.FormatInt(exponent, 10)
+}
+
+// Returns the value of an integer float64 within the int64 range
+func float64ToInt64(f float64) (int64, bool) {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// Converts a float64 using its shortest decimal representation, as written
+// in the schema, rather than its exact binary value ( 0.1 is 1/10 )
+func float64ToRat(f float64) *big.Rat {
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return rat
+}
+
+// exactNumber is a numeric keyword of a schema decoded as a json.Number ( a
+// json.Decoder using UseNumber ), its float64 can be rounded, ex: 2^53 + 1
+type exactNumber struct {
+	rat     *big.Rat
+	literal string
+}
+
+func newExactNumber(literal string) (*exactNumber, bool) {
+	rat, ok := new(big.Rat).SetString(literal)
+	if !ok {
+		return nil, false
+	}
+	return &exactNumber{rat: rat, literal: literal}, true
+}
+
+// Returns the value of a numeric keyword of a schema, exact is set when it is
+// a json.Number
+func schemaNumber(value interface{}) (f float64, exact *exactNumber, ok bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, nil, true
+	case json.Number:
+		if exact, ok = newExactNumber(string(value)); ok {
+			f, _ = exact.rat.Float64()
+		}
+		return f, exact, ok
+	}
+	return 0, nil, false
+}
+
+// Keeps the exact value of a numeric keyword, when it has one
+func (s *jsonSchema) setExactNumber(keyword string, exact *exactNumber) {
+	if exact == nil {
+		return
+	}
+	if s.exactNumbers == nil {
+		s.exactNumbers = make(map[string]*exactNumber)
+	}
+	s.exactNumbers[keyword] = exact
+}
+
+// Returns the exact value of a numeric keyword, nil when only its float64 is known
+func (s *jsonSchema) exactBound(keyword string) *big.Rat {
+	if exact, ok := s.exactNumbers[keyword]; ok {
+		return exact.rat
+	}
+	return nil
+}
+
+// Formats a numeric keyword for the error messages, as written in the schema when known
+func (s *jsonSchema) formatBound(keyword string, f float64) string {
+	if exact, ok := s.exactNumbers[keyword]; ok {
+		return exact.literal
+	}
+	return validationErrorFormatNumber(f)
+}
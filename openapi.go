@@ -0,0 +1,158 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      OpenAPI 3.0 and Swagger 2.0 schema objects.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Dialect is the language the schemas of a document are written in, see SchemaOptions.Dialect
+type Dialect string
+
+const (
+	// JSON schema, the unknown keywords are ignored
+	DIALECT_JSON_SCHEMA Dialect = ""
+	// Schema objects of OpenAPI 3.0 : nullable adds null to the types, example
+	// is one of the examples, readOnly and writeOnly apply with SetAccessMode
+	DIALECT_OPENAPI_3_0 Dialect = "openapi-3.0"
+	// Schema objects of Swagger 2.0, a subset of draft 4 : oneOf, anyOf, not,
+	// patternProperties, dependencies, additionalItems and lists of types are
+	// schema errors, discriminator is the name of a property, example is one
+	// of the examples, readOnly applies with SetAccessMode
+	DIALECT_SWAGGER_2_0 Dialect = "swagger-2.0"
+	// Structural schemas of Kubernetes custom resources : the skeleton has a
+	// type at each level, x-kubernetes-int-or-string and nullable add types,
+	// the fields it does not declare are pruned unless
+	// x-kubernetes-preserve-unknown-fields is set
+	DIALECT_KUBERNETES Dialect = "kubernetes"
+)
+
+// Keywords of draft 4 the schema objects of Swagger 2.0 do not have
+var swaggerUnsupportedKeywords = []string{KEY_ONE_OF, KEY_ANY_OF, KEY_NOT, KEY_PATTERN_PROPERTIES, KEY_DEPENDENCIES, KEY_ADDITIONAL_ITEMS}
+
+// AccessMode tells if the validated documents are requests or responses, for
+// the readOnly and writeOnly properties of OpenAPI 3.0, see SetAccessMode
+type AccessMode int
+
+const (
+	// readOnly and writeOnly have no effect
+	ACCESS_MODE_ANY AccessMode = iota
+	// readOnly values are errors, the readOnly properties are not required
+	ACCESS_MODE_REQUEST
+	// writeOnly values are errors, the writeOnly properties are not required
+	ACCESS_MODE_RESPONSE
+)
+
+// Validates the documents as requests or responses, ACCESS_MODE_ANY by default
+func (d *JsonSchemaDocument) SetAccessMode(accessMode AccessMode) {
+	d.setOptions(func(options *validationOptions) {
+		options.accessMode = accessMode
+	})
+}
+
+// Tells if the schemas are OpenAPI 3.0 or Swagger 2.0 schema objects
+func (d *JsonSchemaDocument) openApiDialect() bool {
+	return d.schemaOptions.Dialect == DIALECT_OPENAPI_3_0 || d.schemaOptions.Dialect == DIALECT_SWAGGER_2_0
+}
+
+// Checks that a schema object of Swagger 2.0 only has the keywords of its subset of draft 4
+func checkSwaggerKeywords(m map[string]interface{}) error {
+	for _, keyword := range swaggerUnsupportedKeywords {
+		if existsMapKey(m, keyword) {
+			return errors.New(fmt.Sprintf("%s is not supported by Swagger 2.0", keyword))
+		}
+	}
+	for _, keyword := range []string{KEY_TYPE, KEY_DISCRIMINATOR} {
+		if existsMapKey(m, keyword) && !isKind(m[keyword], reflect.String) {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, keyword, STRING_STRING))
+		}
+	}
+	return nil
+}
+
+// Interprets the OpenAPI keywords of a schema object, once its type is parsed
+func (d *JsonSchemaDocument) parseOpenApiKeywords(m map[string]interface{}, currentSchema *jsonSchema) error {
+
+	booleans := []string{KEY_NULLABLE, KEY_READ_ONLY, KEY_WRITE_ONLY}
+	if d.schemaOptions.Dialect == DIALECT_SWAGGER_2_0 {
+		// nullable and writeOnly came with OpenAPI 3.0
+		booleans = []string{KEY_READ_ONLY}
+	}
+	for _, keyword := range booleans {
+		if existsMapKey(m, keyword) && !isKind(m[keyword], reflect.Bool) {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, keyword, STRING_BOOLEAN))
+		}
+	}
+	for _, keyword := range []string{KEY_XML, KEY_EXTERNAL_DOCS} {
+		if existsMapKey(m, keyword) && !isKind(m[keyword], reflect.Map) {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, keyword, STRING_OBJECT))
+		}
+	}
+
+	if d.schemaOptions.Dialect == DIALECT_OPENAPI_3_0 {
+		// a schema without a type already accepts null
+		if nullable, _ := m[KEY_NULLABLE].(bool); nullable && currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_NULL) {
+			currentSchema.types.Add(TYPE_NULL)
+		}
+		currentSchema.writeOnly, _ = m[KEY_WRITE_ONLY].(bool)
+	}
+
+	if existsMapKey(m, KEY_EXAMPLE) && currentSchema.examples == nil {
+		currentSchema.examples = []interface{}{m[KEY_EXAMPLE]}
+	}
+
+	currentSchema.readOnly, _ = m[KEY_READ_ONLY].(bool)
+	if currentSchema.readOnly && currentSchema.writeOnly {
+		return errors.New("readOnly and writeOnly cannot both be true")
+	}
+	return nil
+}
+
+// Tells if the values of the schema cannot be part of the validated documents,
+// the readOnly values of the requests and the writeOnly values of the responses
+func (o *validationOptions) excludes(schema *jsonSchema) bool {
+	if o == nil || schema == nil || o.accessMode == ACCESS_MODE_ANY {
+		return false
+	}
+	for schema.refSchema != nil {
+		schema = schema.refSchema
+	}
+	return schema.readOnly && o.accessMode == ACCESS_MODE_REQUEST || schema.writeOnly && o.accessMode == ACCESS_MODE_RESPONSE
+}
+
+// Reports a value of the schema the access mode excludes
+func (v *ValidationResult) checkAccessMode(schema *jsonSchema, context *jsonContext) {
+	if !v.options.excludes(schema) {
+		return
+	}
+	if schema.readOnly {
+		v.addError(context, KEY_READ_ONLY, "%s is read only, it cannot be sent in a request", schema.property)
+	} else {
+		v.addError(context, KEY_WRITE_ONLY, "%s is write only, it cannot be returned in a response", schema.property)
+	}
+}
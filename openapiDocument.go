@@ -0,0 +1,171 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Schemas of the components of OpenAPI and Swagger documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonreference"
+	"sort"
+	"strings"
+	"time"
+)
+
+// OpenApiDocument is an OpenAPI 3.1 document, or a 3.0 or Swagger 2.0 one,
+// each schema of its components.schemas ( its definitions for Swagger 2.0 )
+// is compiled as a schema document, to validate the request and response
+// bodies straight from the spec
+// The references of the schemas are resolved in the OpenAPI document
+type OpenApiDocument struct {
+	version string
+	schemas map[string]*JsonSchemaDocument
+}
+
+// NewOpenApiDocument compiles the components.schemas of an OpenAPI document,
+// or the definitions of a Swagger 2.0 one, given as a reference ( file or
+// http url, json or yaml ) or as json
+// The schema objects of an OpenAPI 3.0 or Swagger 2.0 document are read with
+// DIALECT_OPENAPI_3_0 or DIALECT_SWAGGER_2_0 unless the options set another dialect
+func NewOpenApiDocument(document interface{}, schemaOptions SchemaOptions) (*OpenApiDocument, error) {
+
+	var err error
+	var reference gojsonreference.JsonReference
+	var spd *schemaPoolDocument
+
+	switch document := document.(type) {
+
+	case string:
+		reference, err = gojsonreference.NewJsonReference(document)
+		if err != nil {
+			return nil, err
+		}
+		spd, err = newJsonSchemaDocument(schemaOptions).pool.GetPoolDocument(reference)
+		if err != nil {
+			return nil, err
+		}
+
+	case map[string]interface{}:
+		reference, err = gojsonreference.NewJsonReference("#")
+		if err != nil {
+			return nil, err
+		}
+		spd = &schemaPoolDocument{Document: document}
+
+	default:
+		return nil, errors.New("Invalid argument, must be a jsonReference string or Json as map[string]interface{}")
+	}
+
+	m, ok := spd.Document.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("the OpenAPI document must be an object")
+	}
+	version, _ := m[KEY_OPENAPI].(string)
+	schemasPath := []string{KEY_COMPONENTS, KEY_SCHEMAS}
+	dialect := DIALECT_JSON_SCHEMA
+	switch {
+	case m[KEY_SWAGGER] == "2.0":
+		version = "2.0"
+		schemasPath = []string{KEY_DEFINITIONS}
+		dialect = DIALECT_SWAGGER_2_0
+	case strings.HasPrefix(version, "3.0."):
+		dialect = DIALECT_OPENAPI_3_0
+	case !strings.HasPrefix(version, "3."):
+		return nil, errors.New(fmt.Sprintf("OpenAPI version %q is not supported, 3.x or Swagger 2.0 is expected", version))
+	}
+	if schemaOptions.Dialect == DIALECT_JSON_SCHEMA {
+		schemaOptions.Dialect = dialect
+	}
+
+	schemas := m
+	for _, key := range schemasPath {
+		if !existsMapKey(schemas, key) {
+			schemas = nil
+			break
+		}
+		if schemas, ok = schemas[key].(map[string]interface{}); !ok {
+			return nil, errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, key, STRING_OBJECT))
+		}
+	}
+
+	o := &OpenApiDocument{version: version, schemas: make(map[string]*JsonSchemaDocument, len(schemas))}
+	for name := range schemas {
+		d, err := compileOpenApiSchema(reference, spd, "#/"+strings.Join(schemasPath, "/")+"/"+escapeJsonPointerToken(name), schemaOptions)
+		if err != nil {
+			return nil, err
+		}
+		o.schemas[name] = d
+	}
+	return o, nil
+}
+
+// Compiles a schema of the OpenAPI document, spd, already loaded, its root
+// references the schema at the fragment
+func compileOpenApiSchema(reference gojsonreference.JsonReference, spd *schemaPoolDocument, fragment string, schemaOptions SchemaOptions) (*JsonSchemaDocument, error) {
+
+	start := time.Now()
+
+	d := newJsonSchemaDocument(schemaOptions)
+	// a reference of its own, the pool clears the fragment of its url
+	documentReference, err := gojsonreference.NewJsonReference(reference.String())
+	if err != nil {
+		return nil, err
+	}
+	d.documentReference = documentReference
+	d.pool.addPoolDocument(d.documentReference, spd)
+
+	err = d.parse(map[string]interface{}{KEY_REF: fragment})
+	if schemaError, ok := err.(*SchemaError); ok {
+		schemaError.locate(d.pool)
+	}
+	if err == nil {
+		err = d.compiled(start)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Version returns the openapi version of the document, ex: 3.1.0, 2.0 for Swagger 2.0
+func (o *OpenApiDocument) Version() string {
+	return o.version
+}
+
+// Schema returns the schema document of a components.schemas entry, or of a
+// definitions entry, nil when there is none
+func (o *OpenApiDocument) Schema(name string) *JsonSchemaDocument {
+	return o.schemas[name]
+}
+
+// SchemaNames returns the names of the schemas, sorted
+func (o *OpenApiDocument) SchemaNames() []string {
+	names := make([]string, 0, len(o.schemas))
+	for name := range o.schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
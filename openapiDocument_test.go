@@ -0,0 +1,158 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schemas of OpenAPI documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testOpenApiDocument = `{
+	"openapi":"3.1.0",
+	"info":{"title":"Pets","version":"1"},
+	"paths":{},
+	"components":{
+		"schemas":{
+			"Pet":{
+				"type":"object",
+				"required":["name"],
+				"properties":{
+					"name":{"type":"string"},
+					"tag":{"type":["string","null"]},
+					"category":{"$ref":"#/components/schemas/Category"}
+				}
+			},
+			"Category":{"type":"object","properties":{"id":{"type":"integer","minimum":1}}}
+		}
+	}
+}`
+
+func TestOpenApiDocument(t *testing.T) {
+
+	file := filepath.Join(t.TempDir(), "openapi.json")
+	if err := os.WriteFile(file, []byte(testOpenApiDocument), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, document := range []interface{}{"file://" + filepath.ToSlash(file), mustParseJson(t, testOpenApiDocument)} {
+		openApiDocument, err := NewOpenApiDocument(document, SchemaOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := openApiDocument.SchemaNames(); !reflect.DeepEqual(names, []string{"Category", "Pet"}) || openApiDocument.Version() != "3.1.0" {
+			t.Errorf("Expects the schemas of the components, given %v %s", names, openApiDocument.Version())
+		}
+		if openApiDocument.Schema("Order") != nil {
+			t.Errorf("Expects no schema for an unknown name")
+		}
+
+		pet := openApiDocument.Schema("Pet")
+		if result := pet.Validate(mustParseJson(t, `{"name":"Rex","tag":null,"category":{"id":2}}`)); !result.IsValid() {
+			t.Errorf("Expects the pet to be valid, given %v", result.GetErrorMessages())
+		}
+		result := pet.Validate(mustParseJson(t, `{"category":{"id":0}}`))
+		if len(result.GetErrors()) != 2 || !hasErrorType(result, ERROR_TYPE_REQUIRED) || !hasErrorType(result, ERROR_TYPE_MINIMUM) {
+			t.Errorf("Expects the referenced schema to be validated, given %v", result.GetErrorMessages())
+		}
+	}
+}
+
+func TestOpenApiDocumentErrors(t *testing.T) {
+
+	if _, err := NewOpenApiDocument(mustParseJson(t, `{"swagger":"1.2"}`), SchemaOptions{}); err == nil {
+		t.Errorf("Expects a document without openapi 3 or swagger 2.0 to be an error")
+	}
+
+	invalid := strings.Replace(testOpenApiDocument, `"minimum":1`, `"minimum":"1"`, 1)
+	_, err := NewOpenApiDocument(mustParseJson(t, invalid), SchemaOptions{})
+	if schemaError, ok := err.(*SchemaError); !ok || !strings.HasPrefix(schemaError.Pointer, "/components/schemas/Category") {
+		t.Errorf("Expects the invalid schema to be located, given %v", err)
+	}
+
+	// the schema objects of OpenAPI 3.0
+	openApiDocument, err := NewOpenApiDocument(mustParseJson(t, `{"openapi":"3.0.3","components":{"schemas":{"Tag":{"type":"string","nullable":true}}}}`), SchemaOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := openApiDocument.Schema("Tag").Validate(nil); !result.IsValid() {
+		t.Errorf("Expects nullable to be read in an OpenAPI 3.0 document, given %v", result.GetErrorMessages())
+	}
+}
+
+func TestSwaggerDocument(t *testing.T) {
+
+	swaggerDocument, err := NewOpenApiDocument(mustParseJson(t, `{
+		"swagger": "2.0",
+		"definitions": {
+			"Pet": {
+				"type": "object",
+				"discriminator": "kind",
+				"required": ["kind", "name"],
+				"properties": {
+					"kind": {"type": "string"},
+					"id": {"type": "integer", "readOnly": true},
+					"name": {"type": "string"},
+					"owner": {"$ref": "#/definitions/Owner"}
+				}
+			},
+			"Owner": {"type": "object", "required": ["name"]}
+		}
+	}`), SchemaOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swaggerDocument.Version() != "2.0" {
+		t.Errorf("Expects the version 2.0, given %s", swaggerDocument.Version())
+	}
+	if names := swaggerDocument.SchemaNames(); len(names) != 2 || names[0] != "Owner" || names[1] != "Pet" {
+		t.Errorf("Expects the definitions to be compiled, given %v", names)
+	}
+
+	pet := swaggerDocument.Schema("Pet")
+	if result := pet.Validate(mustParseJson(t, `{"kind":"dog","id":1,"name":"Rex"}`)); !result.IsValid() {
+		t.Errorf("Expects the pet to be valid, given %v", result.GetErrorMessages())
+	}
+	if result := pet.Validate(mustParseJson(t, `{"kind":"dog","name":"Rex","owner":{}}`)); !hasErrorType(result, ERROR_TYPE_REQUIRED) {
+		t.Errorf("Expects the referenced definition to be validated, given %v", result.GetErrorMessages())
+	}
+	pet.SetAccessMode(ACCESS_MODE_REQUEST)
+	if result := pet.Validate(mustParseJson(t, `{"kind":"dog","id":1,"name":"Rex"}`)); !hasErrorType(result, ERROR_TYPE_READ_ONLY) {
+		t.Errorf("Expects readOnly to be read in a Swagger 2.0 document, given %v", result.GetErrorMessages())
+	}
+
+	for _, definition := range []string{
+		`{"oneOf":[{"type":"string"}]}`,
+		`{"type":["string","null"]}`,
+		`{"discriminator":{"propertyName":"kind"}}`,
+	} {
+		if _, err := NewOpenApiDocument(mustParseJson(t, `{"swagger":"2.0","definitions":{"Pet":`+definition+`}}`), SchemaOptions{}); err == nil {
+			t.Errorf("Expects %s to be an error in a Swagger 2.0 document", definition)
+		}
+	}
+}
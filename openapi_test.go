@@ -0,0 +1,111 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the OpenAPI 3.0 dialect.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+const testOpenApiSchema = `{
+	"type":"object",
+	"required":["id","password","name"],
+	"properties":{
+		"id":{"type":"integer","readOnly":true},
+		"password":{"type":"string","writeOnly":true},
+		"name":{"type":"string","nullable":true,"example":"Ann"},
+		"links":{"type":"array","xml":{"wrapped":true},"externalDocs":{"url":"https://example.com"}}
+	}
+}`
+
+func TestOpenApiDialect(t *testing.T) {
+
+	options := SchemaOptions{Dialect: DIALECT_OPENAPI_3_0}
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, testOpenApiSchema), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := schemaDocument.Validate(mustParseJson(t, `{"id":1,"password":"p","name":null}`)); !result.IsValid() {
+		t.Errorf("Expects a nullable property to accept null, given %v", result.GetErrorMessages())
+	}
+	if examples := schemaDocument.Root().Properties()["name"].Examples(); len(examples) != 1 || examples[0] != "Ann" {
+		t.Errorf("Expects example to be an example, given %v", examples)
+	}
+
+	plain := mustNewSchemaDocument(t, testOpenApiSchema)
+	if result := plain.Validate(mustParseJson(t, `{"id":1,"password":"p","name":null}`)); result.IsValid() {
+		t.Errorf("Expects nullable to be ignored by json schema")
+	}
+
+	for _, schema := range []string{`{"nullable":"yes"}`, `{"xml":true}`, `{"readOnly":true,"writeOnly":true}`} {
+		if _, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, schema), options); err == nil {
+			t.Errorf("Expects %s to be a schema error", schema)
+		}
+	}
+}
+
+func TestAccessMode(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, testOpenApiSchema), SchemaOptions{Dialect: DIALECT_OPENAPI_3_0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		accessMode AccessMode
+		document   string
+		errorType  ErrorType
+	}{
+		{ACCESS_MODE_ANY, `{"id":1,"password":"p","name":"a"}`, ""},
+		{ACCESS_MODE_ANY, `{"password":"p","name":"a"}`, ERROR_TYPE_REQUIRED},
+		{ACCESS_MODE_REQUEST, `{"password":"p","name":"a"}`, ""},
+		{ACCESS_MODE_REQUEST, `{"id":1,"password":"p","name":"a"}`, ERROR_TYPE_READ_ONLY},
+		{ACCESS_MODE_REQUEST, `{"name":"a"}`, ERROR_TYPE_REQUIRED},
+		{ACCESS_MODE_RESPONSE, `{"id":1,"name":"a"}`, ""},
+		{ACCESS_MODE_RESPONSE, `{"id":1,"password":"p","name":"a"}`, ERROR_TYPE_WRITE_ONLY},
+	}
+
+	for _, test := range tests {
+		schemaDocument.SetAccessMode(test.accessMode)
+		result := schemaDocument.Validate(mustParseJson(t, test.document))
+		if test.errorType == "" && !result.IsValid() || test.errorType != "" && (len(result.GetErrors()) != 1 || !hasErrorType(result, test.errorType)) {
+			t.Errorf("Expects %s in access mode %d to have the error %q, given %v", test.document, test.accessMode, test.errorType, result.GetErrorMessages())
+		}
+	}
+
+	data, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	loaded.SetAccessMode(ACCESS_MODE_REQUEST)
+	if result := loaded.Validate(mustParseJson(t, `{"id":1,"password":"p","name":"a"}`)); !hasErrorType(result, ERROR_TYPE_READ_ONLY) {
+		t.Errorf("Expects the loaded schema to keep readOnly, given %v", result.GetErrorMessages())
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      OpenTelemetry spans of the validations.
+//
+// created          16-10-2026
+
+// Package oteltracing wraps the validations of json schemas in OpenTelemetry spans.
+//
+//	schema.SetTracer(oteltracing.New(otel.Tracer("myapp")))
+//	result := schema.ValidateContext(request.Context(), document)
+package oteltracing
+
+import (
+	"context"
+	"github.com/sigu-399/gojsonschema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+const (
+	SPAN_NAME = "jsonschema.validate"
+
+	ATTRIBUTE_SCHEMA_ID     = "jsonschema.schema_id"
+	ATTRIBUTE_DOCUMENT_SIZE = "jsonschema.document_size"
+	ATTRIBUTE_VALID         = "jsonschema.valid"
+	ATTRIBUTE_ERROR_COUNT   = "jsonschema.error_count"
+	ATTRIBUTE_DURATION      = "jsonschema.duration_ms"
+)
+
+// New returns a tracer starting a span for each validation, with the id of
+// the schema, the size of the document ( when validated from its json text ),
+// the number of errors and the duration as attributes
+// The span of an invalid document has an error status
+func New(tracer trace.Tracer) gojsonschema.Tracer {
+	return &validationTracer{tracer: tracer}
+}
+
+type validationTracer struct {
+	tracer trace.Tracer
+}
+
+func (t *validationTracer) StartValidation(ctx context.Context, info gojsonschema.ValidationInfo) func(result *gojsonschema.ValidationResult) {
+
+	attributes := []attribute.KeyValue{attribute.String(ATTRIBUTE_SCHEMA_ID, info.SchemaId)}
+	if info.DocumentSize >= 0 {
+		attributes = append(attributes, attribute.Int(ATTRIBUTE_DOCUMENT_SIZE, info.DocumentSize))
+	}
+	_, span := t.tracer.Start(ctx, SPAN_NAME, trace.WithAttributes(attributes...))
+	start := time.Now()
+
+	return func(result *gojsonschema.ValidationResult) {
+		span.SetAttributes(
+			attribute.Bool(ATTRIBUTE_VALID, result.IsValid()),
+			attribute.Int(ATTRIBUTE_ERROR_COUNT, len(result.GetErrors())),
+			attribute.Float64(ATTRIBUTE_DURATION, float64(time.Since(start))/float64(time.Millisecond)),
+		)
+		if !result.IsValid() {
+			span.SetStatus(codes.Error, gojsonschema.ErrInvalidDocument.Error())
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of a part of a document only.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonpointer"
+	"strconv"
+	"strings"
+)
+
+// ValidateAt validates only the sub-document found at pointer ( ex: /a/0/b )
+// against the schemas applying to it, the rest of the document is ignored
+// The schemas are found following properties, patternProperties,
+// additionalProperties, items, additionalItems and allOf, the branches of
+// anyOf and oneOf cannot be chosen without the parent and are not followed
+func (v *JsonSchemaDocument) ValidateAt(pointer string, document interface{}) (*ValidationResult, error) {
+
+	tokens, err := parseJsonPointerTokens(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonPointer, err := gojsonpointer.NewJsonPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if !isJsonValue(document) {
+		document, err = toJsonValue(document)
+		if err != nil {
+			return nil, err
+		}
+	}
+	subDocument, _, err := jsonPointer.Get(document)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tokens of the arrays of the document are indexes
+	context := v.getOptions().rootContext()
+	node := document
+	for _, token := range tokens {
+		switch value := node.(type) {
+		case []interface{}:
+			index, _ := strconv.Atoi(token)
+			context = consJsonIndexContext(index, context)
+			node = value[index]
+		case map[string]interface{}:
+			context = consJsonContext(token, context)
+			node = value[token]
+		}
+	}
+
+	result := &ValidationResult{options: v.getOptions(), context: context}
+	for _, schema := range v.rootSchema.schemasAt(document, tokens) {
+		result.Merge(schema.Validate(subDocument, context, v.getOptions()))
+	}
+
+	result.sortErrors()
+	result.removeDuplicateErrors()
+	return result, nil
+}
+
+// RevalidatePatch applies a json patch ( RFC 6902 ) to a copy of a valid
+// document and revalidates only the parts changed by the patch
+// For each changed value its parent is revalidated, so that keywords like
+// required or dependencies are checked, or the closest ancestor with keywords
+// depending on the whole value ( anyOf, oneOf, not, enum, uniqueItems... )
+// Returns the patched document, the original one is left untouched
+func (v *JsonSchemaDocument) RevalidatePatch(document interface{}, patch []byte) (interface{}, *ValidationResult, error) {
+
+	operations, err := parseJsonPatch(patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isJsonValue(document) {
+		document, err = toJsonValue(document)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	patched, changed, err := applyJsonPatch(document, operations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// pointers to revalidate, without the ones inside another one
+	var pointers []string
+	for _, pointer := range changed {
+		tokens, _ := parseJsonPointerTokens(pointer)
+		pointer = v.rootSchema.revalidationPointer(patched, tokens)
+		covered := false
+		for i := 0; i < len(pointers); i++ {
+			if pointers[i] == pointer || strings.HasPrefix(pointer, pointers[i]+"/") {
+				covered = true
+			} else if strings.HasPrefix(pointers[i], pointer+"/") {
+				pointers = append(pointers[:i], pointers[i+1:]...)
+				i--
+			}
+		}
+		if !covered {
+			pointers = append(pointers, pointer)
+		}
+	}
+
+	result := &ValidationResult{options: v.getOptions()}
+	for _, pointer := range pointers {
+		pointerResult, err := v.ValidateAt(pointer, patched)
+		if err != nil {
+			return nil, nil, err
+		}
+		result.Merge(pointerResult)
+	}
+
+	result.sortErrors()
+	result.removeDuplicateErrors()
+	return patched, result, nil
+}
+
+// revalidationPointer returns the pointer to revalidate when the value at
+// tokens of the document changed : its parent or the first ancestor depending
+// on the whole value
+func (v *jsonSchema) revalidationPointer(document interface{}, tokens []string) string {
+
+	if len(tokens) > 0 {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	for depth := 0; depth < len(tokens); depth++ {
+		for _, schema := range expandAllOf(v.schemasAt(document, tokens[:depth])) {
+			if schema.dependsOnWholeValue() {
+				tokens = tokens[:depth]
+				break
+			}
+		}
+	}
+
+	pointer := ""
+	for _, token := range tokens {
+		pointer += "/" + escapeJsonPointerToken(token)
+	}
+	return pointer
+}
+
+// dependsOnWholeValue tells if the schema has keywords whose result can change
+// with any value nested in the validated one
+func (v *jsonSchema) dependsOnWholeValue() bool {
+
+	if len(v.anyOf) > 0 || len(v.oneOf) > 0 || v.not != nil || len(v.enum) > 0 || v.uniqueItems {
+		return true
+	}
+	for _, dependency := range v.dependencies {
+		if _, ok := dependency.(*jsonSchema); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseJsonPointerTokens splits and unescapes a json pointer
+func parseJsonPointerTokens(pointer string) ([]string, error) {
+
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.New(fmt.Sprintf("json pointer %s must be empty or start with a /", pointer))
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = unescapeJsonPointerToken(tokens[i])
+	}
+	return tokens, nil
+}
+
+// schemasAt returns the schemas applying to the value found following tokens
+// from a document validated by the schema, a token is an index in the arrays
+// of the document and a property name in its objects
+func (v *jsonSchema) schemasAt(document interface{}, tokens []string) []*jsonSchema {
+
+	schemas := []*jsonSchema{v}
+
+	node := document
+	for _, token := range tokens {
+		array, isArray := node.([]interface{})
+		var children []*jsonSchema
+		for _, schema := range expandAllOf(schemas) {
+			children = append(children, schema.childSchemas(token, isArray)...)
+		}
+		schemas = children
+
+		object, _ := node.(map[string]interface{})
+		node = object[token]
+		if isArray {
+			if index, err := strconv.Atoi(token); err == nil && index >= 0 && index < len(array) {
+				node = array[index]
+			}
+		}
+	}
+
+	return schemas
+}
+
+// expandAllOf resolves references and adds the allOf schemas, recursively
+func expandAllOf(schemas []*jsonSchema) []*jsonSchema {
+
+	var expanded []*jsonSchema
+	for _, schema := range schemas {
+		for schema.refSchema != nil {
+			schema = schema.refSchema
+		}
+		expanded = append(expanded, schema)
+		expanded = append(expanded, expandAllOf(schema.allOf)...)
+	}
+	return expanded
+}
+
+// childSchemas returns the schemas applying to the item token of an array or
+// to the property token of an object
+func (v *jsonSchema) childSchemas(token string, isArray bool) []*jsonSchema {
+
+	var children []*jsonSchema
+
+	if isArray {
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 {
+			return nil
+		}
+		if v.itemsChildrenIsSingleSchema {
+			children = append(children, v.itemsChildren[0])
+		} else if index < len(v.itemsChildren) {
+			children = append(children, v.itemsChildren[index])
+		} else if additionalItems, ok := v.additionalItems.(*jsonSchema); ok && len(v.itemsChildren) > 0 {
+			children = append(children, additionalItems)
+		}
+		return children
+	}
+
+	property, matched := v.propertiesIndex[token]
+	if matched {
+		children = append(children, property)
+	}
+	for pattern, schema := range v.patternProperties {
+		// a pattern that could not be matched applies rather than additionalProperties
+		if ok, err := v.patternPropertiesRegexp[pattern].MatchString(token); ok || err != nil {
+			children = append(children, schema)
+			matched = true
+		}
+	}
+	if additionalProperties, ok := v.additionalProperties.(*jsonSchema); ok && !matched {
+		children = append(children, additionalProperties)
+	}
+
+	return children
+}
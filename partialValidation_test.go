@@ -0,0 +1,146 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the validation of a part of a document.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestValidateAt(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"required":["name"],
+		"properties":{"servers":{"items":{"properties":{"port":{"type":"integer"}}}}},
+		"patternProperties":{"^x-":{"type":"string"}},
+		"additionalProperties":{"type":"boolean"},
+		"allOf":[{"properties":{"servers":{"maxItems":1}}}]
+	}`)
+
+	// the missing name is outside of the validated part
+	document := mustParseJson(t, `{"servers":[{"port":80},{"port":"443"}],"x-owner":1,"debug":true,"a/b":"x"}`)
+
+	tests := []struct {
+		pointer  string
+		expected []string
+	}{
+		{"/servers/0/port", nil},
+		{"/servers/1/port", []string{`ROOT.servers.1.port : port must be of type integer`}},
+		{"/servers", []string{`ROOT.servers : servers must have at the most 1 items`, `ROOT.servers.1.port : port must be of type integer`}},
+		{"/x-owner", []string{`ROOT.x-owner : ^x- must be of type string`}},
+		{"/debug", nil},
+		{"/a~1b", []string{`ROOT.a/b : additionalProperties must be of type boolean`}},
+	}
+
+	for _, test := range tests {
+		result, err := schemaDocument.ValidateAt(test.pointer, document)
+		if err != nil {
+			t.Errorf("Could not validate at %s : %s", test.pointer, err.Error())
+			continue
+		}
+		messages := result.GetErrorMessages()
+		if len(messages) != len(test.expected) {
+			t.Errorf("Expects %v at %s, given %v", test.expected, test.pointer, messages)
+			continue
+		}
+		for i := range test.expected {
+			if messages[i] != test.expected[i] {
+				t.Errorf("Expects %q at %s, given %q", test.expected[i], test.pointer, messages[i])
+			}
+		}
+	}
+
+	result, _ := schemaDocument.ValidateAt("/servers/1/port", document)
+	if path := result.GetErrors()[0].Path(); len(path) != 3 || path[1] != 1 {
+		t.Errorf("Expects the index of the server as an int, given %#v", path)
+	}
+
+	for _, pointer := range []string{"servers", "/servers/2"} {
+		if _, err := schemaDocument.ValidateAt(pointer, document); err == nil {
+			t.Errorf("Expects %s to be an error", pointer)
+		}
+	}
+
+	// a numeric token is a property of an object and an index of an array
+	schemaDocument = mustNewSchemaDocument(t, `{"additionalProperties":{
+		"items":{"type":"string"},
+		"patternProperties":{"^[0-9]+$":{"type":"integer"}}
+	}}`)
+	document = mustParseJson(t, `{"object":{"0":5},"array":["a"]}`)
+	for _, pointer := range []string{"/object/0", "/array/0"} {
+		result, err := schemaDocument.ValidateAt(pointer, document)
+		if err != nil || !result.IsValid() {
+			t.Errorf("Expects %s to be valid, given %v %v", pointer, err, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestRevalidatePatch(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"required":["name"],
+		"properties":{
+			"name":{"type":"string"},
+			"servers":{"items":{"required":["port"],"properties":{"port":{"type":"integer"}}}},
+			"tags":{"uniqueItems":true,"items":{"type":"string"}}
+		}
+	}`)
+
+	document := mustParseJson(t, `{"name":"x","servers":[{"port":80}],"tags":["a","b"]}`)
+
+	tests := []struct {
+		patch    string
+		expected []string
+	}{
+		{`[{"op":"replace","path":"/servers/0/port","value":8080}]`, nil},
+		{`[{"op":"replace","path":"/servers/0/port","value":"8080"}]`, []string{`ROOT.servers.0.port : port must be of type integer`}},
+		{`[{"op":"remove","path":"/servers/0/port"}]`, []string{`ROOT.servers.0 : port property is required`}},
+		{`[{"op":"move","from":"/name","path":"/title"}]`, []string{`ROOT : name property is required`}},
+		{`[{"op":"add","path":"/tags/-","value":"a"}]`, []string{`ROOT.tags : tags items must be unique`}},
+		{`[{"op":"copy","from":"/servers/0","path":"/servers/-"},{"op":"test","path":"/servers/1/port","value":80}]`, nil},
+	}
+
+	for _, test := range tests {
+		_, result, err := schemaDocument.RevalidatePatch(document, []byte(test.patch))
+		if err != nil {
+			t.Errorf("Could not revalidate %s : %s", test.patch, err.Error())
+			continue
+		}
+		messages := result.GetErrorMessages()
+		if len(messages) != len(test.expected) {
+			t.Errorf("Expects %v for %s, given %v", test.expected, test.patch, messages)
+			continue
+		}
+		for i := range test.expected {
+			if messages[i] != test.expected[i] {
+				t.Errorf("Expects %q for %s, given %q", test.expected[i], test.patch, messages[i])
+			}
+		}
+	}
+
+	if given := *mustMarshalToString(t, document); given != `{"name":"x","servers":[{"port":80}],"tags":["a","b"]}` {
+		t.Errorf("Expects the original document to be untouched, given %s", given)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Renderings of the locations of the document.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PathFormat is the rendering of the locations in the document of the errors,
+// annotations and matched branches of a result
+type PathFormat int
+
+const (
+	// ROOT.items.3.name, the default
+	PATH_FORMAT_DOTTED PathFormat = iota
+	// /items/3/name
+	PATH_FORMAT_JSON_POINTER
+	// $.items[3].name
+	PATH_FORMAT_JSON_PATH
+)
+
+// Property names written with the dot notation in a json path, the others are quoted
+var jsonPathIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+var jsonPathQuoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// format renders the context in the path format
+func (c *jsonContext) format(format PathFormat) string {
+	switch format {
+	case PATH_FORMAT_JSON_POINTER:
+		return c.pointer()
+	case PATH_FORMAT_JSON_PATH:
+		return c.jsonPath()
+	default:
+		return c.String()
+	}
+}
+
+// jsonPath returns the json path of the context, ex: $.items[0]['a b'] for ROOT.items.0.a b
+func (c *jsonContext) jsonPath() string {
+	var path strings.Builder
+	path.WriteString("$")
+	for _, element := range c.path() {
+		switch element := element.(type) {
+		case int:
+			path.WriteString("[" + strconv.Itoa(element) + "]")
+		case string:
+			if jsonPathIdentifierRegexp.MatchString(element) {
+				path.WriteString("." + element)
+			} else {
+				path.WriteString("['" + jsonPathQuoteReplacer.Replace(element) + "']")
+			}
+		}
+	}
+	return path.String()
+}
+
+// SetPathFormat renders the locations of the errors, annotations and matched
+// branches of the result, and of its children, in the path format
+func (v *ValidationResult) SetPathFormat(format PathFormat) {
+	v.pathFormat = format
+	for _, resultError := range v.resultErrors {
+		resultError.formatMessage()
+		if resultError.context != nil {
+			resultError.Context = resultError.context.format(format)
+		}
+	}
+	for _, annotation := range v.annotations {
+		if annotation.context != nil {
+			annotation.Context = annotation.context.format(format)
+		}
+	}
+	for _, child := range v.children {
+		child.SetPathFormat(format)
+	}
+}
+
+// Renders a location of the document in the path format of the result
+func (v *ValidationResult) formatPath(context *jsonContext) string {
+	if context == nil {
+		return ""
+	}
+	return context.format(v.pathFormat)
+}
@@ -0,0 +1,58 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the path formats.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestPathFormat(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"items":{"items":{"properties":{"name":{"type":"string"},"it's":{"type":"string"}}}}}}`)
+	document := `{"items":[{},{},{},{"name":1,"it's":1}]}`
+
+	expected := map[PathFormat][]string{
+		PATH_FORMAT_DOTTED:       {"ROOT.items.3", "ROOT.items.3.it's", "ROOT.items.3.name"},
+		PATH_FORMAT_JSON_POINTER: {"/items/3", "/items/3/it's", "/items/3/name"},
+		PATH_FORMAT_JSON_PATH:    {"$.items[3]", `$.items[3]['it\'s']`, "$.items[3].name"},
+	}
+	for format, paths := range expected {
+		result := schemaDocument.Validate(mustParseJson(t, document))
+		result.SetPathFormat(format)
+		errors := result.GetErrors()
+		if len(errors) != 2 {
+			t.Fatalf("Expects 2 errors, given %v", errors)
+		}
+		for i, resultError := range errors {
+			if resultError.Context != paths[i+1] {
+				t.Errorf("Expects the path %s, given %s", paths[i+1], resultError.Context)
+			}
+		}
+		if child := result.Child(KEY_PROPERTIES, "items").Child(KEY_ITEMS, "3"); child.Context() != paths[0] {
+			t.Errorf("Expects the child path %s, given %s", paths[0], child.Context())
+		}
+	}
+}
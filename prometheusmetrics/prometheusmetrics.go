@@ -0,0 +1,112 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Prometheus collectors of the validation metrics.
+//
+// created          16-10-2026
+
+// Package prometheusmetrics counts the validations of json schemas in prometheus.
+//
+//	metrics, err := prometheusmetrics.New(prometheus.DefaultRegisterer, "myapp")
+//	schema.SetMetrics(metrics.Schema("user"))
+package prometheusmetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sigu-399/gojsonschema"
+	"time"
+)
+
+const (
+	LABEL_SCHEMA  = "schema"
+	LABEL_RESULT  = "result"
+	LABEL_KEYWORD = "keyword"
+
+	RESULT_VALID   = "valid"
+	RESULT_INVALID = "invalid"
+)
+
+// Metrics holds the collectors, shared by the schemas
+type Metrics struct {
+	validations     *prometheus.CounterVec
+	keywordFailures *prometheus.CounterVec
+	duration        *prometheus.HistogramVec
+}
+
+// New creates and registers the collectors :
+// <namespace>_jsonschema_validations_total{schema,result},
+// <namespace>_jsonschema_keyword_failures_total{schema,keyword} and
+// <namespace>_jsonschema_validation_duration_seconds{schema}
+func New(registerer prometheus.Registerer, namespace string) (*Metrics, error) {
+
+	m := &Metrics{
+		validations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "jsonschema",
+			Name:      "validations_total",
+			Help:      "Number of validated documents.",
+		}, []string{LABEL_SCHEMA, LABEL_RESULT}),
+		keywordFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "jsonschema",
+			Name:      "keyword_failures_total",
+			Help:      "Number of validation errors by keyword.",
+		}, []string{LABEL_SCHEMA, LABEL_KEYWORD}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "jsonschema",
+			Name:      "validation_duration_seconds",
+			Help:      "Duration of the validations.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{LABEL_SCHEMA}),
+	}
+
+	for _, collector := range []prometheus.Collector{m.validations, m.keywordFailures, m.duration} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Schema returns the metrics of one schema, labelled with its name
+func (m *Metrics) Schema(name string) gojsonschema.Metrics {
+	return &schemaMetrics{metrics: m, name: name}
+}
+
+type schemaMetrics struct {
+	metrics *Metrics
+	name    string
+}
+
+func (s *schemaMetrics) ObserveValidation(valid bool, duration time.Duration) {
+	result := RESULT_VALID
+	if !valid {
+		result = RESULT_INVALID
+	}
+	s.metrics.validations.WithLabelValues(s.name, result).Inc()
+	s.metrics.duration.WithLabelValues(s.name).Observe(duration.Seconds())
+}
+
+func (s *schemaMetrics) ObserveKeywordFailure(keyword string) {
+	s.metrics.keywordFailures.WithLabelValues(s.name, keyword).Inc()
+}
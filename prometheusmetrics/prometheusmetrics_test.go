@@ -0,0 +1,65 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the prometheus collectors.
+//
+// created          16-10-2026
+
+package prometheusmetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sigu-399/gojsonschema"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+
+	registry := prometheus.NewRegistry()
+	metrics, err := New(registry, "test")
+	if err != nil {
+		t.Fatalf("Could not register the collectors : %s", err.Error())
+	}
+	if _, err := New(registry, "test"); err == nil {
+		t.Errorf("Expects an error when the collectors are already registered")
+	}
+
+	schemaDocument, err := gojsonschema.NewJsonSchemaDocument(map[string]interface{}{"required": []interface{}{"name"}})
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	schemaDocument.SetMetrics(metrics.Schema("user"))
+
+	schemaDocument.Validate(map[string]interface{}{"name": "a"})
+	schemaDocument.Validate(map[string]interface{}{})
+	schemaDocument.Validate(map[string]interface{}{})
+
+	if given := testutil.ToFloat64(metrics.validations.WithLabelValues("user", RESULT_VALID)); given != 1 {
+		t.Errorf("Expects 1 valid document, given %v", given)
+	}
+	if given := testutil.ToFloat64(metrics.validations.WithLabelValues("user", RESULT_INVALID)); given != 2 {
+		t.Errorf("Expects 2 invalid documents, given %v", given)
+	}
+	if given := testutil.ToFloat64(metrics.keywordFailures.WithLabelValues("user", gojsonschema.KEY_REQUIRED)); given != 2 {
+		t.Errorf("Expects 2 required failures, given %v", given)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Graph of the references between schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ReferenceGraph is the graph of the $ref between the schemas of a document and the documents it references
+// Nodes are named document#pointer, they are the roots and definitions of the documents
+// and the other referenced schemas
+type ReferenceGraph struct {
+	Nodes []string
+	Edges []ReferenceEdge
+}
+
+// ReferenceEdge goes from the root or definition holding a $ref to the referenced schema
+type ReferenceEdge struct {
+	From string
+	To   string
+}
+
+// ReferenceGraph returns the graph of the resolved references, sorted by name
+func (d *JsonSchemaDocument) ReferenceGraph() *ReferenceGraph {
+
+	graph := &ReferenceGraph{}
+	nodes := make(map[string]bool)
+	edges := make(map[ReferenceEdge]bool)
+	var queue []*jsonSchema
+
+	addNode := func(schema *jsonSchema) string {
+		node := referenceGraphNode(schema)
+		if !nodes[node] {
+			nodes[node] = true
+			graph.Nodes = append(graph.Nodes, node)
+			queue = append(queue, schema)
+		}
+		return node
+	}
+
+	addNode(d.rootSchema)
+	for len(queue) > 0 {
+		resource := queue[0]
+		queue = queue[1:]
+		from := referenceGraphNode(resource)
+
+		resource.walk("", func(path string, schema *jsonSchema) bool {
+			// definitions are nodes of their own
+			if schema != resource && schema.property == KEY_DEFINITIONS {
+				addNode(schema)
+				return false
+			}
+			if schema.refSchema != nil {
+				edge := ReferenceEdge{From: from, To: addNode(schema.refSchema)}
+				if !edges[edge] {
+					edges[edge] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+			}
+			return true
+		})
+	}
+
+	sort.Strings(graph.Nodes)
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+
+	return graph
+}
+
+func referenceGraphNode(schema *jsonSchema) string {
+	node := &SchemaNode{schema: schema}
+	return node.Document() + "#" + node.Pointer()
+}
+
+// Dot returns the graph in the graphviz dot language
+func (g *ReferenceGraph) Dot() string {
+
+	var dot strings.Builder
+	dot.WriteString("digraph references {\n")
+	for _, node := range g.Nodes {
+		dot.WriteString("\t" + strconv.Quote(node) + ";\n")
+	}
+	for _, edge := range g.Edges {
+		dot.WriteString("\t" + strconv.Quote(edge.From) + " -> " + strconv.Quote(edge.To) + ";\n")
+	}
+	dot.WriteString("}\n")
+
+	return dot.String()
+}
+
+// Adjacency returns the nodes referenced by each node
+func (g *ReferenceGraph) Adjacency() map[string][]string {
+	adjacency := make(map[string][]string, len(g.Nodes))
+	for _, node := range g.Nodes {
+		adjacency[node] = []string{}
+	}
+	for _, edge := range g.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+	}
+	return adjacency
+}
+
+// MarshalJSON writes the graph as an adjacency list : {"node":["referenced node",...]}
+func (g *ReferenceGraph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.Adjacency())
+}
@@ -0,0 +1,87 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the graph of references.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReferenceGraph(t *testing.T) {
+
+	directory := t.TempDir()
+	files := map[string]string{
+		"main.json": `{
+			"definitions":{
+				"Name":{"type":"string"},
+				"Node":{"properties":{"name":{"$ref":"#/definitions/Name"},"children":{"items":{"$ref":"#/definitions/Node"}}}},
+				"Unused":{}
+			},
+			"properties":{
+				"tree":{"$ref":"#/definitions/Node"},
+				"port":{"$ref":"other.json#/definitions/Port"}
+			}
+		}`,
+		"other.json": `{"definitions":{"Port":{"type":"integer"}}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Could not write %s : %s", name, err.Error())
+		}
+	}
+
+	base := "file://" + filepath.ToSlash(directory) + "/"
+	schemaDocument, err := NewJsonSchemaDocument(base + "main.json")
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	graph := schemaDocument.ReferenceGraph()
+
+	marshalled, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("Could not marshal the graph : %s", err.Error())
+	}
+	expected := `{` +
+		`"main.json#":["main.json#/definitions/Node","other.json#/definitions/Port"],` +
+		`"main.json#/definitions/Name":[],` +
+		`"main.json#/definitions/Node":["main.json#/definitions/Name","main.json#/definitions/Node"],` +
+		`"main.json#/definitions/Unused":[],` +
+		`"other.json#/definitions/Port":[]}`
+	if given := strings.Replace(string(marshalled), base, "", -1); given != expected {
+		t.Errorf("Expects %s, given %s", expected, given)
+	}
+
+	dot := strings.Replace(graph.Dot(), base, "", -1)
+	for _, expected := range []string{"digraph references {\n", "\t\"main.json#/definitions/Node\" -> \"main.json#/definitions/Name\";\n", "\t\"main.json#/definitions/Unused\";\n"} {
+		if !strings.Contains(dot, expected) {
+			t.Errorf("Expects the dot graph to contain %q, given %s", expected, dot)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Regex engines compiling the patterns of the schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"regexp"
+)
+
+// RegexEngine compiles the pattern and patternProperties regular expressions
+// of a schema document, see SchemaOptions.RegexEngine
+// The regexp2engine package compiles them with github.com/dlclark/regexp2,
+// which supports the lookarounds and backreferences RE2 does not
+type RegexEngine interface {
+	Compile(pattern string) (Regexp, error)
+}
+
+// Regexp is a regular expression compiled by a RegexEngine, it is used by the
+// validating goroutines concurrently
+type Regexp interface {
+	// An error is a failed match, a timeout for example, it is reported as a
+	// validation error of the keyword
+	MatchString(input string) (bool, error)
+	// The pattern it was compiled from
+	String() string
+}
+
+// RegexEngineFunc adapts a function to a RegexEngine
+type RegexEngineFunc func(pattern string) (Regexp, error)
+
+func (f RegexEngineFunc) Compile(pattern string) (Regexp, error) {
+	return f(pattern)
+}
+
+// The default engine, Go's RE2 regular expressions
+type goRegexp struct {
+	re *regexp.Regexp
+}
+
+func compileGoRegexp(pattern string) (Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return goRegexp{re: re}, nil
+}
+
+func (r goRegexp) MatchString(input string) (bool, error) {
+	return r.re.MatchString(input), nil
+}
+
+func (r goRegexp) String() string {
+	return r.re.String()
+}
+
+// A pattern translated from ECMA 262, its text is the pattern of the schema
+type translatedRegexp struct {
+	Regexp
+	source string
+}
+
+func (r translatedRegexp) String() string {
+	return r.source
+}
+
+// Compiles a pattern with Go's regexp package, translated from ECMA 262 if requested
+func compileSchemaRegexp(pattern string, ecmaRegex bool) (Regexp, error) {
+	if !ecmaRegex {
+		return compileGoRegexp(pattern)
+	}
+	goPattern, err := ecmaToGoRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	re, err := compileGoRegexp(goPattern)
+	if err != nil {
+		return nil, err
+	}
+	return translatedRegexp{Regexp: re, source: pattern}, nil
+}
+
+// The RE2 syntax of a compiled pattern
+func re2Pattern(re Regexp) string {
+	if translated, ok := re.(translatedRegexp); ok {
+		return translated.Regexp.String()
+	}
+	return re.String()
+}
@@ -0,0 +1,107 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the regex engines.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Matches the inputs not starting with the prefix of a (?!prefix) pattern
+type testLookahead struct {
+	pattern string
+}
+
+func (r testLookahead) MatchString(input string) (bool, error) {
+	if strings.HasPrefix(input, "slow") {
+		return false, errors.New("match timeout")
+	}
+	return !strings.HasPrefix(input, strings.TrimSuffix(strings.TrimPrefix(r.pattern, "^(?!"), ")")), nil
+}
+
+func (r testLookahead) String() string {
+	return r.pattern
+}
+
+func TestRegexEngine(t *testing.T) {
+
+	var compiled []string
+	engine := RegexEngineFunc(func(pattern string) (Regexp, error) {
+		compiled = append(compiled, pattern)
+		if !strings.HasPrefix(pattern, "^(?!") {
+			return nil, errors.New("unsupported pattern")
+		}
+		return testLookahead{pattern: pattern}, nil
+	})
+
+	schema := mustParseJson(t, `{"properties":{"name":{"pattern":"^(?!admin)"}},"patternProperties":{"^(?!x-)":{"type":"string"}},"additionalProperties":false}`)
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions(schema, SchemaOptions{RegexEngine: engine, EcmaRegex: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compiled) != 2 {
+		t.Errorf("Expects the patterns to be compiled by the engine, given %v", compiled)
+	}
+
+	if result := schemaDocument.Validate(mustParseJson(t, `{"name":"user"}`)); !result.IsValid() {
+		t.Errorf("Expects the document to be valid, given %v", result.GetErrors())
+	}
+	result := schemaDocument.Validate(mustParseJson(t, `{"name":"administrator","x-a":"1"}`))
+	if len(result.GetErrors()) != 2 {
+		t.Errorf("Expects the pattern and the additional property to be reported, given %v", result.GetErrors())
+	}
+
+	result = schemaDocument.Validate(mustParseJson(t, `{"name":"slow","slow":"1"}`))
+	errs := result.GetErrors()
+	if len(errs) != 2 || errs[0].Type != ERROR_TYPE_PATTERN && errs[1].Type != ERROR_TYPE_PATTERN {
+		t.Errorf("Expects the failed matches to be reported, given %v", errs)
+	}
+	for _, resultError := range errs {
+		if resultError.Keyword == KEY_ADDITIONAL_PROPERTIES || !strings.Contains(resultError.Description, "match timeout") {
+			t.Errorf("Expects only the failed matches to be reported, given %v", resultError)
+		}
+	}
+
+	if _, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, `{"pattern":"^a"}`), SchemaOptions{RegexEngine: engine}); err == nil {
+		t.Errorf("Expects the pattern the engine cannot compile to be a schema error")
+	}
+
+	if _, err := schemaDocument.MarshalBinary(); err == nil {
+		t.Errorf("Expects the schema compiled with an engine not to be exported")
+	}
+
+	formatDocument, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, `{"format":"regex"}`), SchemaOptions{RegexEngine: engine})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := formatDocument.Validate("^(?!admin)"); !result.IsValid() {
+		t.Errorf("Expects the regex format to be checked with the engine, given %v", result.GetErrors())
+	}
+	if result := formatDocument.Validate("^a"); result.IsValid() {
+		t.Errorf("Expects the pattern the engine cannot compile not to match the regex format")
+	}
+}
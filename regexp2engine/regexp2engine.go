@@ -0,0 +1,60 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Regex engine of github.com/dlclark/regexp2.
+//
+// created          16-10-2026
+
+// Package regexp2engine compiles the patterns of json schemas with
+// github.com/dlclark/regexp2, which supports lookarounds and backreferences.
+//
+//	schemaDocument, err := gojsonschema.NewJsonSchemaDocumentWithOptions(document, gojsonschema.SchemaOptions{
+//		RegexEngine: regexp2engine.New(time.Second),
+//	})
+package regexp2engine
+
+import (
+	"github.com/dlclark/regexp2"
+	"github.com/sigu-399/gojsonschema"
+	"time"
+)
+
+// New returns an engine compiling the patterns as ECMAScript regular
+// expressions, the regular expressions of JSON schema
+// A match taking longer than timeout fails, it is reported as a validation
+// error, 0 does not limit the matches
+func New(timeout time.Duration) gojsonschema.RegexEngine {
+	return NewWithOptions(regexp2.ECMAScript, timeout)
+}
+
+// NewWithOptions returns an engine compiling the patterns with the options
+func NewWithOptions(options regexp2.RegexOptions, timeout time.Duration) gojsonschema.RegexEngine {
+	return gojsonschema.RegexEngineFunc(func(pattern string) (gojsonschema.Regexp, error) {
+		re, err := regexp2.Compile(pattern, options)
+		if err != nil {
+			return nil, err
+		}
+		if timeout > 0 {
+			re.MatchTimeout = timeout
+		}
+		return re, nil
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Timeouts, retries and concurrency of the remote document loading.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// FetchOptions change how the remote documents of a schema are loaded
+type FetchOptions struct {
+	// Timeout of each request, reading the body included, none when 0
+	Timeout time.Duration
+
+	// Retries of a request failing on a network error or with a 5xx / 429 status
+	Retries int
+
+	// Delay before the first retry, doubled for each of the next ones, 100ms when 0
+	RetryDelay time.Duration
+
+	// Bounds the requests running at once, it may be shared by several schema documents
+	Limiter *FetchLimiter
+}
+
+// FetchLimiter bounds the remote documents loaded at once
+type FetchLimiter struct {
+	slots chan struct{}
+}
+
+func NewFetchLimiter(maxConcurrent int) *FetchLimiter {
+	return &FetchLimiter{slots: make(chan struct{}, maxConcurrent)}
+}
+
+func (l *FetchLimiter) acquire() (release func()) {
+	l.slots <- struct{}{}
+	return func() {
+		<-l.slots
+	}
+}
+
+// httpStatusError is the error of a response that is not 200 OK
+type httpStatusError struct {
+	status     string
+	statusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return "Could not access schema " + e.status
+}
+
+func newHttpStatusError(resp *http.Response) error {
+	return &httpStatusError{status: resp.Status, statusCode: resp.StatusCode}
+}
+
+// isRetryable tells whether a failed request may succeed when sent again
+func isRetryable(err error) bool {
+	if errors.Is(err, ErrReferenceDenied) || errors.Is(err, ErrLimitExceeded) {
+		return false
+	}
+	var statusError *httpStatusError
+	if errors.As(err, &statusError) {
+		return statusError.statusCode >= 500 || statusError.statusCode == http.StatusTooManyRequests
+	}
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// fetch loads the body of a remote document, retrying on the transient failures
+func (p *schemaPool) fetch(url string) ([]byte, error) {
+
+	if p.fetchOptions.Limiter != nil {
+		release := p.fetchOptions.Limiter.acquire()
+		defer release()
+	}
+
+	delay := p.fetchOptions.RetryDelay
+	if delay <= 0 {
+		delay = 100 * time.Millisecond
+	}
+
+	for attempt := 0; ; attempt++ {
+		var text []byte
+		var err error
+		if p.cacheDirectory != "" {
+			text, err = getCachedHttpBody(p.client, p.cacheDirectory, url, p.limits.MaxBytes)
+		} else {
+			text, err = getHttpBody(p.client, url, p.limits.MaxBytes)
+		}
+		if err == nil || attempt >= p.fetchOptions.Retries || !isRetryable(err) {
+			return text, err
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the loading of the remote documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchRetries(t *testing.T) {
+
+	var requests int32
+	failures := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&failures) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/missing.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"type":"string"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path     string
+		failures int32
+		retries  int
+		requests int32
+		loaded   bool
+	}{
+		{"/schema.json", 2, 2, 3, true},
+		{"/schema.json", 2, 1, 2, false},
+		{"/schema.json", 0, 0, 1, true},
+		// only the transient failures are retried
+		{"/missing.json", 0, 3, 1, false},
+	}
+
+	for _, test := range tests {
+		atomic.StoreInt32(&requests, 0)
+		atomic.StoreInt32(&failures, test.failures)
+		_, err := NewJsonSchemaDocumentWithOptions(server.URL+test.path, SchemaOptions{Fetch: FetchOptions{Retries: test.retries, RetryDelay: time.Millisecond}})
+		if (err == nil) != test.loaded {
+			t.Errorf("Expects %s to be loaded : %t after %d failures and %d retries, given %v", test.path, test.loaded, test.failures, test.retries, err)
+		}
+		if atomic.LoadInt32(&requests) != test.requests {
+			t.Errorf("Expects %d requests of %s, given %d", test.requests, test.path, atomic.LoadInt32(&requests))
+		}
+	}
+}
+
+func TestFetchTimeout(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(`{"type":"string"}`))
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	_, err := NewJsonSchemaDocumentWithOptions(server.URL+"/schema.json", SchemaOptions{Fetch: FetchOptions{Timeout: 20 * time.Millisecond}})
+	if err == nil {
+		t.Errorf("Expects the request to time out")
+	}
+	if time.Since(start) > 150*time.Millisecond {
+		t.Errorf("Expects the request to be cancelled after its timeout, given %s", time.Since(start))
+	}
+}
+
+func TestFetchLimiter(t *testing.T) {
+
+	var running, maxRunning int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`{"type":"string"}`))
+	}))
+	defer server.Close()
+
+	limiter := NewFetchLimiter(2)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := NewJsonSchemaDocumentWithOptions(server.URL+"/schema.json", SchemaOptions{Fetch: FetchOptions{Limiter: limiter}}); err != nil {
+				t.Errorf("Could not load the schema : %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&maxRunning) > 2 {
+		t.Errorf("Expects at most 2 requests at once, given %d", atomic.LoadInt32(&maxRunning))
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Restrictions on the documents the references of a schema may load.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RemotePolicy restricts the urls a schema document loads, itself and its
+// references, so that a schema cannot make the validator fetch internal endpoints
+type RemotePolicy struct {
+	// Schemes allowed ( http, https, file ), all of them when empty
+	AllowedSchemes []string
+
+	// Hosts allowed, all of them when empty, *.example.com matches the
+	// subdomains of example.com
+	AllowedHosts []string
+
+	// Hosts denied, even when they are allowed
+	DeniedHosts []string
+
+	// Deny the loopback, private and link-local addresses, such as the
+	// 169.254.169.254 metadata endpoint of the cloud providers
+	// The addresses are checked when connecting, after the name resolution,
+	// the documents are not loaded through the proxy of the environment then
+	DenyPrivateNetworks bool
+}
+
+// ErrReferenceDenied is wrapped by the errors of the urls the RemotePolicy denies
+var ErrReferenceDenied = errors.New("reference denied by the remote policy")
+
+func (p *RemotePolicy) isZero() bool {
+	return len(p.AllowedSchemes) == 0 && len(p.AllowedHosts) == 0 && len(p.DeniedHosts) == 0 && !p.DenyPrivateNetworks
+}
+
+// check tells whether a url may be loaded, the hosts of file urls are not checked
+func (p *RemotePolicy) check(u *url.URL) error {
+	scheme := strings.ToLower(u.Scheme)
+	if len(p.AllowedSchemes) > 0 && !isStringInSlice(p.AllowedSchemes, scheme) {
+		return fmt.Errorf("%w: scheme %s of %s", ErrReferenceDenied, scheme, u.String())
+	}
+	if scheme == "file" {
+		return nil
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if len(p.AllowedHosts) > 0 && !matchesHost(p.AllowedHosts, host) {
+		return fmt.Errorf("%w: host %s of %s", ErrReferenceDenied, host, u.String())
+	}
+	if matchesHost(p.DeniedHosts, host) {
+		return fmt.Errorf("%w: host %s of %s", ErrReferenceDenied, host, u.String())
+	}
+	if ip := net.ParseIP(host); ip != nil && p.DenyPrivateNetworks && isPrivateAddress(ip) {
+		return fmt.Errorf("%w: private address %s of %s", ErrReferenceDenied, host, u.String())
+	}
+	return nil
+}
+
+// client returns the http client loading the urls of the policy : the
+// redirections are checked, and the addresses connected to
+func (p *RemotePolicy) client() *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if p.DenyPrivateNetworks {
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateAddress(ip) {
+				return fmt.Errorf("%w: private address %s", ErrReferenceDenied, host)
+			}
+			return nil
+		}
+	}
+
+	// the default transport may have been replaced by a wrapper, the connections
+	// are then made by a transport of its own
+	var transport *http.Transport
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment, ForceAttemptHTTP2: true, IdleConnTimeout: 90 * time.Second}
+	}
+	transport.DialContext = dialer.DialContext
+	if p.DenyPrivateNetworks {
+		// a proxy would connect to the addresses instead
+		transport.Proxy = nil
+	}
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return p.check(request.URL)
+		},
+	}
+}
+
+// matchesHost tells whether host is one of the patterns, *.example.com
+// matching the subdomains of example.com
+func matchesHost(patterns []string, host string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if strings.HasPrefix(pattern, "*.") {
+			if strings.HasSuffix(host, pattern[1:]) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivateAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
@@ -0,0 +1,137 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the remote policy.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRemotePolicyCheck(t *testing.T) {
+
+	tests := []struct {
+		policy RemotePolicy
+		url    string
+		denied bool
+	}{
+		{RemotePolicy{}, "http://169.254.169.254/latest", false},
+		{RemotePolicy{AllowedSchemes: []string{"https"}}, "http://example.com/a.json", true},
+		{RemotePolicy{AllowedSchemes: []string{"https"}}, "HTTPS://example.com/a.json", false},
+		{RemotePolicy{AllowedSchemes: []string{"https"}}, "file:///schemas/a.json", true},
+		{RemotePolicy{AllowedHosts: []string{"example.com"}}, "file:///schemas/a.json", false},
+		{RemotePolicy{AllowedHosts: []string{"example.com"}}, "http://example.com:8080/a.json", false},
+		{RemotePolicy{AllowedHosts: []string{"example.com"}}, "http://schemas.example.com/a.json", true},
+		{RemotePolicy{AllowedHosts: []string{"*.example.com"}}, "http://schemas.example.com/a.json", false},
+		{RemotePolicy{AllowedHosts: []string{"*.example.com"}}, "http://badexample.com/a.json", true},
+		{RemotePolicy{AllowedHosts: []string{"*.example.com"}, DeniedHosts: []string{"internal.example.com"}}, "http://internal.example.com/a.json", true},
+		{RemotePolicy{DenyPrivateNetworks: true}, "http://169.254.169.254/latest", true},
+		{RemotePolicy{DenyPrivateNetworks: true}, "http://[::1]/a.json", true},
+		{RemotePolicy{DenyPrivateNetworks: true}, "http://10.0.0.1/a.json", true},
+		{RemotePolicy{DenyPrivateNetworks: true}, "http://93.184.216.34/a.json", false},
+	}
+
+	for _, test := range tests {
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = test.policy.check(u)
+		if errors.Is(err, ErrReferenceDenied) != test.denied {
+			t.Errorf("Expects %s to be denied : %t, given %v", test.url, test.denied, err)
+		}
+	}
+}
+
+func TestRemotePolicyLoad(t *testing.T) {
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirect.json":
+			// the same server, by another host name
+			http.Redirect(w, r, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)+"/schema.json", http.StatusFound)
+		case "/root.json":
+			w.Write([]byte(`{"$ref":"` + server.URL + `/redirect.json"}`))
+		default:
+			w.Write([]byte(`{"type":"string"}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		policy RemotePolicy
+		url    string
+		denied bool
+	}{
+		{RemotePolicy{AllowedHosts: []string{"127.0.0.1"}}, "/schema.json", false},
+		{RemotePolicy{AllowedHosts: []string{"127.0.0.1", "localhost"}}, "/root.json", false},
+		// the redirections are checked, the references too
+		{RemotePolicy{AllowedHosts: []string{"127.0.0.1"}}, "/redirect.json", true},
+		{RemotePolicy{AllowedHosts: []string{"127.0.0.1"}}, "/root.json", true},
+		{RemotePolicy{DenyPrivateNetworks: true}, "/schema.json", true},
+	}
+
+	for _, test := range tests {
+		_, err := NewJsonSchemaDocumentWithOptions(server.URL+test.url, SchemaOptions{RemotePolicy: test.policy})
+		if errors.Is(err, ErrReferenceDenied) != test.denied {
+			t.Errorf("Expects %s to be denied : %t, given %v", test.url, test.denied, err)
+		}
+	}
+
+	// the addresses of the host names are checked when connecting
+	_, err := NewJsonSchemaDocumentWithOptions(strings.Replace(server.URL, "127.0.0.1", "localhost", 1)+"/schema.json", SchemaOptions{RemotePolicy: RemotePolicy{DenyPrivateNetworks: true}})
+	if !errors.Is(err, ErrReferenceDenied) {
+		t.Errorf("Expects localhost to be denied, given %v", err)
+	}
+}
+
+// Wraps the default transport, as instrumentations do
+type wrappedTransport struct {
+	http.RoundTripper
+}
+
+func TestRemotePolicyWrappedDefaultTransport(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"string"}`))
+	}))
+	defer server.Close()
+
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = wrappedTransport{defaultTransport}
+	defer func() {
+		http.DefaultTransport = defaultTransport
+	}()
+
+	_, err := NewJsonSchemaDocumentWithOptions(server.URL+"/schema.json", SchemaOptions{RemotePolicy: RemotePolicy{AllowedHosts: []string{"127.0.0.1"}}})
+	if err != nil {
+		t.Errorf("Expects the schema to be loaded, given %s", err.Error())
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Pluggable loading of the documents of the references.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+)
+
+// Resolver loads the documents of a schema, itself and its references, from
+// any store : S3, a database, a schema registry service...
+// Its documents are checked by the RemotePolicy and Limits of the schema options
+type Resolver interface {
+	// Resolve returns the json text of the document at uri, an absolute url
+	// without fragment, or ErrUnresolved to let it be loaded from its url
+	Resolve(uri string) ([]byte, error)
+}
+
+// ResolverFunc is a function used as a Resolver
+type ResolverFunc func(uri string) ([]byte, error)
+
+func (f ResolverFunc) Resolve(uri string) ([]byte, error) {
+	return f(uri)
+}
+
+// ErrUnresolved is returned by a Resolver leaving a document to the default
+// loaders, the file and http ones
+var ErrUnresolved = errors.New("document not resolved")
@@ -0,0 +1,81 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the reference resolvers.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolver(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"integer","minimum":1}`))
+	}))
+	defer server.Close()
+
+	var resolved []string
+	store := map[string]string{
+		"s3://schemas/order.json":    `{"properties":{"customer":{"$ref":"customer.json"},"quantity":{"$ref":"` + server.URL + `/quantity.json"}}}`,
+		"s3://schemas/customer.json": `{"required":["name"]}`,
+	}
+	resolver := ResolverFunc(func(uri string) ([]byte, error) {
+		resolved = append(resolved, uri)
+		if text, ok := store[uri]; ok {
+			return []byte(text), nil
+		}
+		if uri == "s3://schemas/broken.json" {
+			return nil, errors.New("access denied")
+		}
+		return nil, ErrUnresolved
+	})
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions("s3://schemas/order.json", SchemaOptions{Resolver: resolver})
+	if err != nil {
+		t.Fatalf("Could not load the schema : %s", err.Error())
+	}
+	// the properties are parsed in any order
+	if len(resolved) != 3 || !isStringInSlice(resolved, "s3://schemas/customer.json") {
+		t.Errorf("Expects the documents to be resolved, given %v", resolved)
+	}
+	result := schemaDocument.Validate(mustParseJson(t, `{"customer":{},"quantity":0}`))
+	if len(result.GetErrors()) != 2 {
+		t.Errorf("Expects 2 errors, given %v", result.GetErrorMessages())
+	}
+
+	// the errors of the resolver are not left to the other loaders
+	if _, err := NewJsonSchemaDocumentWithOptions("s3://schemas/broken.json", SchemaOptions{Resolver: resolver}); err == nil || err.Error() != "access denied" {
+		t.Errorf("Expects the error of the resolver, given %v", err)
+	}
+
+	// the remote policy applies to the resolved documents
+	policy := RemotePolicy{AllowedSchemes: []string{"http"}}
+	if _, err := NewJsonSchemaDocumentWithOptions("s3://schemas/customer.json", SchemaOptions{Resolver: resolver, RemotePolicy: policy}); !errors.Is(err, ErrReferenceDenied) {
+		t.Errorf("Expects the document to be denied, given %v", err)
+	}
+}
@@ -29,7 +29,6 @@ package gojsonschema
 import (
 	"errors"
 	"github.com/sigu-399/gojsonreference"
-	"regexp"
 )
 
 type jsonSchema struct {
@@ -38,6 +37,24 @@ type jsonSchema struct {
 	id          *string
 	title       *string
 	description *string
+	// notes to the schema maintainers, they have no effect on the validation
+	comment *string
+	// the values of the schema are reported as warnings
+	deprecated bool
+	// OpenAPI 3.0, the values are not allowed in the requests / responses
+	readOnly  bool
+	writeOnly bool
+	// Kubernetes, an integer or a string / the fields it does not declare are
+	// kept / the fields it does not declare are pruned
+	intOrString           bool
+	preserveUnknownFields bool
+	prunesUnknownFields   bool
+
+	// default value, can legitimately be null hence the flag
+	defaultValue interface{}
+	hasDefault   bool
+
+	examples []interface{}
 
 	// Types associated with the
 	types jsonSchemaType
@@ -58,29 +75,46 @@ type jsonSchema struct {
 	itemsChildren               []*jsonSchema
 	itemsChildrenIsSingleSchema bool
 	propertiesChildren          []*jsonSchema
+	// propertiesChildren by property name
+	propertiesIndex map[string]*jsonSchema
+	// additionalProperties is false and there is no patternProperties, only
+	// the declared properties are allowed
+	closedProperties bool
 
 	property string
 
+	// json pointer of the schema in its document
+	pointer string
+
 	// validation : number / integer
 	multipleOf       *float64
 	maximum          *float64
 	exclusiveMaximum bool
 	minimum          *float64
 	exclusiveMinimum bool
+	// draft-06 exclusiveMaximum / exclusiveMinimum, bounds of their own
+	exclusiveMaximumValue *float64
+	exclusiveMinimumValue *float64
+	// the numeric keywords decoded as json.Number, by keyword, with their exact value
+	exactNumbers map[string]*exactNumber
 
 	// validation : string
 	minLength *int
 	maxLength *int
-	pattern   *regexp.Regexp
+	pattern   Regexp
+	format    *string
 
 	// validation : object
 	minProperties *int
 	maxProperties *int
 	required      []string
+	requiredSet   map[string]bool
 
 	dependencies         map[string]interface{}
 	additionalProperties interface{}
 	patternProperties    map[string]*jsonSchema
+	// compiled patternProperties keys
+	patternPropertiesRegexp map[string]Regexp
 
 	// validation : array
 	minItems    *int
@@ -91,12 +125,23 @@ type jsonSchema struct {
 
 	// validation : all
 	enum []string
+	// canonical json of the enum values, see marshalToCanonicalString
+	enumKeys map[string]bool
 
 	// validation : schema
 	oneOf []*jsonSchema
 	anyOf []*jsonSchema
 	allOf []*jsonSchema
 	not   *jsonSchema
+
+	// OpenAPI extension : picks the oneOf schema from a property value
+	discriminator *jsonSchemaDiscriminator
+}
+
+type jsonSchemaDiscriminator struct {
+	propertyName string
+	// discriminator value => index of the oneOf schema
+	mapping map[string]int
 }
 
 func (s *jsonSchema) AddEnum(i interface{}) error {
@@ -105,12 +150,20 @@ func (s *jsonSchema) AddEnum(i interface{}) error {
 	if err != nil {
 		return err
 	}
+	key, err := marshalToCanonicalString(i)
+	if err != nil {
+		return err
+	}
 
-	if isStringInSlice(s.enum, *is) {
+	if s.enumKeys[*key] {
 		return errors.New("enum items must be unique")
 	}
 
+	if s.enumKeys == nil {
+		s.enumKeys = make(map[string]bool)
+	}
 	s.enum = append(s.enum, *is)
+	s.enumKeys[*key] = true
 
 	return nil
 }
@@ -133,21 +186,25 @@ func (s *jsonSchema) SetNot(schema *jsonSchema) {
 
 func (s *jsonSchema) HasEnum(i interface{}) (bool, error) {
 
-	is, err := marshalToString(i)
+	key, err := marshalToCanonicalString(i)
 	if err != nil {
 		return false, err
 	}
 
-	return isStringInSlice(s.enum, *is), nil
+	return s.enumKeys[*key], nil
 }
 
 func (s *jsonSchema) AddRequired(value string) error {
 
-	if isStringInSlice(s.required, value) {
+	if s.requiredSet[value] {
 		return errors.New("required items must be unique")
 	}
 
 	s.required = append(s.required, value)
+	if s.requiredSet == nil {
+		s.requiredSet = make(map[string]bool)
+	}
+	s.requiredSet[value] = true
 
 	return nil
 }
@@ -162,14 +219,45 @@ func (s *jsonSchema) AddItemsChild(child *jsonSchema) {
 
 func (s *jsonSchema) AddPropertiesChild(child *jsonSchema) {
 	s.propertiesChildren = append(s.propertiesChildren, child)
+	if s.propertiesIndex == nil {
+		s.propertiesIndex = make(map[string]*jsonSchema)
+	}
+	s.propertiesIndex[child.property] = child
+}
+
+// Rebuilds the sets of the required and declared properties, once the property
+// names of the children are known
+func (s *jsonSchema) indexProperties() {
+	s.closedProperties = s.closesProperties()
+	s.propertiesIndex, s.requiredSet = nil, nil
+	for _, child := range s.propertiesChildren {
+		if s.propertiesIndex == nil {
+			s.propertiesIndex = make(map[string]*jsonSchema, len(s.propertiesChildren))
+		}
+		s.propertiesIndex[child.property] = child
+	}
+	for _, required := range s.required {
+		if s.requiredSet == nil {
+			s.requiredSet = make(map[string]bool, len(s.required))
+		}
+		s.requiredSet[required] = true
+	}
+}
+
+func (s *jsonSchema) closesProperties() bool {
+	return s.additionalProperties == false && len(s.patternProperties) == 0
 }
 
 func (s *jsonSchema) HasProperty(name string) bool {
+	_, ok := s.propertiesIndex[name]
+	return ok
+}
 
-	for _, v := range s.propertiesChildren {
-		if v.property == name {
-			return true
-		}
+// Json pointer of a sub-schema, ex: childPointer("properties", "a") => /properties/a
+func (s *jsonSchema) childPointer(tokens ...string) string {
+	pointer := s.pointer
+	for _, token := range tokens {
+		pointer += "/" + escapeJsonPointerToken(token)
 	}
-	return false
+	return pointer
 }
@@ -0,0 +1,374 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Fluent builder of schemas.
+//
+// created          16-10-2026
+
+// Package schema builds json schemas in go code
+//
+//	document, err := schema.Object().
+//		Prop("name", schema.String().MinLength(1)).
+//		Prop("age", schema.Integer().Minimum(0)).
+//		Required("name").
+//		Compile()
+package schema
+
+import (
+	"encoding/json"
+	"github.com/sigu-399/gojsonschema"
+)
+
+// Builder is a schema under construction, its methods modify it and return it for chaining
+type Builder struct {
+	keywords    map[string]interface{}
+	properties  []property
+	definitions []property
+}
+
+type property struct {
+	name   string
+	schema *Builder
+}
+
+func newBuilder(schemaType string) *Builder {
+	b := &Builder{keywords: make(map[string]interface{})}
+	if schemaType != "" {
+		b.keywords[gojsonschema.KEY_TYPE] = schemaType
+	}
+	return b
+}
+
+// Any returns a schema accepting any value
+func Any() *Builder {
+	return newBuilder("")
+}
+
+func Object() *Builder {
+	return newBuilder(gojsonschema.TYPE_OBJECT)
+}
+
+func String() *Builder {
+	return newBuilder(gojsonschema.TYPE_STRING)
+}
+
+func Integer() *Builder {
+	return newBuilder(gojsonschema.TYPE_INTEGER)
+}
+
+func Number() *Builder {
+	return newBuilder(gojsonschema.TYPE_NUMBER)
+}
+
+func Boolean() *Builder {
+	return newBuilder(gojsonschema.TYPE_BOOLEAN)
+}
+
+func Null() *Builder {
+	return newBuilder(gojsonschema.TYPE_NULL)
+}
+
+// Array returns an array schema whose items match the given schema
+func Array(items *Builder) *Builder {
+	return newBuilder(gojsonschema.TYPE_ARRAY).Items(items)
+}
+
+// Ref returns a schema referencing another document, the reference must be a full url
+func Ref(reference string) *Builder {
+	b := newBuilder("")
+	b.keywords[gojsonschema.KEY_REF] = reference
+	return b
+}
+
+func OneOf(schemas ...*Builder) *Builder {
+	return newBuilder("").set(gojsonschema.KEY_ONE_OF, schemas)
+}
+
+func AnyOf(schemas ...*Builder) *Builder {
+	return newBuilder("").set(gojsonschema.KEY_ANY_OF, schemas)
+}
+
+func AllOf(schemas ...*Builder) *Builder {
+	return newBuilder("").set(gojsonschema.KEY_ALL_OF, schemas)
+}
+
+func Not(schema *Builder) *Builder {
+	return newBuilder("").set(gojsonschema.KEY_NOT, schema)
+}
+
+func (b *Builder) set(keyword string, value interface{}) *Builder {
+	b.keywords[keyword] = value
+	return b
+}
+
+// meta
+
+func (b *Builder) Title(title string) *Builder {
+	return b.set(gojsonschema.KEY_TITLE, title)
+}
+
+func (b *Builder) Description(description string) *Builder {
+	return b.set(gojsonschema.KEY_DESCRIPTION, description)
+}
+
+func (b *Builder) Comment(comment string) *Builder {
+	return b.set(gojsonschema.KEY_COMMENT, comment)
+}
+
+func (b *Builder) Deprecated() *Builder {
+	return b.set(gojsonschema.KEY_DEPRECATED, true)
+}
+
+func (b *Builder) Default(value interface{}) *Builder {
+	return b.set(gojsonschema.KEY_DEFAULT, value)
+}
+
+// Nullable also accepts null in addition to the type and the enum of the schema
+func (b *Builder) Nullable() *Builder {
+	switch t := b.keywords[gojsonschema.KEY_TYPE].(type) {
+	case string:
+		if t != gojsonschema.TYPE_NULL {
+			b.keywords[gojsonschema.KEY_TYPE] = []string{t, gojsonschema.TYPE_NULL}
+		}
+	}
+	if enum, ok := b.keywords[gojsonschema.KEY_ENUM].([]interface{}); ok {
+		for _, value := range enum {
+			if value == nil {
+				return b
+			}
+		}
+		b.keywords[gojsonschema.KEY_ENUM] = append(enum, nil)
+	}
+	return b
+}
+
+func (b *Builder) allowsNull() bool {
+	t, _ := b.keywords[gojsonschema.KEY_TYPE].([]string)
+	for _, jsonType := range t {
+		if jsonType == gojsonschema.TYPE_NULL {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Builder) Enum(values ...interface{}) *Builder {
+	return b.set(gojsonschema.KEY_ENUM, values)
+}
+
+// Definition adds a schema to the definitions
+func (b *Builder) Definition(name string, schema *Builder) *Builder {
+	b.definitions = append(b.definitions, property{name: name, schema: schema})
+	return b
+}
+
+// number / integer
+
+func (b *Builder) MultipleOf(multipleOf float64) *Builder {
+	return b.set(gojsonschema.KEY_MULTIPLE_OF, multipleOf)
+}
+
+func (b *Builder) Minimum(minimum float64) *Builder {
+	delete(b.keywords, gojsonschema.KEY_EXCLUSIVE_MINIMUM)
+	return b.set(gojsonschema.KEY_MINIMUM, minimum)
+}
+
+func (b *Builder) ExclusiveMinimum(minimum float64) *Builder {
+	return b.set(gojsonschema.KEY_MINIMUM, minimum).set(gojsonschema.KEY_EXCLUSIVE_MINIMUM, true)
+}
+
+func (b *Builder) Maximum(maximum float64) *Builder {
+	delete(b.keywords, gojsonschema.KEY_EXCLUSIVE_MAXIMUM)
+	return b.set(gojsonschema.KEY_MAXIMUM, maximum)
+}
+
+func (b *Builder) ExclusiveMaximum(maximum float64) *Builder {
+	return b.set(gojsonschema.KEY_MAXIMUM, maximum).set(gojsonschema.KEY_EXCLUSIVE_MAXIMUM, true)
+}
+
+// string
+
+func (b *Builder) MinLength(minLength int) *Builder {
+	return b.set(gojsonschema.KEY_MIN_LENGTH, minLength)
+}
+
+func (b *Builder) MaxLength(maxLength int) *Builder {
+	return b.set(gojsonschema.KEY_MAX_LENGTH, maxLength)
+}
+
+func (b *Builder) Pattern(pattern string) *Builder {
+	return b.set(gojsonschema.KEY_PATTERN, pattern)
+}
+
+func (b *Builder) Format(format string) *Builder {
+	return b.set(gojsonschema.KEY_FORMAT, format)
+}
+
+// object
+
+// Prop adds a property, properties keep the order they are added in
+func (b *Builder) Prop(name string, schema *Builder) *Builder {
+	b.properties = append(b.properties, property{name: name, schema: schema})
+	return b
+}
+
+func (b *Builder) Required(names ...string) *Builder {
+	required, _ := b.keywords[gojsonschema.KEY_REQUIRED].([]string)
+	return b.set(gojsonschema.KEY_REQUIRED, append(required, names...))
+}
+
+func (b *Builder) PatternProp(pattern string, schema *Builder) *Builder {
+	patternProperties, ok := b.keywords[gojsonschema.KEY_PATTERN_PROPERTIES].(map[string]*Builder)
+	if !ok {
+		patternProperties = make(map[string]*Builder)
+	}
+	patternProperties[pattern] = schema
+	return b.set(gojsonschema.KEY_PATTERN_PROPERTIES, patternProperties)
+}
+
+func (b *Builder) AdditionalProperties(allowed bool) *Builder {
+	return b.set(gojsonschema.KEY_ADDITIONAL_PROPERTIES, allowed)
+}
+
+// AdditionalPropertiesSchema sets the schema additional properties must match
+func (b *Builder) AdditionalPropertiesSchema(schema *Builder) *Builder {
+	return b.set(gojsonschema.KEY_ADDITIONAL_PROPERTIES, schema)
+}
+
+func (b *Builder) MinProperties(minProperties int) *Builder {
+	return b.set(gojsonschema.KEY_MIN_PROPERTIES, minProperties)
+}
+
+func (b *Builder) MaxProperties(maxProperties int) *Builder {
+	return b.set(gojsonschema.KEY_MAX_PROPERTIES, maxProperties)
+}
+
+// array
+
+func (b *Builder) Items(schema *Builder) *Builder {
+	return b.set(gojsonschema.KEY_ITEMS, schema)
+}
+
+// TupleItems sets a schema for each item by position
+func (b *Builder) TupleItems(schemas ...*Builder) *Builder {
+	return b.set(gojsonschema.KEY_ITEMS, schemas)
+}
+
+func (b *Builder) AdditionalItems(allowed bool) *Builder {
+	return b.set(gojsonschema.KEY_ADDITIONAL_ITEMS, allowed)
+}
+
+func (b *Builder) MinItems(minItems int) *Builder {
+	return b.set(gojsonschema.KEY_MIN_ITEMS, minItems)
+}
+
+func (b *Builder) MaxItems(maxItems int) *Builder {
+	return b.set(gojsonschema.KEY_MAX_ITEMS, maxItems)
+}
+
+func (b *Builder) UniqueItems() *Builder {
+	return b.set(gojsonschema.KEY_UNIQUE_ITEMS, true)
+}
+
+// Map returns the schema as a json document
+func (b *Builder) Map() map[string]interface{} {
+	return toJson(b).(map[string]interface{})
+}
+
+func (b *Builder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Map())
+}
+
+// Compile parses the schema into a schema document
+func (b *Builder) Compile() (*gojsonschema.JsonSchemaDocument, error) {
+	return gojsonschema.NewJsonSchemaDocument(b.Map())
+}
+
+// MustCompile is like Compile but panics if the schema cannot be parsed,
+// it simplifies the initialization of global variables holding schemas
+func (b *Builder) MustCompile() *gojsonschema.JsonSchemaDocument {
+	document, err := b.Compile()
+	if err != nil {
+		panic(err)
+	}
+	return document
+}
+
+// toJson converts builders and go values to the json values the schema parser expects
+func toJson(value interface{}) interface{} {
+
+	switch value := value.(type) {
+
+	case *Builder:
+		m := make(map[string]interface{}, len(value.keywords)+2)
+		for k, v := range value.keywords {
+			m[k] = toJson(v)
+		}
+		if len(value.properties) > 0 {
+			m[gojsonschema.KEY_PROPERTIES] = propertiesToJson(value.properties)
+		}
+		if len(value.definitions) > 0 {
+			m[gojsonschema.KEY_DEFINITIONS] = propertiesToJson(value.definitions)
+		}
+		return m
+
+	case []*Builder:
+		list := make([]interface{}, len(value))
+		for i, v := range value {
+			list[i] = toJson(v)
+		}
+		return list
+
+	case map[string]*Builder:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = toJson(v)
+		}
+		return m
+
+	case []string:
+		list := make([]interface{}, len(value))
+		for i, v := range value {
+			list[i] = v
+		}
+		return list
+
+	case []interface{}:
+		list := make([]interface{}, len(value))
+		for i, v := range value {
+			list[i] = toJson(v)
+		}
+		return list
+
+	case int:
+		return float64(value)
+	}
+
+	return value
+}
+
+func propertiesToJson(properties []property) map[string]interface{} {
+	m := make(map[string]interface{}, len(properties))
+	for _, p := range properties {
+		m[p.name] = toJson(p.schema)
+	}
+	return m
+}
@@ -0,0 +1,87 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema builder.
+//
+// created          16-10-2026
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+
+	builder := Object().
+		Title("Person").
+		Prop("name", String().MinLength(1).Pattern("^[A-Z]")).
+		Prop("age", Integer().Minimum(0).ExclusiveMaximum(150).Nullable()).
+		Prop("tags", Array(String().Enum("a", "b")).UniqueItems().MaxItems(2)).
+		Required("name").
+		AdditionalProperties(false)
+
+	marshalled, err := json.Marshal(builder)
+	if err != nil {
+		t.Fatalf("Could not marshal schema : %s", err.Error())
+	}
+	expected := `{"additionalProperties":false,"properties":{"age":{"exclusiveMaximum":true,"maximum":150,"minimum":0,"type":["integer","null"]},"name":{"minLength":1,"pattern":"^[A-Z]","type":"string"},"tags":{"items":{"enum":["a","b"],"type":"string"},"maxItems":2,"type":"array","uniqueItems":true}},"required":["name"],"title":"Person","type":"object"}`
+	if string(marshalled) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(marshalled))
+	}
+
+	schemaDocument, err := builder.Compile()
+	if err != nil {
+		t.Fatalf("Could not compile schema : %s", err.Error())
+	}
+
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`{"name":"Gopher","age":null,"tags":["a"]}`, true},
+		{`{"name":"gopher"}`, false},
+		{`{"name":"Gopher","age":150}`, false},
+		{`{"name":"Gopher","tags":["a","a"]}`, false},
+		{`{"name":"Gopher","other":1}`, false},
+		{`{}`, false},
+	}
+
+	for _, test := range tests {
+		var document interface{}
+		json.Unmarshal([]byte(test.document), &document)
+		if result := schemaDocument.Validate(document); result.IsValid() != test.valid {
+			t.Errorf("Expects %s valid to be %t, given %v", test.document, test.valid, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestBuilderMustCompile(t *testing.T) {
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expects an invalid schema to panic")
+		}
+	}()
+
+	String().Pattern("(").MustCompile()
+}
@@ -0,0 +1,479 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generates schemas from go types.
+//
+// created          16-10-2026
+
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TAG_NAME = "jsonschema"
+
+	TAG_REQUIRED = "required"
+	TAG_OPTIONAL = "optional"
+	TAG_NULLABLE = "nullable"
+	TAG_ENUM     = "enum"
+	TAG_DEFAULT  = "default"
+
+	// enum values are separated by pipes : enum=red|green|blue
+	TAG_ENUM_SEPARATOR = "|"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Reflect returns the schema of the json produced by encoding/json for the type of value
+//
+// Properties are named after their json tag, fields without omitempty are required
+// Constraints are given in a jsonschema tag as a comma separated list, values cannot contain commas :
+//
+//	Name  string   `json:"name" jsonschema:"minLength=1,maxLength=64,pattern=^[A-Z]"`
+//	Color string   `json:"color,omitempty" jsonschema:"enum=red|green|blue,default=red"`
+//	Tags  []string `json:"tags" jsonschema:"optional,uniqueItems,maxItems=8"`
+//	Email *string  `json:"email" jsonschema:"format=email,title=Email,description=Contact email"`
+//
+// Pointers, and slices and maps without omitempty, are nullable, fields with
+// the ,string option are strings, recursive types are not supported
+func Reflect(value interface{}) (*Builder, error) {
+	r := reflector{visiting: make(map[reflect.Type]bool)}
+	return r.reflectType(reflect.TypeOf(value))
+}
+
+type reflector struct {
+	// struct types being reflected, to detect recursive types
+	visiting map[reflect.Type]bool
+}
+
+func (r *reflector) reflectType(t reflect.Type) (*Builder, error) {
+
+	if t == nil {
+		return Any(), nil
+	}
+
+	switch {
+	case t == timeType:
+		return String().Format("date-time"), nil
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		return Any(), nil
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return String(), nil
+	}
+
+	switch t.Kind() {
+
+	case reflect.Ptr:
+		elem, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return elem.Nullable(), nil
+
+	case reflect.Interface:
+		return Any(), nil
+
+	case reflect.Bool:
+		return Boolean(), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Integer(), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Integer().Minimum(0), nil
+
+	case reflect.Float32, reflect.Float64:
+		return Number(), nil
+
+	case reflect.String:
+		return String(), nil
+
+	case reflect.Slice, reflect.Array:
+		// encoding/json writes byte slices as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return String(), nil
+		}
+		items, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if isNilableKind(t.Elem().Kind()) {
+			items.Nullable()
+		}
+		array := Array(items)
+		if t.Kind() == reflect.Array {
+			array.MinItems(t.Len()).MaxItems(t.Len())
+		}
+		return array, nil
+
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String && !t.Key().Implements(textMarshalerType) && !isIntegerKind(t.Key().Kind()) {
+			return nil, errors.New(fmt.Sprintf("map key %s cannot be converted to json", t.Key()))
+		}
+		values, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if isNilableKind(t.Elem().Kind()) {
+			values.Nullable()
+		}
+		return Object().AdditionalPropertiesSchema(values), nil
+
+	case reflect.Struct:
+		if r.visiting[t] {
+			return nil, errors.New(fmt.Sprintf("recursive type %s is not supported", t))
+		}
+		r.visiting[t] = true
+		defer delete(r.visiting, t)
+
+		object := Object()
+		if err := r.reflectFields(t, object); err != nil {
+			return nil, err
+		}
+		return object, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("%s cannot be converted to json", t))
+}
+
+// reflectFields adds the exported fields of a struct as properties
+// Fields of embedded structs are promoted as encoding/json does : the least
+// nested field of a name wins, then the one with a json tag, names with
+// several such fields are dropped
+func (r *reflector) reflectFields(t reflect.Type, object *Builder) error {
+
+	fields := make(map[string][]reflectedField)
+	var names []string
+	collectFields(t, 0, false, map[reflect.Type]bool{}, func(field reflectedField) {
+		if _, exists := fields[field.name]; !exists {
+			names = append(names, field.name)
+		}
+		fields[field.name] = append(fields[field.name], field)
+	})
+
+	// less nested names first, in the order of their fields
+	sort.SliceStable(names, func(i, j int) bool {
+		return fields[names[i]][0].depth < fields[names[j]][0].depth
+	})
+
+	for _, name := range names {
+		field, ok := dominantField(fields[name])
+		if !ok {
+			continue
+		}
+
+		var fieldType reflect.Type
+		var property *Builder
+		var err error
+		if field.quoted {
+			// the ,string option writes the value as a json string
+			fieldType = reflect.TypeOf("")
+			property = String()
+			if field.Type.Kind() == reflect.Ptr {
+				property.Nullable()
+			}
+		} else {
+			fieldType = field.Type
+			property, err = r.reflectType(field.Type)
+		}
+		if err != nil {
+			return errors.New(fmt.Sprintf("%s.%s : %s", t.Name(), field.Name, err.Error()))
+		}
+		// encoding/json writes nil slices and maps as null
+		if !field.omitEmpty && isNilableKind(field.Type.Kind()) {
+			property.Nullable()
+		}
+
+		// the fields of a nil embedded pointer are not written
+		required := !field.omitEmpty && !field.viaPointer
+		if schemaTag, ok := field.Tag.Lookup(TAG_NAME); ok {
+			required, err = applyTag(property, schemaTag, required, fieldType)
+			if err != nil {
+				return errors.New(fmt.Sprintf("%s.%s : %s", t.Name(), field.Name, err.Error()))
+			}
+		}
+
+		object.Prop(name, property)
+		if required {
+			object.Required(name)
+		}
+	}
+
+	return nil
+}
+
+// reflectedField is a field of a struct or of its embedded structs, as encoding/json sees it
+type reflectedField struct {
+	reflect.StructField
+	name       string
+	depth      int
+	tagged     bool
+	omitEmpty  bool
+	quoted     bool
+	viaPointer bool
+}
+
+// collectFields calls add for the json fields of a struct and, recursively, of its embedded structs
+func collectFields(t reflect.Type, depth int, viaPointer bool, visited map[reflect.Type]bool, add func(reflectedField)) {
+
+	if visited[t] {
+		return
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	for i := 0; i != t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, tagOptions, _ := strings.Cut(tag, ",")
+		options := strings.Split(tagOptions, ",")
+
+		if field.Anonymous && tagName == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				// encoding/json ignores the embedded pointers to unexported structs
+				if !field.IsExported() {
+					continue
+				}
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				collectFields(fieldType, depth+1, viaPointer || field.Type.Kind() == reflect.Ptr, visited, add)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tagName != "" {
+			name = tagName
+		}
+
+		add(reflectedField{
+			StructField: field,
+			name:        name,
+			depth:       depth,
+			tagged:      tagName != "",
+			omitEmpty:   isStringInSlice(options, "omitempty"),
+			quoted:      isStringInSlice(options, "string") && isQuotableKind(field.Type),
+			viaPointer:  viaPointer,
+		})
+	}
+}
+
+// dominantField returns the field encoding/json writes among the fields of a
+// name, ok is false when none of them is
+func dominantField(fields []reflectedField) (dominant reflectedField, ok bool) {
+	depth := fields[0].depth
+	var candidates []reflectedField
+	for _, field := range fields {
+		if field.depth < depth {
+			depth, candidates = field.depth, nil
+		}
+		if field.depth == depth {
+			candidates = append(candidates, field)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+	var tagged []reflectedField
+	for _, field := range candidates {
+		if field.tagged {
+			tagged = append(tagged, field)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return reflectedField{}, false
+}
+
+// isQuotableKind tells if the ,string option applies to a field of the type
+func isQuotableKind(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return isIntegerKind(t.Kind())
+}
+
+// isNilableKind tells if the values of the kind are written as null when nil,
+// pointers are handled by reflectType
+func isNilableKind(kind reflect.Kind) bool {
+	return kind == reflect.Slice || kind == reflect.Map
+}
+
+// applyTag adds the constraints of a jsonschema tag to the schema of a field,
+// returns whether the field is required
+func applyTag(b *Builder, tag string, required bool, fieldType reflect.Type) (bool, error) {
+
+	// once the enum is known, null is added to it
+	nullable := b.allowsNull()
+	defer func() {
+		if nullable {
+			b.Nullable()
+		}
+	}()
+
+	for _, option := range strings.Split(tag, ",") {
+		key, value, hasValue := strings.Cut(strings.TrimSpace(option), "=")
+
+		switch key {
+		case "":
+			continue
+		case TAG_REQUIRED:
+			required = true
+			continue
+		case TAG_OPTIONAL:
+			required = false
+			continue
+		case TAG_NULLABLE:
+			nullable = true
+			continue
+		case gojsonschema.KEY_UNIQUE_ITEMS:
+			b.UniqueItems()
+			continue
+		}
+
+		if !hasValue {
+			return required, errors.New(fmt.Sprintf("tag option %s must have a value", key))
+		}
+
+		var err error
+		switch key {
+		case gojsonschema.KEY_TITLE:
+			b.Title(value)
+		case gojsonschema.KEY_DESCRIPTION:
+			b.Description(value)
+		case gojsonschema.KEY_FORMAT:
+			b.Format(value)
+		case gojsonschema.KEY_PATTERN:
+			b.Pattern(value)
+		case TAG_ENUM:
+			var values []interface{}
+			for _, enumValue := range strings.Split(value, TAG_ENUM_SEPARATOR) {
+				v, parseErr := parseTagValue(enumValue, fieldType)
+				if parseErr != nil {
+					err = parseErr
+				}
+				values = append(values, v)
+			}
+			b.Enum(values...)
+		case TAG_DEFAULT:
+			var v interface{}
+			v, err = parseTagValue(value, fieldType)
+			b.Default(v)
+		case gojsonschema.KEY_MULTIPLE_OF, gojsonschema.KEY_MINIMUM, gojsonschema.KEY_MAXIMUM,
+			gojsonschema.KEY_EXCLUSIVE_MINIMUM, gojsonschema.KEY_EXCLUSIVE_MAXIMUM:
+			var f float64
+			f, err = strconv.ParseFloat(value, 64)
+			switch key {
+			case gojsonschema.KEY_MULTIPLE_OF:
+				b.MultipleOf(f)
+			case gojsonschema.KEY_MINIMUM:
+				b.Minimum(f)
+			case gojsonschema.KEY_MAXIMUM:
+				b.Maximum(f)
+			case gojsonschema.KEY_EXCLUSIVE_MINIMUM:
+				b.ExclusiveMinimum(f)
+			case gojsonschema.KEY_EXCLUSIVE_MAXIMUM:
+				b.ExclusiveMaximum(f)
+			}
+		case gojsonschema.KEY_MIN_LENGTH, gojsonschema.KEY_MAX_LENGTH, gojsonschema.KEY_MIN_ITEMS, gojsonschema.KEY_MAX_ITEMS,
+			gojsonschema.KEY_MIN_PROPERTIES, gojsonschema.KEY_MAX_PROPERTIES:
+			var i int
+			i, err = strconv.Atoi(value)
+			b.set(key, i)
+		default:
+			return required, errors.New(fmt.Sprintf("unknown tag option %s", key))
+		}
+
+		if err != nil {
+			return required, errors.New(fmt.Sprintf("tag option %s : %s", key, err.Error()))
+		}
+	}
+
+	return required, nil
+}
+
+// parseTagValue converts an enum or default value of a tag to the json type of the field
+func parseTagValue(value string, fieldType reflect.Type) (interface{}, error) {
+
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	switch {
+	case fieldType.Kind() == reflect.Bool:
+		return strconv.ParseBool(value)
+	case isIntegerKind(fieldType.Kind()):
+		i, err := strconv.ParseInt(value, 10, 64)
+		return float64(i), err
+	case fieldType.Kind() == reflect.Float32 || fieldType.Kind() == reflect.Float64:
+		return strconv.ParseFloat(value, 64)
+	}
+
+	return value, nil
+}
+
+func isIntegerKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isStringInSlice(s []string, what string) bool {
+	for i := range s {
+		if s[i] == what {
+			return true
+		}
+	}
+	return false
+}
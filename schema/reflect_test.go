@@ -0,0 +1,193 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema generation from go types.
+//
+// created          16-10-2026
+
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+type reflectAddress struct {
+	City string `json:"city" jsonschema:"minLength=1"`
+}
+
+type reflectBase struct {
+	Id      uint      `json:"id"`
+	Created time.Time `json:"created"`
+}
+
+type reflectPerson struct {
+	reflectBase
+	Name     string            `json:"name" jsonschema:"minLength=1,maxLength=64,pattern=^[A-Z]"`
+	Color    string            `json:"color,omitempty" jsonschema:"enum=red|green,default=red"`
+	Age      *int              `json:"age" jsonschema:"maximum=150"`
+	Tags     []string          `json:"tags" jsonschema:"optional,uniqueItems"`
+	Address  reflectAddress    `json:"address"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+func TestReflect(t *testing.T) {
+
+	builder, err := Reflect(reflectPerson{})
+	if err != nil {
+		t.Fatalf("Could not reflect type : %s", err.Error())
+	}
+
+	marshalled, _ := json.Marshal(builder)
+	expected := `{"properties":{` +
+		`"address":{"properties":{"city":{"minLength":1,"type":"string"}},"required":["city"],"type":"object"},` +
+		`"age":{"maximum":150,"type":["integer","null"]},` +
+		`"color":{"default":"red","enum":["red","green"],"type":"string"},` +
+		`"created":{"format":"date-time","type":"string"},` +
+		`"id":{"minimum":0,"type":"integer"},` +
+		`"labels":{"additionalProperties":{"type":"string"},"type":"object"},` +
+		`"name":{"maxLength":64,"minLength":1,"pattern":"^[A-Z]","type":"string"},` +
+		`"tags":{"items":{"type":"string"},"type":["array","null"],"uniqueItems":true}},` +
+		`"required":["name","age","address","id","created"],"type":"object"}`
+	if string(marshalled) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(marshalled))
+	}
+
+	schemaDocument, err := builder.Compile()
+	if err != nil {
+		t.Fatalf("Could not compile schema : %s", err.Error())
+	}
+
+	// the json of a go value matches the schema of its type
+	age := 42
+	person, _ := json.Marshal(reflectPerson{Name: "Gopher", Age: &age, Address: reflectAddress{City: "Paris"}, Tags: []string{"a"}})
+	var document interface{}
+	json.Unmarshal(person, &document)
+	if result := schemaDocument.Validate(document); !result.IsValid() {
+		t.Errorf("Expects %s to be valid, given %v", string(person), result.GetErrorMessages())
+	}
+}
+
+type reflectNamed struct {
+	Title string
+	Kind  string
+	Label string `json:"label"`
+}
+
+type reflectLabelled struct {
+	Title string `json:"Title"`
+	Kind  string
+	Name  string `json:"name"`
+}
+
+type ReflectZoned struct {
+	Zone string `json:"zone"`
+}
+
+type reflectFields struct {
+	reflectNamed
+	reflectLabelled
+	*ReflectZoned
+	*reflectAddress
+	Count  int               `json:"count,string"`
+	Ratio  *float64          `json:"ratio,string" jsonschema:"optional"`
+	Matrix [][]int           `json:"matrix"`
+	Index  map[string][]int  `json:"index"`
+	Level  *string           `json:"level" jsonschema:"enum=low|high"`
+	Mode   string            `json:"mode" jsonschema:"nullable,enum=a|b"`
+	Unused map[string]string `json:"unused,omitempty"`
+}
+
+func TestReflectFields(t *testing.T) {
+
+	builder, err := Reflect(reflectFields{})
+	if err != nil {
+		t.Fatalf("Could not reflect type : %s", err.Error())
+	}
+
+	// the tagged Title wins over the untagged one, Kind is ambiguous, zone can
+	// be in a nil embedded pointer, the embedded pointer to an unexported
+	// struct is ignored
+	marshalled, _ := json.Marshal(builder)
+	expected := `{"properties":{` +
+		`"Title":{"type":"string"},` +
+		`"count":{"type":"string"},` +
+		`"index":{"additionalProperties":{"items":{"type":"integer"},"type":["array","null"]},"type":["object","null"]},` +
+		`"label":{"type":"string"},` +
+		`"level":{"enum":["low","high",null],"type":["string","null"]},` +
+		`"matrix":{"items":{"items":{"type":"integer"},"type":["array","null"]},"type":["array","null"]},` +
+		`"mode":{"enum":["a","b",null],"type":["string","null"]},` +
+		`"name":{"type":"string"},` +
+		`"ratio":{"type":["string","null"]},` +
+		`"unused":{"additionalProperties":{"type":"string"},"type":"object"},` +
+		`"zone":{"type":"string"}},` +
+		`"required":["count","matrix","index","level","mode","Title","label","name"],"type":"object"}`
+	if string(marshalled) != expected {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", expected, string(marshalled))
+	}
+
+	schemaDocument, err := builder.Compile()
+	if err != nil {
+		t.Fatalf("Could not compile schema : %s", err.Error())
+	}
+	for _, value := range []reflectFields{{Mode: "a"}, {Matrix: [][]int{nil}, Index: map[string][]int{"a": nil}, Count: 3, Mode: "b", ReflectZoned: &ReflectZoned{}}} {
+		marshalledValue, _ := json.Marshal(value)
+		var document interface{}
+		json.Unmarshal(marshalledValue, &document)
+		if result := schemaDocument.Validate(document); !result.IsValid() {
+			t.Errorf("Expects %s to be valid, given %v", string(marshalledValue), result.GetErrorMessages())
+		}
+	}
+}
+
+type reflectNode struct {
+	Children []reflectNode `json:"children"`
+}
+
+func TestReflectErrors(t *testing.T) {
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{reflectNode{}, "recursive type"},
+		{struct {
+			F func()
+		}{}, "cannot be converted to json"},
+		{struct {
+			A int `jsonschema:"minimum=low"`
+		}{}, "tag option minimum"},
+		{struct {
+			A int `jsonschema:"unknown=1"`
+		}{}, "unknown tag option unknown"},
+	}
+
+	for _, test := range tests {
+		_, err := Reflect(test.value)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expects an error containing %q, given %v", test.expected, err)
+		}
+	}
+}
@@ -0,0 +1,505 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Binary export and import of compiled schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonreference"
+	"strings"
+)
+
+// version of the binary format, precompiled schemas of another version must be compiled again
+// It is incremented whenever the encoded fields change
+const BINARY_SCHEMA_VERSION = 2
+
+// binarySchemaDocument is the compiled schema tree flattened in a table,
+// schemas reference each other by their index in the table, -1 for none
+type binarySchemaDocument struct {
+	Version           int
+	DocumentReference string
+	Root              int
+	Formats           []string
+	EcmaRegex         bool
+	StrictFormats     bool
+	CacheDirectory    string
+	Schemas           []binarySchema
+}
+
+type binarySchema struct {
+	// optional values are slices of one or no element, gob drops the pointers to zero values
+	Id, Title, Description, Comment []string
+	Deprecated, ReadOnly, WriteOnly bool
+
+	IntOrString, PreserveUnknownFields, PrunesUnknownFields bool
+	// json of the default value and of the examples
+	Default     []string
+	Examples    []string
+	Types       []string
+	Ref         []string
+	RefSchema   int
+	Schema      []string
+	Definitions map[string]int
+	Parent      int
+
+	DefinitionsChildren         []int
+	ItemsChildren               []int
+	ItemsChildrenIsSingleSchema bool
+	PropertiesChildren          []int
+	Property                    string
+	Pointer                     string
+
+	MultipleOf, Maximum, Minimum       []float64
+	ExclusiveMaximum, ExclusiveMinimum bool
+
+	ExclusiveMaximumValue, ExclusiveMinimumValue []float64
+	// literals of the numeric keywords decoded as json.Number, by keyword
+	ExactNumbers map[string]string
+
+	MinLength, MaxLength []int
+	// source of the compiled regular expressions
+	Pattern []string
+	Format  []string
+
+	MinProperties, MaxProperties []int
+	Required                     []string
+
+	DependencyProperties       map[string][]string
+	DependencySchemas          map[string]int
+	AdditionalPropertiesBool   []bool
+	AdditionalPropertiesSchema int
+	PatternProperties          map[string]int
+	PatternPropertiesRegexp    map[string]string
+
+	MinItems, MaxItems    []int
+	UniqueItems           bool
+	AdditionalItemsBool   []bool
+	AdditionalItemsSchema int
+
+	Enum []string
+
+	OneOf, AnyOf, AllOf []int
+	Not                 int
+
+	DiscriminatorPropertyName []string
+	DiscriminatorMapping      map[string]int
+}
+
+// MarshalBinary exports the compiled schema, UnmarshalBinary loads it without parsing the schema again
+// The format checkers and the validation options ( SetUseDefaults... ) are not exported,
+// a schema compiled with a RegexEngine cannot be exported
+func (d *JsonSchemaDocument) MarshalBinary() ([]byte, error) {
+
+	if d.schemaOptions.RegexEngine != nil {
+		return nil, errors.New("a schema compiled with a regex engine cannot be precompiled")
+	}
+
+	indexes := make(map[*jsonSchema]int)
+	var schemas []*jsonSchema
+	var index func(schema *jsonSchema) int
+	index = func(schema *jsonSchema) int {
+		if schema == nil {
+			return -1
+		}
+		if i, ok := indexes[schema]; ok {
+			return i
+		}
+		indexes[schema] = len(schemas)
+		schemas = append(schemas, schema)
+		return len(schemas) - 1
+	}
+	indexAll := func(list []*jsonSchema) []int {
+		var is []int
+		for _, schema := range list {
+			is = append(is, index(schema))
+		}
+		return is
+	}
+	indexMap := func(m map[string]*jsonSchema) map[string]int {
+		if m == nil {
+			return nil
+		}
+		im := make(map[string]int, len(m))
+		for k, schema := range m {
+			im[k] = index(schema)
+		}
+		return im
+	}
+
+	document := binarySchemaDocument{
+		Version:           BINARY_SCHEMA_VERSION,
+		DocumentReference: d.documentReference.String(),
+		Root:              index(d.rootSchema),
+		Formats:           d.formats,
+		EcmaRegex:         d.schemaOptions.EcmaRegex,
+		StrictFormats:     d.schemaOptions.StrictFormats,
+		CacheDirectory:    d.schemaOptions.CacheDirectory,
+	}
+
+	// schemas are appended to the table while it is walked
+	for i := 0; i != len(schemas); i++ {
+		s := schemas[i]
+		b := binarySchema{
+			Id: optional(s.id), Title: optional(s.title), Description: optional(s.description), Comment: optional(s.comment), Deprecated: s.deprecated,
+			ReadOnly: s.readOnly, WriteOnly: s.writeOnly,
+			IntOrString: s.intOrString, PreserveUnknownFields: s.preserveUnknownFields, PrunesUnknownFields: s.prunesUnknownFields,
+			Types:     s.types.types,
+			RefSchema: index(s.refSchema),
+			Parent:    index(s.parent),
+
+			Definitions:                 indexMap(s.definitions),
+			DefinitionsChildren:         indexAll(s.definitionsChildren),
+			ItemsChildren:               indexAll(s.itemsChildren),
+			ItemsChildrenIsSingleSchema: s.itemsChildrenIsSingleSchema,
+			PropertiesChildren:          indexAll(s.propertiesChildren),
+			Property:                    s.property,
+			Pointer:                     s.pointer,
+
+			MultipleOf: optional(s.multipleOf), Maximum: optional(s.maximum), Minimum: optional(s.minimum),
+			ExclusiveMaximum: s.exclusiveMaximum, ExclusiveMinimum: s.exclusiveMinimum,
+			ExclusiveMaximumValue: optional(s.exclusiveMaximumValue), ExclusiveMinimumValue: optional(s.exclusiveMinimumValue), ExactNumbers: exactNumberLiterals(s.exactNumbers),
+			MinLength: optional(s.minLength), MaxLength: optional(s.maxLength),
+			Format:        optional(s.format),
+			MinProperties: optional(s.minProperties), MaxProperties: optional(s.maxProperties),
+			Required:                   s.required,
+			AdditionalPropertiesSchema: -1,
+			PatternProperties:          indexMap(s.patternProperties),
+			MinItems:                   optional(s.minItems), MaxItems: optional(s.maxItems),
+			UniqueItems:           s.uniqueItems,
+			AdditionalItemsSchema: -1,
+			Enum:                  s.enum,
+			OneOf:                 indexAll(s.oneOf), AnyOf: indexAll(s.anyOf), AllOf: indexAll(s.allOf),
+			Not: index(s.not),
+		}
+
+		if s.hasDefault {
+			defaultJson, err := marshalToString(s.defaultValue)
+			if err != nil {
+				return nil, err
+			}
+			b.Default = optional(defaultJson)
+		}
+		if s.examples != nil {
+			examplesJson, err := marshalToString(s.examples)
+			if err != nil {
+				return nil, err
+			}
+			b.Examples = optional(examplesJson)
+		}
+		if s.ref != nil {
+			ref := s.ref.String()
+			b.Ref = []string{ref}
+		}
+		if s.schema != nil {
+			schema := s.schema.String()
+			b.Schema = []string{schema}
+		}
+		if s.pattern != nil {
+			pattern := s.pattern.String()
+			b.Pattern = []string{pattern}
+		}
+		if s.patternPropertiesRegexp != nil {
+			b.PatternPropertiesRegexp = make(map[string]string, len(s.patternPropertiesRegexp))
+			for k, re := range s.patternPropertiesRegexp {
+				b.PatternPropertiesRegexp[k] = re.String()
+			}
+		}
+		for k, dependency := range s.dependencies {
+			switch dependency := dependency.(type) {
+			case []string:
+				if b.DependencyProperties == nil {
+					b.DependencyProperties = make(map[string][]string)
+				}
+				b.DependencyProperties[k] = dependency
+			case *jsonSchema:
+				if b.DependencySchemas == nil {
+					b.DependencySchemas = make(map[string]int)
+				}
+				b.DependencySchemas[k] = index(dependency)
+			}
+		}
+		switch additionalProperties := s.additionalProperties.(type) {
+		case bool:
+			b.AdditionalPropertiesBool = []bool{additionalProperties}
+		case *jsonSchema:
+			b.AdditionalPropertiesSchema = index(additionalProperties)
+		}
+		switch additionalItems := s.additionalItems.(type) {
+		case bool:
+			b.AdditionalItemsBool = []bool{additionalItems}
+		case *jsonSchema:
+			b.AdditionalItemsSchema = index(additionalItems)
+		}
+		if s.discriminator != nil {
+			b.DiscriminatorPropertyName = []string{s.discriminator.propertyName}
+			b.DiscriminatorMapping = s.discriminator.mapping
+		}
+
+		document.Schemas = append(document.Schemas, b)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(document); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary loads a schema exported by MarshalBinary
+func (d *JsonSchemaDocument) UnmarshalBinary(data []byte) error {
+
+	var document binarySchemaDocument
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&document); err != nil {
+		return err
+	}
+	if document.Version != BINARY_SCHEMA_VERSION {
+		return errors.New(fmt.Sprintf("precompiled schema version %d is not supported", document.Version))
+	}
+
+	schemas := make([]*jsonSchema, len(document.Schemas))
+	for i := range schemas {
+		schemas[i] = &jsonSchema{}
+	}
+	schema := func(i int) (*jsonSchema, error) {
+		if i < 0 {
+			return nil, nil
+		}
+		if i >= len(schemas) {
+			return nil, errors.New(fmt.Sprintf("precompiled schema references the unknown schema %d", i))
+		}
+		return schemas[i], nil
+	}
+
+	// the patterns are exported as written in the schemas
+	compile := func(pattern string) (Regexp, error) {
+		return compileSchemaRegexp(pattern, document.EcmaRegex)
+	}
+
+	for i, b := range document.Schemas {
+		if err := b.unmarshal(schemas[i], schema, compile); err != nil {
+			return err
+		}
+	}
+	for _, s := range schemas {
+		s.indexProperties()
+	}
+
+	root, err := schema(document.Root)
+	if err != nil || root == nil {
+		return errors.New("precompiled schema has no root schema")
+	}
+	documentReference, err := gojsonreference.NewJsonReference(document.DocumentReference)
+	if err != nil {
+		return err
+	}
+
+	*d = JsonSchemaDocument{
+		documentReference: documentReference,
+		rootSchema:        root,
+		pool:              newSchemaPool(),
+		referencePool:     newSchemaReferencePool(),
+		schemaOptions:     SchemaOptions{EcmaRegex: document.EcmaRegex, StrictFormats: document.StrictFormats, CacheDirectory: document.CacheDirectory},
+		formats:           document.Formats,
+	}
+	d.pool.cacheDirectory = document.CacheDirectory
+
+	return nil
+}
+
+// unmarshal copies the binary schema into s, schema returns the schema of an index
+// and compile compiles its patterns
+func (b *binarySchema) unmarshal(s *jsonSchema, schema func(i int) (*jsonSchema, error), compile func(pattern string) (Regexp, error)) error {
+
+	var err error
+	var errs []error
+	index := func(i int) *jsonSchema {
+		indexed, indexErr := schema(i)
+		if indexErr != nil {
+			errs = append(errs, indexErr)
+		}
+		return indexed
+	}
+	indexAll := func(is []int) []*jsonSchema {
+		var list []*jsonSchema
+		for _, i := range is {
+			list = append(list, index(i))
+		}
+		return list
+	}
+	indexMap := func(im map[string]int) map[string]*jsonSchema {
+		if im == nil {
+			return nil
+		}
+		m := make(map[string]*jsonSchema, len(im))
+		for k, i := range im {
+			m[k] = index(i)
+		}
+		return m
+	}
+
+	s.id, s.title, s.description, s.comment = present(b.Id), present(b.Title), present(b.Description), present(b.Comment)
+	s.deprecated, s.readOnly, s.writeOnly = b.Deprecated, b.ReadOnly, b.WriteOnly
+	s.intOrString, s.preserveUnknownFields, s.prunesUnknownFields = b.IntOrString, b.PreserveUnknownFields, b.PrunesUnknownFields
+	s.types.types = b.Types
+	s.refSchema = index(b.RefSchema)
+	s.parent = index(b.Parent)
+	s.definitions = indexMap(b.Definitions)
+	s.definitionsChildren = indexAll(b.DefinitionsChildren)
+	s.itemsChildren = indexAll(b.ItemsChildren)
+	s.itemsChildrenIsSingleSchema = b.ItemsChildrenIsSingleSchema
+	s.propertiesChildren = indexAll(b.PropertiesChildren)
+	s.property = b.Property
+	s.pointer = b.Pointer
+	s.multipleOf, s.maximum, s.minimum = present(b.MultipleOf), present(b.Maximum), present(b.Minimum)
+	s.exclusiveMaximum, s.exclusiveMinimum = b.ExclusiveMaximum, b.ExclusiveMinimum
+	s.exclusiveMaximumValue, s.exclusiveMinimumValue = present(b.ExclusiveMaximumValue), present(b.ExclusiveMinimumValue)
+	for keyword, literal := range b.ExactNumbers {
+		exact, ok := newExactNumber(literal)
+		if !ok {
+			return errors.New(fmt.Sprintf("precompiled schema has an invalid %s %s", keyword, literal))
+		}
+		s.setExactNumber(keyword, exact)
+	}
+	s.minLength, s.maxLength = present(b.MinLength), present(b.MaxLength)
+	s.format = present(b.Format)
+	s.minProperties, s.maxProperties = present(b.MinProperties), present(b.MaxProperties)
+	s.required = b.Required
+	s.patternProperties = indexMap(b.PatternProperties)
+	s.minItems, s.maxItems = present(b.MinItems), present(b.MaxItems)
+	s.uniqueItems = b.UniqueItems
+	for _, value := range b.Enum {
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+		var enumValue interface{}
+		if err = decoder.Decode(&enumValue); err != nil {
+			return err
+		}
+		if err = s.AddEnum(enumValue); err != nil {
+			return err
+		}
+	}
+	s.oneOf, s.anyOf, s.allOf = indexAll(b.OneOf), indexAll(b.AnyOf), indexAll(b.AllOf)
+	s.not = index(b.Not)
+
+	if len(b.Default) > 0 {
+		s.hasDefault = true
+		if err = json.Unmarshal([]byte(b.Default[0]), &s.defaultValue); err != nil {
+			return err
+		}
+	}
+	if len(b.Examples) > 0 {
+		if err = json.Unmarshal([]byte(b.Examples[0]), &s.examples); err != nil {
+			return err
+		}
+	}
+	if len(b.Ref) > 0 {
+		if s.ref, err = newJsonReferencePointer(b.Ref[0]); err != nil {
+			return err
+		}
+	}
+	if len(b.Schema) > 0 {
+		if s.schema, err = newJsonReferencePointer(b.Schema[0]); err != nil {
+			return err
+		}
+	}
+	if len(b.Pattern) > 0 {
+		if s.pattern, err = compile(b.Pattern[0]); err != nil {
+			return err
+		}
+	}
+	if b.PatternPropertiesRegexp != nil {
+		s.patternPropertiesRegexp = make(map[string]Regexp, len(b.PatternPropertiesRegexp))
+		for k, pattern := range b.PatternPropertiesRegexp {
+			if s.patternPropertiesRegexp[k], err = compile(pattern); err != nil {
+				return err
+			}
+		}
+	}
+	if b.DependencyProperties != nil || b.DependencySchemas != nil {
+		s.dependencies = make(map[string]interface{})
+		for k, properties := range b.DependencyProperties {
+			s.dependencies[k] = properties
+		}
+		for k, i := range b.DependencySchemas {
+			s.dependencies[k] = index(i)
+		}
+	}
+	if len(b.AdditionalPropertiesBool) > 0 {
+		s.additionalProperties = b.AdditionalPropertiesBool[0]
+	} else if b.AdditionalPropertiesSchema >= 0 {
+		s.additionalProperties = index(b.AdditionalPropertiesSchema)
+	}
+	if len(b.AdditionalItemsBool) > 0 {
+		s.additionalItems = b.AdditionalItemsBool[0]
+	} else if b.AdditionalItemsSchema >= 0 {
+		s.additionalItems = index(b.AdditionalItemsSchema)
+	}
+	if len(b.DiscriminatorPropertyName) > 0 {
+		s.discriminator = &jsonSchemaDiscriminator{propertyName: b.DiscriminatorPropertyName[0], mapping: b.DiscriminatorMapping}
+	}
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func newJsonReferencePointer(reference string) (*gojsonreference.JsonReference, error) {
+	jsonReference, err := gojsonreference.NewJsonReference(reference)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonReference, nil
+}
+
+func optional[T any](value *T) []T {
+	if value == nil {
+		return nil
+	}
+	return []T{*value}
+}
+
+func present[T any](values []T) *T {
+	if len(values) == 0 {
+		return nil
+	}
+	value := values[0]
+	return &value
+}
+
+func exactNumberLiterals(exactNumbers map[string]*exactNumber) map[string]string {
+	if exactNumbers == nil {
+		return nil
+	}
+	literals := make(map[string]string, len(exactNumbers))
+	for keyword, exact := range exactNumbers {
+		literals[keyword] = exact.literal
+	}
+	return literals
+}
@@ -0,0 +1,109 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the binary export and import of compiled schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+
+	var schema map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"definitions":{"node":{"type":"object","properties":{"name":{"type":"string","pattern":"^[a-z]+$","default":"leaf","examples":["a","b"]},"children":{"type":"array","items":{"$ref":"#/definitions/node"}}},"required":["name"],"additionalProperties":false}},
+		"type":"object",
+		"properties":{
+			"root":{"$ref":"#/definitions/node"},
+			"size":{"type":"integer","minimum":0,"exclusiveMinimum":true,"multipleOf":2},
+			"kind":{"enum":["a","b"]},
+			"tags":{"items":[{"format":"email"}],"additionalItems":false,"uniqueItems":true}
+		},
+		"patternProperties":{"^x-":{"type":"string"}},
+		"dependencies":{"size":["kind"],"kind":{"required":["root"]}},
+		"anyOf":[{"required":["root"]},{"not":{"required":["size"]}}]
+	}`), &schema)
+
+	schemaDocument, err := NewJsonSchemaDocument(schema)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	data, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Could not export schema : %s", err.Error())
+	}
+
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Could not import schema : %s", err.Error())
+	}
+
+	documents := []string{
+		`{"root":{"name":"a","children":[{"name":"b"}]}}`,
+		`{"root":{"name":"a","children":[{"name":"B"}]}}`,
+		`{"root":{"name":"a","other":1}}`,
+		`{"root":{"name":"a"},"size":4,"kind":"a"}`,
+		`{"root":{"name":"a"},"size":3}`,
+		`{"size":0,"kind":"c"}`,
+		`{"kind":"a"}`,
+		`{"tags":["a@b.c"],"x-a":"b"}`,
+		`{"tags":["a",1],"x-a":1}`,
+	}
+	for _, document := range documents {
+		var value interface{}
+		json.Unmarshal([]byte(document), &value)
+
+		expected := schemaDocument.Validate(value).GetErrorMessages()
+		given := loaded.Validate(value).GetErrorMessages()
+		if !reflect.DeepEqual(expected, given) {
+			t.Errorf("Document %s, expects errors %v, given %v", document, expected, given)
+		}
+	}
+
+	// the exported schema is the same once loaded
+	expectedJson, _ := json.Marshal(schemaDocument)
+	givenJson, _ := json.Marshal(loaded)
+	if string(expectedJson) != string(givenJson) {
+		t.Errorf("Expects schema :\n%s\ngiven :\n%s", expectedJson, givenJson)
+	}
+
+	if err := loaded.UnmarshalBinary(data[:len(data)/2]); err == nil {
+		t.Errorf("Expects an error on truncated data")
+	}
+
+	// a schema exported with another layout is compiled again
+	var previous bytes.Buffer
+	if err := gob.NewEncoder(&previous).Encode(binarySchemaDocument{Version: BINARY_SCHEMA_VERSION - 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.UnmarshalBinary(previous.Bytes()); err == nil {
+		t.Errorf("Expects an error on a previous version")
+	}
+}
@@ -0,0 +1,440 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Compares two schemas and reports the breaking changes.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SchemaChange is a difference between two versions of a schema
+// A change is breaking when some documents valid against the old schema are
+// not valid against the new one
+type SchemaChange struct {
+	// json pointer of the changed schema, ex: /properties/a/items
+	Path        string
+	Keyword     string
+	Description string
+	Breaking    bool
+}
+
+func (c SchemaChange) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	path := c.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s %s : %s", kind, path, c.Description)
+}
+
+// DiffSchemas compares an old and a new version of a schema, and returns their
+// differences sorted by path
+func DiffSchemas(oldSchema *JsonSchemaDocument, newSchema *JsonSchemaDocument) []SchemaChange {
+	d := &schemaDiff{visited: make(map[[2]*jsonSchema]bool)}
+	d.diff("", oldSchema.rootSchema, newSchema.rootSchema)
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		if d.changes[i].Path != d.changes[j].Path {
+			return d.changes[i].Path < d.changes[j].Path
+		}
+		return d.changes[i].Keyword < d.changes[j].Keyword
+	})
+	return d.changes
+}
+
+type schemaDiff struct {
+	changes []SchemaChange
+	// pairs of schemas already compared, for recursive schemas
+	visited map[[2]*jsonSchema]bool
+}
+
+func (d *schemaDiff) add(path string, keyword string, breaking bool, description string) {
+	d.changes = append(d.changes, SchemaChange{Path: path, Keyword: keyword, Description: description, Breaking: breaking})
+}
+
+func (d *schemaDiff) diff(path string, o *jsonSchema, n *jsonSchema) {
+
+	for o.refSchema != nil {
+		o = o.refSchema
+	}
+	for n.refSchema != nil {
+		n = n.refSchema
+	}
+	if d.visited[[2]*jsonSchema{o, n}] {
+		return
+	}
+	d.visited[[2]*jsonSchema{o, n}] = true
+
+	d.diffTypes(path, o, n)
+
+	d.diffBound(path, KEY_MINIMUM, o.minimum, o.exclusiveMinimum, n.minimum, n.exclusiveMinimum, 1)
+	d.diffBound(path, KEY_MAXIMUM, o.maximum, o.exclusiveMaximum, n.maximum, n.exclusiveMaximum, -1)
+	d.diffBound(path, KEY_EXCLUSIVE_MINIMUM, o.exclusiveMinimumValue, false, n.exclusiveMinimumValue, false, 1)
+	d.diffBound(path, KEY_EXCLUSIVE_MAXIMUM, o.exclusiveMaximumValue, false, n.exclusiveMaximumValue, false, -1)
+	d.diffBound(path, KEY_MIN_LENGTH, intToFloat(o.minLength), false, intToFloat(n.minLength), false, 1)
+	d.diffBound(path, KEY_MAX_LENGTH, intToFloat(o.maxLength), false, intToFloat(n.maxLength), false, -1)
+	d.diffBound(path, KEY_MIN_ITEMS, intToFloat(o.minItems), false, intToFloat(n.minItems), false, 1)
+	d.diffBound(path, KEY_MAX_ITEMS, intToFloat(o.maxItems), false, intToFloat(n.maxItems), false, -1)
+	d.diffBound(path, KEY_MIN_PROPERTIES, intToFloat(o.minProperties), false, intToFloat(n.minProperties), false, 1)
+	d.diffBound(path, KEY_MAX_PROPERTIES, intToFloat(o.maxProperties), false, intToFloat(n.maxProperties), false, -1)
+
+	switch {
+	case o.multipleOf == nil && n.multipleOf != nil:
+		d.add(path, KEY_MULTIPLE_OF, true, fmt.Sprintf("multipleOf %v added", *n.multipleOf))
+	case o.multipleOf != nil && n.multipleOf == nil:
+		d.add(path, KEY_MULTIPLE_OF, false, fmt.Sprintf("multipleOf %v removed", *o.multipleOf))
+	case o.multipleOf != nil && *o.multipleOf != *n.multipleOf:
+		ratio := *o.multipleOf / *n.multipleOf
+		d.add(path, KEY_MULTIPLE_OF, ratio != math.Trunc(ratio), fmt.Sprintf("multipleOf changed from %v to %v", *o.multipleOf, *n.multipleOf))
+	}
+
+	oldPattern, newPattern := "", ""
+	if o.pattern != nil {
+		oldPattern = o.pattern.String()
+	}
+	if n.pattern != nil {
+		newPattern = n.pattern.String()
+	}
+	d.diffString(path, KEY_PATTERN, oldPattern, newPattern)
+
+	oldFormat, newFormat := "", ""
+	if o.format != nil {
+		oldFormat = *o.format
+	}
+	if n.format != nil {
+		newFormat = *n.format
+	}
+	d.diffString(path, KEY_FORMAT, oldFormat, newFormat)
+
+	d.diffEnum(path, o, n)
+
+	for _, required := range n.required {
+		if !isStringInSlice(o.required, required) {
+			d.add(path, KEY_REQUIRED, true, fmt.Sprintf("%s is now required", required))
+		}
+	}
+	for _, required := range o.required {
+		if !isStringInSlice(n.required, required) {
+			d.add(path, KEY_REQUIRED, false, fmt.Sprintf("%s is no longer required", required))
+		}
+	}
+
+	if o.uniqueItems != n.uniqueItems {
+		d.add(path, KEY_UNIQUE_ITEMS, n.uniqueItems, fmt.Sprintf("uniqueItems changed to %t", n.uniqueItems))
+	}
+
+	d.diffProperties(path, o, n)
+	d.diffItems(path, o, n)
+
+	d.diffSchemas(path, KEY_ALL_OF, o.allOf, n.allOf)
+	d.diffSchemas(path, KEY_ANY_OF, o.anyOf, n.anyOf)
+	d.diffSchemas(path, KEY_ONE_OF, o.oneOf, n.oneOf)
+	switch {
+	case o.not == nil && n.not != nil:
+		d.add(path, KEY_NOT, true, "not added")
+	case o.not != nil && n.not == nil:
+		d.add(path, KEY_NOT, false, "not removed")
+	case o.not != nil:
+		// the documents rejected by not are the ones its schema accepts
+		notDiff := &schemaDiff{visited: d.visited}
+		notDiff.diff(path+"/"+KEY_NOT, o.not, n.not)
+		for _, change := range notDiff.changes {
+			change.Breaking = !change.Breaking
+			d.changes = append(d.changes, change)
+		}
+	}
+}
+
+func intToFloat(i *int) *float64 {
+	if i == nil {
+		return nil
+	}
+	f := float64(*i)
+	return &f
+}
+
+// diffBound compares two bounds, direction is 1 for lower bounds and -1 for upper bounds
+func (d *schemaDiff) diffBound(path string, keyword string, o *float64, oExclusive bool, n *float64, nExclusive bool, direction float64) {
+
+	switch {
+	case o == nil && n == nil:
+	case o == nil:
+		d.add(path, keyword, true, fmt.Sprintf("%s %v added", keyword, *n))
+	case n == nil:
+		d.add(path, keyword, false, fmt.Sprintf("%s %v removed", keyword, *o))
+	case *o != *n:
+		d.add(path, keyword, *n*direction > *o*direction, fmt.Sprintf("%s changed from %v to %v", keyword, *o, *n))
+	case oExclusive != nExclusive:
+		d.add(path, keyword, nExclusive, fmt.Sprintf("%s %v is now exclusive : %t", keyword, *n, nExclusive))
+	}
+}
+
+func (d *schemaDiff) diffString(path string, keyword string, o string, n string) {
+	switch {
+	case o == n:
+	case o == "":
+		d.add(path, keyword, true, fmt.Sprintf("%s %s added", keyword, n))
+	case n == "":
+		d.add(path, keyword, false, fmt.Sprintf("%s %s removed", keyword, o))
+	default:
+		d.add(path, keyword, true, fmt.Sprintf("%s changed from %s to %s", keyword, o, n))
+	}
+}
+
+func (d *schemaDiff) diffTypes(path string, o *jsonSchema, n *jsonSchema) {
+
+	allows := func(s *jsonSchema, jsonType string) bool {
+		return !s.types.HasTypeInSchema() || s.types.HasType(jsonType) || (jsonType == TYPE_INTEGER && s.types.HasType(TYPE_NUMBER))
+	}
+
+	for _, jsonType := range JSON_TYPES {
+		oldAllows, newAllows := allows(o, jsonType), allows(n, jsonType)
+		if oldAllows && !newAllows {
+			d.add(path, KEY_TYPE, true, fmt.Sprintf("type %s no longer allowed", jsonType))
+		} else if !oldAllows && newAllows && !(jsonType == TYPE_INTEGER && allows(o, TYPE_NUMBER)) {
+			d.add(path, KEY_TYPE, false, fmt.Sprintf("type %s now allowed", jsonType))
+		}
+	}
+}
+
+func (d *schemaDiff) diffEnum(path string, o *jsonSchema, n *jsonSchema) {
+
+	switch {
+	case len(o.enum) == 0 && len(n.enum) == 0:
+	case len(o.enum) == 0:
+		d.add(path, KEY_ENUM, true, fmt.Sprintf("enum [%s] added", strings.Join(n.enum, ",")))
+	case len(n.enum) == 0:
+		d.add(path, KEY_ENUM, false, "enum removed")
+	default:
+		for _, value := range o.enum {
+			if !isStringInSlice(n.enum, value) {
+				d.add(path, KEY_ENUM, true, fmt.Sprintf("enum value %s removed", value))
+			}
+		}
+		for _, value := range n.enum {
+			if !isStringInSlice(o.enum, value) {
+				d.add(path, KEY_ENUM, false, fmt.Sprintf("enum value %s added", value))
+			}
+		}
+	}
+}
+
+func (d *schemaDiff) diffProperties(path string, o *jsonSchema, n *jsonSchema) {
+
+	oldProperties := make(map[string]*jsonSchema)
+	for _, property := range o.propertiesChildren {
+		oldProperties[property.property] = property
+	}
+	newProperties := make(map[string]*jsonSchema)
+	for _, property := range n.propertiesChildren {
+		newProperties[property.property] = property
+	}
+
+	for name, newProperty := range newProperties {
+		propertyPath := path + "/" + KEY_PROPERTIES + "/" + escapeJsonPointerToken(name)
+		if oldProperty, ok := oldProperties[name]; ok {
+			d.diff(propertyPath, oldProperty, newProperty)
+		} else {
+			// the property was an additional or pattern property, its schema is
+			// breaking when it is stricter than the one that allowed it
+			d.add(propertyPath, KEY_PROPERTIES, stricter(undeclaredPropertySchema(o, name), newProperty), fmt.Sprintf("property %s added", name))
+		}
+	}
+	for name := range oldProperties {
+		if _, ok := newProperties[name]; !ok {
+			// without its schema the property becomes an additional or pattern property
+			breaking := n.additionalProperties != nil && n.additionalProperties != true || len(matchingPatternSchemas(n, name)) > 0
+			d.add(path+"/"+KEY_PROPERTIES+"/"+escapeJsonPointerToken(name), KEY_PROPERTIES, breaking, fmt.Sprintf("property %s removed", name))
+		}
+	}
+
+	for pattern, newPatternSchema := range n.patternProperties {
+		patternPath := path + "/" + KEY_PATTERN_PROPERTIES + "/" + escapeJsonPointerToken(pattern)
+		if oldPatternSchema, ok := o.patternProperties[pattern]; ok {
+			d.diff(patternPath, oldPatternSchema, newPatternSchema)
+		} else {
+			d.add(patternPath, KEY_PATTERN_PROPERTIES, true, fmt.Sprintf("pattern property %s added", pattern))
+		}
+	}
+	for pattern := range o.patternProperties {
+		if _, ok := n.patternProperties[pattern]; !ok {
+			d.add(path+"/"+KEY_PATTERN_PROPERTIES+"/"+escapeJsonPointerToken(pattern), KEY_PATTERN_PROPERTIES, false, fmt.Sprintf("pattern property %s removed", pattern))
+		}
+	}
+
+	d.diffAdditional(path, KEY_ADDITIONAL_PROPERTIES, "additional properties", o.additionalProperties, n.additionalProperties)
+	d.diffDependencies(path, o, n)
+}
+
+// diffAdditional compares additionalProperties or additionalItems, a boolean or a schema
+func (d *schemaDiff) diffAdditional(path string, keyword string, name string, o interface{}, n interface{}) {
+
+	oldAdditional, oldIsSchema := o.(*jsonSchema)
+	newAdditional, newIsSchema := n.(*jsonSchema)
+	switch {
+	case oldIsSchema && newIsSchema:
+		d.diff(path+"/"+keyword, oldAdditional, newAdditional)
+	case o != false && n == false:
+		d.add(path, keyword, true, name+" no longer allowed")
+	case o == false && n != false:
+		d.add(path, keyword, false, name+" now allowed")
+	case !oldIsSchema && newIsSchema:
+		d.add(path, keyword, true, name+" schema added")
+	case oldIsSchema && !newIsSchema:
+		d.add(path, keyword, false, name+" schema removed")
+	}
+}
+
+func (d *schemaDiff) diffDependencies(path string, o *jsonSchema, n *jsonSchema) {
+
+	for name, newDependency := range n.dependencies {
+		dependencyPath := path + "/" + KEY_DEPENDENCIES + "/" + escapeJsonPointerToken(name)
+		oldDependency, ok := o.dependencies[name]
+		if !ok {
+			d.add(dependencyPath, KEY_DEPENDENCIES, true, fmt.Sprintf("dependency %s added", name))
+			continue
+		}
+		oldSchema, oldIsSchema := oldDependency.(*jsonSchema)
+		newSchema, newIsSchema := newDependency.(*jsonSchema)
+		switch {
+		case oldIsSchema && newIsSchema:
+			d.diff(dependencyPath, oldSchema, newSchema)
+		case oldIsSchema != newIsSchema:
+			d.add(dependencyPath, KEY_DEPENDENCIES, true, fmt.Sprintf("dependency %s changed", name))
+		default:
+			for _, property := range newDependency.([]string) {
+				if !isStringInSlice(oldDependency.([]string), property) {
+					d.add(dependencyPath, KEY_DEPENDENCIES, true, fmt.Sprintf("%s now depends on %s", name, property))
+				}
+			}
+			for _, property := range oldDependency.([]string) {
+				if !isStringInSlice(newDependency.([]string), property) {
+					d.add(dependencyPath, KEY_DEPENDENCIES, false, fmt.Sprintf("%s no longer depends on %s", name, property))
+				}
+			}
+		}
+	}
+	for name := range o.dependencies {
+		if _, ok := n.dependencies[name]; !ok {
+			d.add(path+"/"+KEY_DEPENDENCIES+"/"+escapeJsonPointerToken(name), KEY_DEPENDENCIES, false, fmt.Sprintf("dependency %s removed", name))
+		}
+	}
+}
+
+// stricter tells if some documents valid against the old schema are not valid
+// against the new one, without reporting their differences, nil allows nothing
+func stricter(o *jsonSchema, n *jsonSchema) bool {
+	if o == nil {
+		return false
+	}
+	subDiff := &schemaDiff{visited: make(map[[2]*jsonSchema]bool)}
+	subDiff.diff("", o, n)
+	for _, change := range subDiff.changes {
+		if change.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// undeclaredPropertySchema returns the schema a property that is not declared
+// is validated against, an empty schema when any value is allowed and nil when
+// the property is not allowed
+func undeclaredPropertySchema(s *jsonSchema, name string) *jsonSchema {
+	if patternSchemas := matchingPatternSchemas(s, name); len(patternSchemas) > 0 {
+		return patternSchemas[0]
+	}
+	switch additionalProperties := s.additionalProperties.(type) {
+	case *jsonSchema:
+		return additionalProperties
+	case bool:
+		if !additionalProperties {
+			return nil
+		}
+	}
+	return &jsonSchema{}
+}
+
+// matchingPatternSchemas returns the patternProperties schemas applying to a property name
+func matchingPatternSchemas(s *jsonSchema, name string) []*jsonSchema {
+	var schemas []*jsonSchema
+	for pattern, patternSchema := range s.patternProperties {
+		if regexp, ok := s.patternPropertiesRegexp[pattern]; ok {
+			if matches, err := regexp.MatchString(name); err == nil && matches {
+				schemas = append(schemas, patternSchema)
+			}
+		}
+	}
+	return schemas
+}
+
+func (d *schemaDiff) diffItems(path string, o *jsonSchema, n *jsonSchema) {
+
+	switch {
+	case len(o.itemsChildren) == 0 && len(n.itemsChildren) == 0:
+	case len(o.itemsChildren) == 0:
+		d.add(path, KEY_ITEMS, true, "items added")
+	case len(n.itemsChildren) == 0:
+		d.add(path, KEY_ITEMS, false, "items removed")
+	case o.itemsChildrenIsSingleSchema && n.itemsChildrenIsSingleSchema:
+		d.diff(path+"/"+KEY_ITEMS, o.itemsChildren[0], n.itemsChildren[0])
+	case !o.itemsChildrenIsSingleSchema && !n.itemsChildrenIsSingleSchema && len(o.itemsChildren) == len(n.itemsChildren):
+		for i := range o.itemsChildren {
+			d.diff(path+"/"+KEY_ITEMS+"/"+strconv.Itoa(i), o.itemsChildren[i], n.itemsChildren[i])
+		}
+	default:
+		d.add(path, KEY_ITEMS, true, "items changed")
+	}
+
+	d.diffAdditional(path, KEY_ADDITIONAL_ITEMS, "additional items", o.additionalItems, n.additionalItems)
+}
+
+// diffSchemas compares the sub-schemas of allOf, anyOf and oneOf one by one
+func (d *schemaDiff) diffSchemas(path string, keyword string, o []*jsonSchema, n []*jsonSchema) {
+
+	if len(o) != len(n) {
+		d.add(path, keyword, true, fmt.Sprintf("%s changed from %d to %d schemas", keyword, len(o), len(n)))
+		return
+	}
+	for i := range o {
+		d.diff(path+"/"+keyword+"/"+strconv.Itoa(i), o[i], n[i])
+	}
+}
+
+// escapeJsonPointerToken escapes ~ and / in a json pointer token
+func escapeJsonPointerToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
+
+// unescapeJsonPointerToken reverts escapeJsonPointerToken
+func unescapeJsonPointerToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
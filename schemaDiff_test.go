@@ -0,0 +1,151 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema diff.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffSchemas(t *testing.T) {
+
+	oldSchema := mustNewSchemaDocument(t, `{
+		"type":"object",
+		"required":["id","name"],
+		"properties":{
+			"id":{"type":"integer","minimum":0},
+			"name":{"type":"string","maxLength":10},
+			"kind":{"enum":["a","b"]},
+			"tags":{"items":{"type":"string"}},
+			"legacy":{}
+		}
+	}`)
+	newSchema := mustNewSchemaDocument(t, `{
+		"type":"object",
+		"required":["id","email"],
+		"additionalProperties":false,
+		"properties":{
+			"id":{"type":"number","minimum":1,"exclusiveMinimum":true},
+			"name":{"type":["string","null"],"maxLength":20,"pattern":"^[a-z]+$"},
+			"kind":{"enum":["a","c"]},
+			"tags":{"items":{"type":"string"},"uniqueItems":true},
+			"email":{"type":"string","format":"email"}
+		}
+	}`)
+
+	expected := []string{
+		`breaking / : additional properties no longer allowed`,
+		`breaking / : email is now required`,
+		`compatible / : name is no longer required`,
+		`breaking /properties/email : property email added`,
+		`breaking /properties/id : minimum changed from 0 to 1`,
+		`compatible /properties/id : type number now allowed`,
+		`breaking /properties/kind : enum value "b" removed`,
+		`compatible /properties/kind : enum value "c" added`,
+		`breaking /properties/legacy : property legacy removed`,
+		`compatible /properties/name : maxLength changed from 10 to 20`,
+		`breaking /properties/name : pattern ^[a-z]+$ added`,
+		`compatible /properties/name : type null now allowed`,
+		`breaking /properties/tags : uniqueItems changed to true`,
+	}
+
+	changes := DiffSchemas(oldSchema, newSchema)
+	if len(changes) != len(expected) {
+		for _, change := range changes {
+			t.Log(change.String())
+		}
+		t.Fatalf("Expects %d changes, given %d", len(expected), len(changes))
+	}
+	for i := range expected {
+		if changes[i].String() != expected[i] {
+			t.Errorf("Expects %q, given %q", expected[i], changes[i].String())
+		}
+	}
+
+	if changes := DiffSchemas(oldSchema, oldSchema); len(changes) != 0 {
+		t.Errorf("Expects no change between a schema and itself, given %v", changes)
+	}
+}
+
+func TestDiffUndeclaredProperties(t *testing.T) {
+
+	tests := []struct {
+		oldSchema string
+		newSchema string
+		expected  []string
+	}{
+		{
+			`{"additionalProperties":false}`,
+			`{"additionalProperties":false,"properties":{"a":{"type":"string"}}}`,
+			[]string{`compatible /properties/a : property a added`},
+		},
+		{
+			`{"additionalProperties":{"type":"string"}}`,
+			`{"additionalProperties":{"type":"string"},"properties":{"a":{"type":"string"}}}`,
+			[]string{`compatible /properties/a : property a added`},
+		},
+		{
+			`{"patternProperties":{"^a":{"type":"string"}}}`,
+			`{"patternProperties":{"^a":{"type":"string"}},"properties":{"a":{"type":"string","minLength":1}}}`,
+			[]string{`breaking /properties/a : property a added`},
+		},
+		{
+			`{"properties":{"a":{}},"additionalProperties":{"type":"string"}}`,
+			`{"additionalProperties":{"type":"string"}}`,
+			[]string{`breaking /properties/a : property a removed`},
+		},
+		{
+			`{"patternProperties":{"^a":{"type":"string"},"^b":{}}}`,
+			`{"patternProperties":{"^a":{"type":"integer"},"^c":{}}}`,
+			[]string{
+				`compatible /patternProperties/^a : type integer now allowed`,
+				`breaking /patternProperties/^a : type string no longer allowed`,
+				`compatible /patternProperties/^b : pattern property ^b removed`,
+				`breaking /patternProperties/^c : pattern property ^c added`,
+			},
+		},
+		{
+			`{"items":[{}],"dependencies":{"a":["b"],"c":{"required":["d"]}}}`,
+			`{"items":[{}],"additionalItems":false,"dependencies":{"a":["b","e"],"c":{"required":["d"]},"f":["g"]}}`,
+			[]string{
+				`breaking / : additional items no longer allowed`,
+				`breaking /dependencies/a : a now depends on e`,
+				`breaking /dependencies/f : dependency f added`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		changes := DiffSchemas(mustNewSchemaDocument(t, test.oldSchema), mustNewSchemaDocument(t, test.newSchema))
+		var given []string
+		for _, change := range changes {
+			given = append(given, change.String())
+		}
+		if strings.Join(given, "\n") != strings.Join(test.expected, "\n") {
+			t.Errorf("Expects %v for %s to %s, given %v", test.expected, test.oldSchema, test.newSchema, given)
+		}
+	}
+}
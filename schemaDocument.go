@@ -27,20 +27,92 @@
 package gojsonschema
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/sigu-399/gojsonreference"
+	"io/fs"
+	"log/slog"
 	"reflect"
-	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// SchemaOptions change how a schema document is parsed
+type SchemaOptions struct {
+	// Translate the patterns from ECMA 262, the regular expressions of JSON schema,
+	// to Go regular expressions ( unicode escapes, \s, [^]... )
+	EcmaRegex bool
+
+	// Language of the schemas, DIALECT_OPENAPI_3_0 or DIALECT_SWAGGER_2_0 for
+	// the schema objects of OpenAPI 3.0 or Swagger 2.0
+	Dialect Dialect
+
+	// Compiles the patterns instead of Go's regexp package, EcmaRegex does not
+	// translate the patterns it is given, see RegexEngine
+	RegexEngine RegexEngine
+
+	// Make an unknown format name ( a typo such as "emial" ) a schema error
+	StrictFormats bool
+
+	// Make the examples and default values a schema does not validate schema errors
+	CheckExamples bool
+
+	// Format checkers of this document, they shadow the ones registered with AddFormatChecker
+	FormatCheckers map[string]FormatChecker
+
+	// Directory where the remote schemas are cached across processes, they are
+	// revalidated with their ETag / Last-Modified once their max-age has expired
+	CacheDirectory string
+
+	// Limits of the json documents loaded from files and urls, those exceeding
+	// them are refused before being decoded ( only MaxBytes applies to yaml )
+	Limits Limits
+
+	// Urls the schema document and its references may be loaded from
+	RemotePolicy RemotePolicy
+
+	// File system the file urls are read from, such as an embed.FS :
+	// file:///schemas/a.json is its file schemas/a.json
+	FileSystem fs.FS
+
+	// Loads the documents of the schema and of its references instead of the
+	// file and http loaders, see Resolver
+	Resolver Resolver
+
+	// Timeouts, retries and concurrency of the loading of the remote documents
+	Fetch FetchOptions
+
+	// Receives the events of the library : remote schemas fetched, schema
+	// compiled, and the failed validations unless SetLogger changes it
+	Logger *slog.Logger
+}
+
 func NewJsonSchemaDocument(document interface{}) (*JsonSchemaDocument, error) {
+	return NewJsonSchemaDocumentWithOptions(document, SchemaOptions{})
+}
+
+// NewJsonSchemaDocumentFS compiles the schema of the file name of fsys, its
+// relative references are read from fsys too
+//
+//	//go:embed schemas
+//	var schemas embed.FS
+//
+//	schema, err := gojsonschema.NewJsonSchemaDocumentFS(schemas, "schemas/order.json")
+func NewJsonSchemaDocumentFS(fsys fs.FS, name string) (*JsonSchemaDocument, error) {
+	return NewJsonSchemaDocumentWithOptions("file:///"+name, SchemaOptions{FileSystem: fsys})
+}
+
+func NewJsonSchemaDocumentWithOptions(document interface{}, schemaOptions SchemaOptions) (*JsonSchemaDocument, error) {
 
 	var err error
 
-	d := JsonSchemaDocument{}
-	d.pool = newSchemaPool()
-	d.referencePool = newSchemaReferencePool()
+	start := time.Now()
+
+	d := newJsonSchemaDocument(schemaOptions)
 
 	switch document.(type) {
 
@@ -53,6 +125,9 @@ func NewJsonSchemaDocument(document interface{}) (*JsonSchemaDocument, error) {
 		}
 
 		err = d.parse(spd.Document)
+		if schemaError, ok := err.(*SchemaError); ok {
+			schemaError.locate(d.pool)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -63,6 +138,7 @@ func NewJsonSchemaDocument(document interface{}) (*JsonSchemaDocument, error) {
 		if err != nil {
 			return nil, err
 		}
+		d.pool.addDocument(d.documentReference, document)
 
 		err = d.parse(document.(map[string]interface{}))
 		if err != nil {
@@ -73,14 +149,79 @@ func NewJsonSchemaDocument(document interface{}) (*JsonSchemaDocument, error) {
 		return nil, errors.New("Invalid argument, must be a jsonReference string or Json as map[string]interface{}")
 	}
 
-	return &d, nil
+	if err := d.compiled(start); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Returns a document to parse, with its pools
+func newJsonSchemaDocument(schemaOptions SchemaOptions) *JsonSchemaDocument {
+
+	d := &JsonSchemaDocument{schemaOptions: schemaOptions}
+	if schemaOptions.RegexEngine != nil {
+		d.AddFormatChecker("regex", FormatCheckerFunc(d.checkRegexFormat))
+	}
+	for name, checker := range schemaOptions.FormatCheckers {
+		d.AddFormatChecker(name, checker)
+	}
+	if schemaOptions.Logger != nil {
+		d.SetLogger(schemaOptions.Logger)
+	}
+	d.pool = newSchemaPool()
+	d.pool.cacheDirectory = schemaOptions.CacheDirectory
+	d.pool.logger = schemaOptions.Logger
+	d.pool.limits = schemaOptions.Limits
+	d.pool.policy = schemaOptions.RemotePolicy
+	d.pool.fetchOptions = schemaOptions.Fetch
+	d.pool.fileSystem = schemaOptions.FileSystem
+	d.pool.resolver = schemaOptions.Resolver
+	if !schemaOptions.RemotePolicy.isZero() || schemaOptions.Fetch.Timeout > 0 {
+		d.pool.client = schemaOptions.RemotePolicy.client()
+		d.pool.client.Timeout = schemaOptions.Fetch.Timeout
+	}
+	d.referencePool = newSchemaReferencePool()
+	return d
 }
 
+// Checks the examples of the parsed document, if asked, and logs its compilation
+func (d *JsonSchemaDocument) compiled(start time.Time) error {
+
+	if d.schemaOptions.CheckExamples {
+		if err := d.checkExamples(); err != nil {
+			return err
+		}
+	}
+
+	if d.schemaOptions.Logger != nil {
+		d.schemaOptions.Logger.LogAttrs(context.Background(), slog.LevelInfo, LOG_SCHEMA_COMPILED,
+			slog.String(LOG_KEY_SCHEMA, d.schemaId()),
+			slog.Int(LOG_KEY_DOCUMENTS, len(d.pool.schemaPoolDocuments)),
+			slog.Duration(LOG_KEY_DURATION, time.Since(start)))
+	}
+	return nil
+}
+
+// A JsonSchemaDocument is safe for concurrent use once compiled : validations
+// only read the schemas, and the setters ( SetUseDefaults, AddFormatChecker... )
+// may run at any time, each validation uses the options set when it starts
+// SetRootSchemaName renames the compiled root schema, it must be called before use
+// The validated documents are not copied, SetUseDefaults and SetRemoveAdditional
+// modify them, a document must not be validated by several goroutines then
 type JsonSchemaDocument struct {
 	documentReference gojsonreference.JsonReference
 	rootSchema        *jsonSchema
 	pool              *schemaPool
 	referencePool     *schemaReferencePool
+	schemaOptions     SchemaOptions
+
+	// validation options, replaced as a whole by the setters so that Validate
+	// can run concurrently with them
+	options      atomic.Pointer[validationOptions]
+	optionsMutex sync.Mutex
+
+	// format names used in the schema
+	formats []string
 }
 
 func (d *JsonSchemaDocument) parse(document interface{}) error {
@@ -88,10 +229,38 @@ func (d *JsonSchemaDocument) parse(document interface{}) error {
 	return d.parseSchema(document, d.rootSchema)
 }
 
+// Names the root in the error messages, (root) by default
+// Not safe for concurrent use, it must be called before the first validation
 func (d *JsonSchemaDocument) SetRootSchemaName(name string) {
 	d.rootSchema.property = name
 }
 
+// Returns the format names used in the schema that no checker is registered for,
+// these formats are not checked
+func (d *JsonSchemaDocument) GetUnknownFormats() []string {
+	var unknownFormats []string
+	for _, formatName := range d.formats {
+		if _, ok := d.getOptions().getFormatChecker(formatName); !ok {
+			unknownFormats = append(unknownFormats, formatName)
+		}
+	}
+	return unknownFormats
+}
+
+// Compiles a pattern of the schema, translated from ECMA 262 if requested
+func (d *JsonSchemaDocument) compileRegexp(pattern string) (Regexp, error) {
+	if d.schemaOptions.RegexEngine != nil {
+		return d.schemaOptions.RegexEngine.Compile(pattern)
+	}
+	return compileSchemaRegexp(pattern, d.schemaOptions.EcmaRegex)
+}
+
+// The regex format, checked with the engine of the patterns
+func (d *JsonSchemaDocument) checkRegexFormat(input string) error {
+	_, err := d.compileRegexp(input)
+	return err
+}
+
 // Parses a schema
 //
 // Pretty long function ( sorry :) )... but pretty straight forward, repetitive and boring
@@ -100,12 +269,32 @@ func (d *JsonSchemaDocument) SetRootSchemaName(name string) {
 //
 func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema *jsonSchema) error {
 
+	err := d.parseSchemaKeywords(documentNode, currentSchema)
+	if _, isSchemaError := err.(*SchemaError); err != nil && !isSchemaError {
+		err = newSchemaError(currentSchema, err)
+	}
+	return err
+}
+
+func (d *JsonSchemaDocument) parseSchemaKeywords(documentNode interface{}, currentSchema *jsonSchema) error {
+
 	if !isKind(documentNode, reflect.Map) {
 		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, STRING_SCHEMA, STRING_OBJECT))
 	}
 
 	m := documentNode.(map[string]interface{})
 
+	if d.schemaOptions.Dialect == DIALECT_SWAGGER_2_0 {
+		if err := checkSwaggerKeywords(m); err != nil {
+			return err
+		}
+	}
+	if d.schemaOptions.Dialect == DIALECT_KUBERNETES {
+		if err := checkKubernetesKeywords(m, currentSchema); err != nil {
+			return err
+		}
+	}
+
 	if currentSchema == d.rootSchema {
 		currentSchema.ref = &d.documentReference
 	}
@@ -149,11 +338,11 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 			currentSchema.definitions = make(map[string]*jsonSchema)
 			for dk, dv := range m[KEY_DEFINITIONS].(map[string]interface{}) {
 				if isKind(dv, reflect.Map) {
-					newSchema := &jsonSchema{property: KEY_DEFINITIONS, parent: currentSchema, ref: currentSchema.ref}
+					newSchema := &jsonSchema{property: KEY_DEFINITIONS, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_DEFINITIONS, dk)}
 					currentSchema.definitions[dk] = newSchema
-					err := d.parseSchema(m[KEY_DEFINITIONS], newSchema)
+					err := d.parseSchema(dv, newSchema)
 					if err != nil {
-						return errors.New(err.Error())
+						return err
 					}
 				} else {
 					return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_DEFINITIONS, STRING_ARRAY_OF_SCHEMAS))
@@ -173,6 +362,23 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		currentSchema.id = &k
 	}
 
+	// $comment
+	if existsMapKey(m, KEY_COMMENT) && !isKind(m[KEY_COMMENT], reflect.String) {
+		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_COMMENT, STRING_STRING))
+	}
+	if k, ok := m[KEY_COMMENT].(string); ok {
+		currentSchema.comment = &k
+	}
+
+	// deprecated, a keyword since draft 2019-09, the earlier drafts know it as
+	// an unknown keyword with any value ( "deprecated": "use foo" )
+	if existsMapKey(m, KEY_DEPRECATED) && !isKind(m[KEY_DEPRECATED], reflect.Bool) && (d.schemaOptions.Dialect == DIALECT_OPENAPI_3_0 || currentSchema.isDraft2019OrLater()) {
+		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_DEPRECATED, STRING_BOOLEAN))
+	}
+	if k, ok := m[KEY_DEPRECATED].(bool); ok {
+		currentSchema.deprecated = k
+	}
+
 	// title
 	if existsMapKey(m, KEY_TITLE) && !isKind(m[KEY_TITLE], reflect.String) {
 		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_TITLE, STRING_STRING))
@@ -189,6 +395,21 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		currentSchema.description = &k
 	}
 
+	// default
+	if existsMapKey(m, KEY_DEFAULT) {
+		currentSchema.defaultValue = m[KEY_DEFAULT]
+		currentSchema.hasDefault = true
+	}
+
+	// examples
+	if existsMapKey(m, KEY_EXAMPLES) {
+		examples, ok := m[KEY_EXAMPLES].([]interface{})
+		if !ok {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_EXAMPLES, TYPE_ARRAY))
+		}
+		currentSchema.examples = examples
+	}
+
 	// type
 	if existsMapKey(m, KEY_TYPE) {
 		if isKind(m[KEY_TYPE], reflect.String) {
@@ -215,6 +436,20 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		}
 	}
 
+	// OpenAPI 3.0 and Swagger 2.0
+	if d.openApiDialect() {
+		if err := d.parseOpenApiKeywords(m, currentSchema); err != nil {
+			return err
+		}
+	}
+
+	// Kubernetes
+	if d.schemaOptions.Dialect == DIALECT_KUBERNETES {
+		if err := d.parseKubernetesKeywords(m, currentSchema); err != nil {
+			return err
+		}
+	}
+
 	// properties
 	if existsMapKey(m, KEY_PROPERTIES) {
 		err := d.parseProperties(m[KEY_PROPERTIES], currentSchema)
@@ -228,11 +463,11 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		if isKind(m[KEY_ADDITIONAL_PROPERTIES], reflect.Bool) {
 			currentSchema.additionalProperties = m[KEY_ADDITIONAL_PROPERTIES].(bool)
 		} else if isKind(m[KEY_ADDITIONAL_PROPERTIES], reflect.Map) {
-			newSchema := &jsonSchema{property: KEY_ADDITIONAL_PROPERTIES, parent: currentSchema, ref: currentSchema.ref}
+			newSchema := &jsonSchema{property: KEY_ADDITIONAL_PROPERTIES, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_ADDITIONAL_PROPERTIES)}
 			currentSchema.additionalProperties = newSchema
 			err := d.parseSchema(m[KEY_ADDITIONAL_PROPERTIES], newSchema)
 			if err != nil {
-				return errors.New(err.Error())
+				return err
 			}
 		} else {
 			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_ADDITIONAL_PROPERTIES, STRING_BOOLEAN+"/"+STRING_SCHEMA))
@@ -245,15 +480,17 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 			patternPropertiesMap := m[KEY_PATTERN_PROPERTIES].(map[string]interface{})
 			if len(patternPropertiesMap) > 0 {
 				currentSchema.patternProperties = make(map[string]*jsonSchema)
+				currentSchema.patternPropertiesRegexp = make(map[string]Regexp)
 				for k, v := range patternPropertiesMap {
-					_, err := regexp.MatchString(k, "")
+					regexpObject, err := d.compileRegexp(k)
 					if err != nil {
-						return errors.New(fmt.Sprintf("Invalid regex pattern '%s'", k))
+						return newSchemaError(currentSchema, errors.New(fmt.Sprintf("invalid regex pattern '%s' : %s", k, err.Error())), KEY_PATTERN_PROPERTIES, k)
 					}
-					newSchema := &jsonSchema{property: k, parent: currentSchema, ref: currentSchema.ref}
+					currentSchema.patternPropertiesRegexp[k] = regexpObject
+					newSchema := &jsonSchema{property: k, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_PATTERN_PROPERTIES, k)}
 					err = d.parseSchema(v, newSchema)
 					if err != nil {
-						return errors.New(err.Error())
+						return err
 					}
 					currentSchema.patternProperties[k] = newSchema
 				}
@@ -262,6 +499,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_PATTERN_PROPERTIES, STRING_SCHEMA))
 		}
 	}
+	currentSchema.closedProperties = currentSchema.closesProperties()
 
 	// dependencies
 	if existsMapKey(m, KEY_DEPENDENCIES) {
@@ -274,9 +512,9 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	// items
 	if existsMapKey(m, KEY_ITEMS) {
 		if isKind(m[KEY_ITEMS], reflect.Slice) {
-			for _, itemElement := range m[KEY_ITEMS].([]interface{}) {
+			for i, itemElement := range m[KEY_ITEMS].([]interface{}) {
 				if isKind(itemElement, reflect.Map) {
-					newSchema := &jsonSchema{parent: currentSchema, property: KEY_ITEMS}
+					newSchema := &jsonSchema{parent: currentSchema, property: KEY_ITEMS, pointer: currentSchema.childPointer(KEY_ITEMS, strconv.Itoa(i))}
 					newSchema.ref = currentSchema.ref
 					currentSchema.AddItemsChild(newSchema)
 					err := d.parseSchema(itemElement, newSchema)
@@ -289,7 +527,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 				currentSchema.itemsChildrenIsSingleSchema = false
 			}
 		} else if isKind(m[KEY_ITEMS], reflect.Map) {
-			newSchema := &jsonSchema{parent: currentSchema, property: KEY_ITEMS}
+			newSchema := &jsonSchema{parent: currentSchema, property: KEY_ITEMS, pointer: currentSchema.childPointer(KEY_ITEMS)}
 			newSchema.ref = currentSchema.ref
 			currentSchema.AddItemsChild(newSchema)
 			err := d.parseSchema(m[KEY_ITEMS], newSchema)
@@ -307,11 +545,11 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		if isKind(m[KEY_ADDITIONAL_ITEMS], reflect.Bool) {
 			currentSchema.additionalItems = m[KEY_ADDITIONAL_ITEMS].(bool)
 		} else if isKind(m[KEY_ADDITIONAL_ITEMS], reflect.Map) {
-			newSchema := &jsonSchema{property: KEY_ADDITIONAL_ITEMS, parent: currentSchema, ref: currentSchema.ref}
+			newSchema := &jsonSchema{property: KEY_ADDITIONAL_ITEMS, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_ADDITIONAL_ITEMS)}
 			currentSchema.additionalItems = newSchema
 			err := d.parseSchema(m[KEY_ADDITIONAL_ITEMS], newSchema)
 			if err != nil {
-				return errors.New(err.Error())
+				return err
 			}
 		} else {
 			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_ADDITIONAL_ITEMS, STRING_BOOLEAN+"/"+STRING_SCHEMA))
@@ -321,56 +559,62 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	// validation : number / integer
 
 	if existsMapKey(m, KEY_MULTIPLE_OF) {
-		if isKind(m[KEY_MULTIPLE_OF], reflect.Float64) {
-			multipleOfValue := m[KEY_MULTIPLE_OF].(float64)
-			if multipleOfValue <= 0 {
+		if multipleOfValue, exact, ok := schemaNumber(m[KEY_MULTIPLE_OF]); ok {
+			if multipleOfValue <= 0 || exact != nil && exact.rat.Sign() <= 0 {
 				return errors.New("multipleOf must be strictly greater than 0")
 			}
 			currentSchema.multipleOf = &multipleOfValue
+			currentSchema.setExactNumber(KEY_MULTIPLE_OF, exact)
 		} else {
 			return errors.New("multipleOf must be a number")
 		}
 	}
 
 	if existsMapKey(m, KEY_MINIMUM) {
-		if isKind(m[KEY_MINIMUM], reflect.Float64) {
-			minimumValue := m[KEY_MINIMUM].(float64)
+		if minimumValue, exact, ok := schemaNumber(m[KEY_MINIMUM]); ok {
 			currentSchema.minimum = &minimumValue
+			currentSchema.setExactNumber(KEY_MINIMUM, exact)
 		} else {
 			return errors.New("minimum must be a number")
 		}
 	}
 
 	if existsMapKey(m, KEY_EXCLUSIVE_MINIMUM) {
-		if isKind(m[KEY_EXCLUSIVE_MINIMUM], reflect.Bool) {
+		if exclusiveMinimumValue, exact, ok := schemaNumber(m[KEY_EXCLUSIVE_MINIMUM]); ok {
+			currentSchema.exclusiveMinimumValue = &exclusiveMinimumValue
+			currentSchema.setExactNumber(KEY_EXCLUSIVE_MINIMUM, exact)
+		} else if isKind(m[KEY_EXCLUSIVE_MINIMUM], reflect.Bool) {
 			if currentSchema.minimum == nil {
 				return errors.New("exclusiveMinimum cannot exist without minimum")
 			}
 			exclusiveMinimumValue := m[KEY_EXCLUSIVE_MINIMUM].(bool)
 			currentSchema.exclusiveMinimum = exclusiveMinimumValue
 		} else {
-			return errors.New("exclusiveMinimum must be a boolean")
+			return errors.New("exclusiveMinimum must be a boolean or a number")
 		}
 	}
 
 	if existsMapKey(m, KEY_MAXIMUM) {
-		if isKind(m[KEY_MAXIMUM], reflect.Float64) {
-			maximumValue := m[KEY_MAXIMUM].(float64)
+		if maximumValue, exact, ok := schemaNumber(m[KEY_MAXIMUM]); ok {
 			currentSchema.maximum = &maximumValue
+			currentSchema.setExactNumber(KEY_MAXIMUM, exact)
 		} else {
 			return errors.New("maximum must be a number")
 		}
 	}
 
 	if existsMapKey(m, KEY_EXCLUSIVE_MAXIMUM) {
-		if isKind(m[KEY_EXCLUSIVE_MAXIMUM], reflect.Bool) {
+		if exclusiveMaximumValue, exact, ok := schemaNumber(m[KEY_EXCLUSIVE_MAXIMUM]); ok {
+			currentSchema.exclusiveMaximumValue = &exclusiveMaximumValue
+			currentSchema.setExactNumber(KEY_EXCLUSIVE_MAXIMUM, exact)
+		} else if isKind(m[KEY_EXCLUSIVE_MAXIMUM], reflect.Bool) {
 			if currentSchema.maximum == nil {
 				return errors.New("exclusiveMaximum cannot exist without maximum")
 			}
 			exclusiveMaximumValue := m[KEY_EXCLUSIVE_MAXIMUM].(bool)
 			currentSchema.exclusiveMaximum = exclusiveMaximumValue
 		} else {
-			return errors.New("exclusiveMaximum must be a boolean")
+			return errors.New("exclusiveMaximum must be a boolean or a number")
 		}
 	}
 
@@ -383,8 +627,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	// validation : string
 
 	if existsMapKey(m, KEY_MIN_LENGTH) {
-		if isKind(m[KEY_MIN_LENGTH], reflect.Float64) {
-			minLengthValue := m[KEY_MIN_LENGTH].(float64)
+		if minLengthValue, _, ok := schemaNumber(m[KEY_MIN_LENGTH]); ok {
 			if isFloat64AnInteger(minLengthValue) {
 				if minLengthValue < 0 {
 					return errors.New("minLength must be greater than or equal to 0")
@@ -400,8 +643,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	}
 
 	if existsMapKey(m, KEY_MAX_LENGTH) {
-		if isKind(m[KEY_MAX_LENGTH], reflect.Float64) {
-			maxLengthValue := m[KEY_MAX_LENGTH].(float64)
+		if maxLengthValue, _, ok := schemaNumber(m[KEY_MAX_LENGTH]); ok {
 			if isFloat64AnInteger(maxLengthValue) {
 				if maxLengthValue < 0 {
 					return errors.New("maxLength must be greater than or equal to 0")
@@ -424,9 +666,9 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 
 	if existsMapKey(m, KEY_PATTERN) {
 		if isKind(m[KEY_PATTERN], reflect.String) {
-			regexpObject, err := regexp.Compile(m[KEY_PATTERN].(string))
+			regexpObject, err := d.compileRegexp(m[KEY_PATTERN].(string))
 			if err != nil {
-				return errors.New("pattern must be a valid regular expression")
+				return newSchemaError(currentSchema, errors.New(fmt.Sprintf("pattern must be a valid regular expression : %s", err.Error())), KEY_PATTERN)
 			}
 			currentSchema.pattern = regexpObject
 		} else {
@@ -434,11 +676,24 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		}
 	}
 
+	if existsMapKey(m, KEY_FORMAT) {
+		formatName, ok := m[KEY_FORMAT].(string)
+		if !ok {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_FORMAT, STRING_STRING))
+		}
+		if _, ok := d.getOptions().getFormatChecker(formatName); !ok && d.schemaOptions.StrictFormats {
+			return errors.New(fmt.Sprintf("format %s is unknown", formatName))
+		}
+		if !isStringInSlice(d.formats, formatName) {
+			d.formats = append(d.formats, formatName)
+		}
+		currentSchema.format = &formatName
+	}
+
 	// validation : object
 
 	if existsMapKey(m, KEY_MIN_PROPERTIES) {
-		if isKind(m[KEY_MIN_PROPERTIES], reflect.Float64) {
-			minPropertiesValue := m[KEY_MIN_PROPERTIES].(float64)
+		if minPropertiesValue, _, ok := schemaNumber(m[KEY_MIN_PROPERTIES]); ok {
 			if isFloat64AnInteger(minPropertiesValue) {
 				if minPropertiesValue < 0 {
 					return errors.New("minProperties must be greater than or equal to 0")
@@ -454,8 +709,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	}
 
 	if existsMapKey(m, KEY_MAX_PROPERTIES) {
-		if isKind(m[KEY_MAX_PROPERTIES], reflect.Float64) {
-			maxPropertiesValue := m[KEY_MAX_PROPERTIES].(float64)
+		if maxPropertiesValue, _, ok := schemaNumber(m[KEY_MAX_PROPERTIES]); ok {
 			if isFloat64AnInteger(maxPropertiesValue) {
 				if maxPropertiesValue < 0 {
 					return errors.New("maxProperties must be greater than or equal to 0")
@@ -497,8 +751,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	// validation : array
 
 	if existsMapKey(m, KEY_MIN_ITEMS) {
-		if isKind(m[KEY_MIN_ITEMS], reflect.Float64) {
-			minItemsValue := m[KEY_MIN_ITEMS].(float64)
+		if minItemsValue, _, ok := schemaNumber(m[KEY_MIN_ITEMS]); ok {
 			if isFloat64AnInteger(minItemsValue) {
 				if minItemsValue < 0 {
 					return errors.New("minItems must be greater than or equal to 0")
@@ -514,8 +767,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 	}
 
 	if existsMapKey(m, KEY_MAX_ITEMS) {
-		if isKind(m[KEY_MAX_ITEMS], reflect.Float64) {
-			maxItemsValue := m[KEY_MAX_ITEMS].(float64)
+		if maxItemsValue, _, ok := schemaNumber(m[KEY_MAX_ITEMS]); ok {
 			if isFloat64AnInteger(maxItemsValue) {
 				if maxItemsValue < 0 {
 					return errors.New("maxItems must be greater than or equal to 0")
@@ -557,8 +809,8 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 
 	if existsMapKey(m, KEY_ONE_OF) {
 		if isKind(m[KEY_ONE_OF], reflect.Slice) {
-			for _, v := range m[KEY_ONE_OF].([]interface{}) {
-				newSchema := &jsonSchema{property: KEY_ONE_OF, parent: currentSchema, ref: currentSchema.ref}
+			for i, v := range m[KEY_ONE_OF].([]interface{}) {
+				newSchema := &jsonSchema{property: KEY_ONE_OF, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_ONE_OF, strconv.Itoa(i))}
 				currentSchema.AddOneOf(newSchema)
 				err := d.parseSchema(v, newSchema)
 				if err != nil {
@@ -570,10 +822,18 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 		}
 	}
 
+	// an OpenAPI 3.0 keyword, the discriminator of Swagger 2.0 only names a property
+	if existsMapKey(m, KEY_DISCRIMINATOR) && d.schemaOptions.Dialect == DIALECT_OPENAPI_3_0 {
+		err := d.parseDiscriminator(m, currentSchema)
+		if err != nil {
+			return err
+		}
+	}
+
 	if existsMapKey(m, KEY_ANY_OF) {
 		if isKind(m[KEY_ANY_OF], reflect.Slice) {
-			for _, v := range m[KEY_ANY_OF].([]interface{}) {
-				newSchema := &jsonSchema{property: KEY_ANY_OF, parent: currentSchema, ref: currentSchema.ref}
+			for i, v := range m[KEY_ANY_OF].([]interface{}) {
+				newSchema := &jsonSchema{property: KEY_ANY_OF, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_ANY_OF, strconv.Itoa(i))}
 				currentSchema.AddAnyOf(newSchema)
 				err := d.parseSchema(v, newSchema)
 				if err != nil {
@@ -587,8 +847,8 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 
 	if existsMapKey(m, KEY_ALL_OF) {
 		if isKind(m[KEY_ALL_OF], reflect.Slice) {
-			for _, v := range m[KEY_ALL_OF].([]interface{}) {
-				newSchema := &jsonSchema{property: KEY_ALL_OF, parent: currentSchema, ref: currentSchema.ref}
+			for i, v := range m[KEY_ALL_OF].([]interface{}) {
+				newSchema := &jsonSchema{property: KEY_ALL_OF, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_ALL_OF, strconv.Itoa(i))}
 				currentSchema.AddAllOf(newSchema)
 				err := d.parseSchema(v, newSchema)
 				if err != nil {
@@ -602,7 +862,7 @@ func (d *JsonSchemaDocument) parseSchema(documentNode interface{}, currentSchema
 
 	if existsMapKey(m, KEY_NOT) {
 		if isKind(m[KEY_NOT], reflect.Map) {
-			newSchema := &jsonSchema{property: KEY_NOT, parent: currentSchema, ref: currentSchema.ref}
+			newSchema := &jsonSchema{property: KEY_NOT, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_NOT)}
 			currentSchema.SetNot(newSchema)
 			err := d.parseSchema(m[KEY_NOT], newSchema)
 			if err != nil {
@@ -653,7 +913,7 @@ func (d *JsonSchemaDocument) parseReference(documentNode interface{}, currentSch
 	// returns the loaded referenced schema for the caller to update its current schema
 	newSchemaDocument := refdDocumentNode.(map[string]interface{})
 
-	newSchema := &jsonSchema{property: KEY_REF, parent: currentSchema, ref: currentSchema.ref}
+	newSchema := &jsonSchema{property: KEY_REF, parent: currentSchema, ref: currentSchema.ref, pointer: jsonPointer.String()}
 	d.referencePool.AddSchema(currentSchema.ref.String()+reference, newSchema)
 
 	err = d.parseSchema(newSchemaDocument, newSchema)
@@ -667,6 +927,96 @@ func (d *JsonSchemaDocument) parseReference(documentNode interface{}, currentSch
 
 }
 
+// Tells if the $schema of the schema, or of its closest parent declaring one,
+// is draft 2019-09 or a later draft
+func (s *jsonSchema) isDraft2019OrLater() bool {
+	for ; s != nil; s = s.parent {
+		if s.schema != nil {
+			uri := s.schema.String()
+			return strings.Contains(uri, "/draft/2019-09/") || strings.Contains(uri, "/draft/2020-12/")
+		}
+	}
+	return false
+}
+
+// Parses an OpenAPI style discriminator, each value of the discriminator property
+// is bound to one of the oneOf schemas, explicitly with the mapping or implicitly
+// with the last element of the oneOf schema $ref ( ex: #/definitions/Cat => Cat )
+// A mapping value may be a $ref or the name of a schema of the definitions / components
+func (d *JsonSchemaDocument) parseDiscriminator(m map[string]interface{}, currentSchema *jsonSchema) error {
+
+	if !isKind(m[KEY_DISCRIMINATOR], reflect.Map) {
+		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_DISCRIMINATOR, STRING_OBJECT))
+	}
+	if len(currentSchema.oneOf) == 0 {
+		return errors.New("discriminator cannot exist without oneOf")
+	}
+
+	discriminatorMap := m[KEY_DISCRIMINATOR].(map[string]interface{})
+
+	propertyName, ok := discriminatorMap[KEY_PROPERTY_NAME].(string)
+	if !ok {
+		return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_PROPERTY_NAME, STRING_STRING))
+	}
+
+	discriminator := &jsonSchemaDiscriminator{propertyName: propertyName, mapping: make(map[string]int)}
+
+	// $ref of every oneOf schema
+	oneOfRefs := make([]string, len(currentSchema.oneOf))
+	for i, v := range m[KEY_ONE_OF].([]interface{}) {
+		if oneOfMap, ok := v.(map[string]interface{}); ok {
+			if ref, ok := oneOfMap[KEY_REF].(string); ok {
+				oneOfRefs[i] = ref
+			}
+		}
+	}
+
+	for i, ref := range oneOfRefs {
+		if ref != "" {
+			discriminator.mapping[unescapeJsonPointerToken(ref[strings.LastIndex(ref, "/")+1:])] = i
+		}
+	}
+
+	if existsMapKey(discriminatorMap, KEY_MAPPING) {
+		if !isKind(discriminatorMap[KEY_MAPPING], reflect.Map) {
+			return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_MAPPING, STRING_OBJECT))
+		}
+		for value, ref := range discriminatorMap[KEY_MAPPING].(map[string]interface{}) {
+			refString, ok := ref.(string)
+			if !ok {
+				return errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_MAPPING, STRING_OBJECT+" of strings"))
+			}
+			found := false
+			for i, oneOfRef := range oneOfRefs {
+				if oneOfRef == refString || isDiscriminatorSchemaName(refString) && refersToSchemaName(oneOfRef, refString) {
+					discriminator.mapping[value] = i
+					found = true
+				}
+			}
+			if !found {
+				return errors.New(fmt.Sprintf("discriminator mapping %s does not reference a oneOf schema", refString))
+			}
+		}
+	}
+
+	currentSchema.discriminator = discriminator
+
+	return nil
+}
+
+// A mapping value is either a $ref or, as allowed by OpenAPI, the bare name of a schema
+func isDiscriminatorSchemaName(value string) bool {
+	return value != "" && !strings.ContainsAny(value, "/#")
+}
+
+// Whether the $ref points to the schema of that name in the definitions or
+// the components of its document
+func refersToSchemaName(ref string, name string) bool {
+	token := escapeJsonPointerToken(name)
+	return strings.HasSuffix(ref, "#/"+KEY_DEFINITIONS+"/"+token) ||
+		strings.HasSuffix(ref, "#/"+KEY_COMPONENTS+"/"+KEY_SCHEMAS+"/"+token)
+}
+
 func (d *JsonSchemaDocument) parseProperties(documentNode interface{}, currentSchema *jsonSchema) error {
 
 	if !isKind(documentNode, reflect.Map) {
@@ -676,7 +1026,7 @@ func (d *JsonSchemaDocument) parseProperties(documentNode interface{}, currentSc
 	m := documentNode.(map[string]interface{})
 	for k := range m {
 		schemaProperty := k
-		newSchema := &jsonSchema{property: schemaProperty, parent: currentSchema, ref: currentSchema.ref}
+		newSchema := &jsonSchema{property: schemaProperty, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_PROPERTIES, k)}
 		currentSchema.AddPropertiesChild(newSchema)
 		err := d.parseSchema(m[k], newSchema)
 		if err != nil {
@@ -713,7 +1063,7 @@ func (d *JsonSchemaDocument) parseDependencies(documentNode interface{}, current
 			}
 
 		case reflect.Map:
-			depSchema := &jsonSchema{property: k, parent: currentSchema, ref: currentSchema.ref}
+			depSchema := &jsonSchema{property: k, parent: currentSchema, ref: currentSchema.ref, pointer: currentSchema.childPointer(KEY_DEPENDENCIES, k)}
 			err := d.parseSchema(m[k], depSchema)
 			if err != nil {
 				return err
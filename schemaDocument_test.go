@@ -0,0 +1,138 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema parsing.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestInvalidPatternLocation(t *testing.T) {
+
+	tests := []struct {
+		schema   string
+		expected string
+	}{
+		{`{"pattern":"(a"}`, `/pattern : pattern must be a valid regular expression : `},
+		{`{"properties":{"a/b":{"items":[{},{"pattern":"[z-a]"}]}}}`, `/properties/a~1b/items/1/pattern : pattern must be a valid regular expression : `},
+		{`{"definitions":{"d":{"patternProperties":{"*":{}}}}}`, `/definitions/d/patternProperties/* : invalid regex pattern '*' : `},
+		{`{"anyOf":[{"not":{"pattern":"(?<=a)b"}}]}`, `/anyOf/0/not/pattern : pattern must be a valid regular expression : `},
+	}
+
+	for _, test := range tests {
+		_, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, test.schema), SchemaOptions{EcmaRegex: true})
+		if err == nil {
+			t.Errorf("Expects %s to be an error", test.schema)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("Expects an error starting with %q for %s, given %q", test.expected, test.schema, err.Error())
+		}
+	}
+}
+
+func TestSchemaErrorLocation(t *testing.T) {
+
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	err := os.WriteFile(schemaFile, []byte(`{
+  "properties": {
+    "a": {"type": "string"},
+    "b": {
+      "items": [{}, {"minLength": -1}]
+    }
+  }
+}`), 0644)
+	if err != nil {
+		t.Fatalf("Could not write schema : %s", err.Error())
+	}
+
+	_, err = NewJsonSchemaDocument("file://" + filepath.ToSlash(schemaFile))
+
+	var schemaError *SchemaError
+	if !errors.As(err, &schemaError) {
+		t.Fatalf("Expects a *SchemaError, given %v", err)
+	}
+	if schemaError.Pointer != "/properties/b/items/1" || schemaError.Line != 5 || schemaError.Column != 21 {
+		t.Errorf("Expects the error at /properties/b/items/1 line 5 column 21, given %s line %d column %d", schemaError.Pointer, schemaError.Line, schemaError.Column)
+	}
+	if !strings.HasSuffix(schemaError.Document, "/schema.json") {
+		t.Errorf("Expects the error in schema.json, given %s", schemaError.Document)
+	}
+
+	_, err = NewJsonSchemaDocument(mustParseJson(t, `{"properties":{"a":{"type":"text"}}}`))
+	if err == nil || err.Error() != "/properties/a : text is not a valid type" {
+		t.Errorf("Expects the pointer of the invalid schema, given %v", err)
+	}
+}
+
+func TestInternalReferenceInJson(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, `{
+		"definitions":{"node":{"properties":{"children":{"items":{"$ref":"#/definitions/node"}},"name":{"type":"string"}}}},
+		"$ref":"#/definitions/node"
+	}`))
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	if result := schemaDocument.Validate(mustParseJson(t, `{"name":"a","children":[{"name":"b"}]}`)); !result.IsValid() {
+		t.Errorf("Expects a valid document, given %v", result.GetErrorMessages())
+	}
+	if result := schemaDocument.Validate(mustParseJson(t, `{"children":[{"name":1}]}`)); result.IsValid() {
+		t.Errorf("Expects the referenced schema to be validated")
+	}
+}
+
+func TestSchemaDocumentFS(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"schemas/order.json":       {Data: []byte(`{"properties":{"id":{"$ref":"definitions.json#/definitions/id"},"lines":{"items":{"$ref":"lines/line.yaml"}}}}`)},
+		"schemas/definitions.json": {Data: []byte(`{"definitions":{"id":{"type":"integer","minimum":1}}}`)},
+		"schemas/lines/line.yaml":  {Data: []byte("required: [product]\nproperties:\n  product:\n    $ref: '../definitions.json#/definitions/id'\n")},
+	}
+
+	schemaDocument, err := NewJsonSchemaDocumentFS(fsys, "schemas/order.json")
+	if err != nil {
+		t.Fatalf("Could not load the schema : %s", err.Error())
+	}
+
+	if result := schemaDocument.Validate(mustParseJson(t, `{"id":1,"lines":[{"product":2}]}`)); !result.IsValid() {
+		t.Errorf("Expects a valid document, given %v", result.GetErrorMessages())
+	}
+	result := schemaDocument.Validate(mustParseJson(t, `{"id":0,"lines":[{"product":"a"},{}]}`))
+	if len(result.GetErrors()) != 3 {
+		t.Errorf("Expects 3 errors, given %v", result.GetErrorMessages())
+	}
+
+	// the files are read from the file system only
+	if _, err := NewJsonSchemaDocumentFS(fsys, "schemas/missing.json"); err == nil {
+		t.Errorf("Expects a missing file to fail")
+	}
+}
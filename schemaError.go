@@ -0,0 +1,192 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Errors of schemas that cannot be parsed, with their location.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// SchemaError is returned when a schema cannot be parsed
+type SchemaError struct {
+	// url of the schema document, empty for a schema given as json
+	Document string
+	// json pointer of the invalid schema or keyword in the document
+	Pointer string
+	// position in the text of the document, starting at 1, 0 when unknown
+	Line   int
+	Column int
+
+	Err error
+}
+
+func (e *SchemaError) Error() string {
+
+	location := e.Pointer
+	if location == "" {
+		location = "/"
+	}
+	if e.Document != "" {
+		location = e.Document + "#" + e.Pointer
+	}
+	if e.Line > 0 {
+		location += fmt.Sprintf(" ( line %d, column %d )", e.Line, e.Column)
+	}
+
+	return location + " : " + e.Err.Error()
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+// newSchemaError wraps an error on a schema or one of its keywords
+func newSchemaError(schema *jsonSchema, err error, tokens ...string) *SchemaError {
+	schemaError := &SchemaError{Pointer: schema.childPointer(tokens...), Err: err}
+	if schema.ref != nil {
+		schemaError.Document = documentUrl(*schema.ref)
+	}
+	return schemaError
+}
+
+// locate sets the line and column of the error from the text of its document
+func (e *SchemaError) locate(pool *schemaPool) {
+
+	spd, ok := pool.schemaPoolDocuments[e.Document]
+	if !ok || spd.text == nil {
+		return
+	}
+
+	tokens, err := parseJsonPointerTokens(e.Pointer)
+	if err != nil {
+		return
+	}
+	offset, ok := jsonPointerOffset(spd.text, tokens)
+	if !ok {
+		return
+	}
+
+	e.Line, e.Column = 1, 1
+	for _, c := range spd.text[:offset] {
+		if c == '\n' {
+			e.Line++
+			e.Column = 1
+		} else {
+			e.Column++
+		}
+	}
+}
+
+// jsonPointerOffset returns the offset in a json text of the value at the
+// pointer tokens, or of the closest existing parent
+func jsonPointerOffset(text []byte, tokens []string) (int64, bool) {
+
+	decoder := json.NewDecoder(bytes.NewReader(text))
+
+	// path of the next value, and whether each container is an object
+	var path []string
+	var isObject []bool
+	expectsKey := false
+
+	best := int64(-1)
+	bestDepth := -1
+
+	for {
+		start := valueStart(text, decoder.InputOffset())
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, false
+		}
+
+		depth := len(path)
+		if expectsKey {
+			// object key, the value follows
+			path[depth-1] = token.(string)
+			expectsKey = false
+			continue
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			path = path[:depth-1]
+			isObject = isObject[:depth-1]
+			depth--
+		} else if depth <= len(tokens) && depth > bestDepth && matchesPointer(path, tokens) {
+			best, bestDepth = start, depth
+			if depth == len(tokens) {
+				return best, true
+			}
+		}
+
+		switch token {
+		case json.Delim('{'):
+			path = append(path, "")
+			isObject = append(isObject, true)
+		case json.Delim('['):
+			path = append(path, "-1")
+			isObject = append(isObject, false)
+		}
+
+		// next element of the current container
+		if len(path) > 0 {
+			if isObject[len(path)-1] {
+				expectsKey = decoder.More()
+			} else if decoder.More() {
+				index, _ := strconv.Atoi(path[len(path)-1])
+				path[len(path)-1] = strconv.Itoa(index + 1)
+			}
+		}
+	}
+
+	return best, best >= 0
+}
+
+func matchesPointer(path []string, tokens []string) bool {
+	for i := range path {
+		if path[i] != tokens[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// valueStart skips the spaces and separators before a value
+func valueStart(text []byte, offset int64) int64 {
+	for offset < int64(len(text)) {
+		switch text[offset] {
+		case ' ', '\t', '\n', '\r', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
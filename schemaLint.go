@@ -0,0 +1,279 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Finds contradictions and suspicious constructs in schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// LintFinding is a problem found in a schema by Lint
+type LintFinding struct {
+	// json pointer of the schema, ex: /properties/a
+	Path    string
+	Keyword string
+	Message string
+	// contradictions make the schema impossible to validate, other findings
+	// are only suspicious
+	Contradiction bool
+	// SEVERITY_ERROR for the contradictions, SEVERITY_WARNING for the others
+	Severity Severity
+}
+
+func (f LintFinding) String() string {
+	path := f.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s %s : %s", path, f.Keyword, f.Message)
+}
+
+// Lint checks the schema for contradictions ( minItems > maxItems, enum
+// values of the wrong type... ) making it impossible to validate, for
+// keywords that have no effect, and for examples and default values the
+// schema does not validate, the findings are sorted by path
+func (d *JsonSchemaDocument) Lint() []LintFinding {
+
+	options := d.getOptions().forBranch()
+	var findings []LintFinding
+	d.rootSchema.walk("", func(path string, schema *jsonSchema) bool {
+		findings = append(findings, schema.lint(path)...)
+		for _, example := range schema.invalidExamples(options) {
+			findings = append(findings, LintFinding{Path: path, Keyword: example.keyword, Message: example.message(), Severity: SEVERITY_WARNING})
+		}
+		return true
+	})
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Path < findings[j].Path
+	})
+	return findings
+}
+
+// lint returns the findings on a single schema, not its sub-schemas
+func (v *jsonSchema) lint(path string) []LintFinding {
+
+	var findings []LintFinding
+	add := func(keyword string, contradiction bool, message string, values ...interface{}) {
+		severity := SEVERITY_WARNING
+		if contradiction {
+			severity = SEVERITY_ERROR
+		}
+		findings = append(findings, LintFinding{Path: path, Keyword: keyword, Message: fmt.Sprintf(message, values...), Contradiction: contradiction, Severity: severity})
+	}
+
+	// bounds
+	if v.minimum != nil && v.maximum != nil && (*v.minimum > *v.maximum || *v.minimum == *v.maximum && (v.exclusiveMinimum || v.exclusiveMaximum)) {
+		add(KEY_MINIMUM, true, "no number is between minimum %v and maximum %v", *v.minimum, *v.maximum)
+	}
+	lintIntBounds := func(minKeyword string, min *int, maxKeyword string, max *int) {
+		if min != nil && max != nil && *min > *max {
+			add(minKeyword, true, "%s %d is above %s %d", minKeyword, *min, maxKeyword, *max)
+		}
+	}
+	lintIntBounds(KEY_MIN_LENGTH, v.minLength, KEY_MAX_LENGTH, v.maxLength)
+	lintIntBounds(KEY_MIN_ITEMS, v.minItems, KEY_MAX_ITEMS, v.maxItems)
+	lintIntBounds(KEY_MIN_PROPERTIES, v.minProperties, KEY_MAX_PROPERTIES, v.maxProperties)
+	if v.maxProperties != nil && len(v.required) > *v.maxProperties {
+		add(KEY_REQUIRED, true, "%d properties are required but maxProperties is %d", len(v.required), *v.maxProperties)
+	}
+
+	// enum values against the schema type
+	if v.types.HasTypeInSchema() && len(v.enum) > 0 {
+		valid := 0
+		for _, value := range v.enum {
+			var enumValue interface{}
+			if err := json.Unmarshal([]byte(value), &enumValue); err != nil {
+				continue
+			}
+			if v.allowsType(jsonTypeOf(enumValue)) {
+				valid++
+			} else {
+				add(KEY_ENUM, false, "enum value %s is not of type %s", value, v.types.String())
+			}
+		}
+		if valid == 0 {
+			add(KEY_ENUM, true, "no enum value is of type %s", v.types.String())
+		}
+	}
+
+	// required properties that cannot exist
+	if v.additionalProperties == false && len(v.patternProperties) == 0 {
+		for _, required := range v.required {
+			if !v.HasProperty(required) {
+				add(KEY_REQUIRED, true, "%s is required but not allowed by additionalProperties", required)
+			}
+		}
+	}
+
+	if v.not != nil && isEmptySchema(v.not) {
+		add(KEY_NOT, true, "not of an empty schema never validates")
+	}
+
+	// keywords with no effect on the declared types
+	if v.types.HasTypeInSchema() {
+		lintIgnored := func(jsonType string, keywords map[string]bool) {
+			if v.allowsType(jsonType) {
+				return
+			}
+			for _, keyword := range sortedKeys(keywords) {
+				if keywords[keyword] {
+					add(keyword, false, "%s only applies to %s values but the type is %s", keyword, jsonType, v.types.String())
+				}
+			}
+		}
+		lintIgnored(TYPE_STRING, map[string]bool{KEY_MIN_LENGTH: v.minLength != nil, KEY_MAX_LENGTH: v.maxLength != nil, KEY_PATTERN: v.pattern != nil, KEY_FORMAT: v.format != nil})
+		lintIgnored(TYPE_NUMBER, map[string]bool{KEY_MINIMUM: v.minimum != nil, KEY_MAXIMUM: v.maximum != nil, KEY_EXCLUSIVE_MINIMUM: v.exclusiveMinimumValue != nil, KEY_EXCLUSIVE_MAXIMUM: v.exclusiveMaximumValue != nil, KEY_MULTIPLE_OF: v.multipleOf != nil})
+		lintIgnored(TYPE_ARRAY, map[string]bool{KEY_ITEMS: len(v.itemsChildren) > 0, KEY_MIN_ITEMS: v.minItems != nil, KEY_MAX_ITEMS: v.maxItems != nil, KEY_UNIQUE_ITEMS: v.uniqueItems})
+		lintIgnored(TYPE_OBJECT, map[string]bool{KEY_PROPERTIES: len(v.propertiesChildren) > 0, KEY_REQUIRED: len(v.required) > 0, KEY_MIN_PROPERTIES: v.minProperties != nil, KEY_MAX_PROPERTIES: v.maxProperties != nil})
+	}
+
+	if len(v.oneOf) == 1 {
+		add(KEY_ONE_OF, false, "a single schema in oneOf can be used directly")
+	}
+	if len(v.anyOf) == 1 {
+		add(KEY_ANY_OF, false, "a single schema in anyOf can be used directly")
+	}
+
+	return findings
+}
+
+// allowsType tells if a value of the json type can be valid, integers being numbers
+func (v *jsonSchema) allowsType(jsonType string) bool {
+	if !v.types.HasTypeInSchema() || v.types.HasType(jsonType) {
+		return true
+	}
+	if jsonType == TYPE_INTEGER && v.types.HasType(TYPE_NUMBER) {
+		return true
+	}
+	// some numbers are integers
+	return jsonType == TYPE_NUMBER && v.types.HasType(TYPE_INTEGER)
+}
+
+// jsonTypeOf returns the json type of a decoded value
+func jsonTypeOf(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return TYPE_NULL
+	case bool:
+		return TYPE_BOOLEAN
+	case string:
+		return TYPE_STRING
+	case float64:
+		if isFloat64AnInteger(value) {
+			return TYPE_INTEGER
+		}
+		return TYPE_NUMBER
+	case []interface{}:
+		return TYPE_ARRAY
+	}
+	return TYPE_OBJECT
+}
+
+// isEmptySchema tells if a schema accepts everything
+func isEmptySchema(v *jsonSchema) bool {
+	return v.refSchema == nil && !v.types.HasTypeInSchema() &&
+		v.minimum == nil && v.maximum == nil && v.exclusiveMinimumValue == nil && v.exclusiveMaximumValue == nil && v.multipleOf == nil &&
+		v.minLength == nil && v.maxLength == nil && v.pattern == nil && v.format == nil &&
+		v.minItems == nil && v.maxItems == nil && !v.uniqueItems && len(v.itemsChildren) == 0 && v.additionalItems == nil &&
+		v.minProperties == nil && v.maxProperties == nil && len(v.required) == 0 && len(v.propertiesChildren) == 0 &&
+		len(v.patternProperties) == 0 && v.additionalProperties == nil && len(v.dependencies) == 0 &&
+		len(v.enum) == 0 && len(v.allOf) == 0 && len(v.anyOf) == 0 && len(v.oneOf) == 0 && v.not == nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// invalidExample is an example, or the default value, its schema does not validate
+type invalidExample struct {
+	keyword string
+	// index in the examples, -1 for the default value
+	index int
+	err   *ResultError
+}
+
+func (e invalidExample) message() string {
+	if e.index < 0 {
+		return fmt.Sprintf("the default value does not validate : %s", e.err.Error())
+	}
+	return fmt.Sprintf("example %d does not validate : %s", e.index, e.err.Error())
+}
+
+// invalidExamples validates the examples and the default value of a schema
+// against the schema, options must not modify the values
+func (v *jsonSchema) invalidExamples(options *validationOptions) []invalidExample {
+
+	var invalid []invalidExample
+	check := func(keyword string, index int, value interface{}, context *jsonContext) {
+		result := v.Validate(copyJson(value), context, options)
+		if !result.IsValid() {
+			invalid = append(invalid, invalidExample{keyword: keyword, index: index, err: result.GetErrors()[0]})
+		}
+	}
+
+	if v.hasDefault {
+		check(KEY_DEFAULT, -1, v.defaultValue, consJsonContext(KEY_DEFAULT, nil))
+	}
+	for i, example := range v.examples {
+		check(KEY_EXAMPLES, i, example, consJsonIndexContext(i, consJsonContext(KEY_EXAMPLES, nil)))
+	}
+	return invalid
+}
+
+// checkExamples returns the first example, or default value, of the schemas
+// that does not validate, as a schema error
+func (d *JsonSchemaDocument) checkExamples() error {
+
+	options := d.getOptions().forBranch()
+	var schemaError *SchemaError
+	d.rootSchema.walk("", func(path string, schema *jsonSchema) bool {
+		if schemaError != nil {
+			return false
+		}
+		if invalid := schema.invalidExamples(options); len(invalid) > 0 {
+			tokens := []string{invalid[0].keyword}
+			if invalid[0].index >= 0 {
+				tokens = append(tokens, strconv.Itoa(invalid[0].index))
+			}
+			schemaError = newSchemaError(schema, errors.New(invalid[0].message()), tokens...)
+		}
+		return schemaError == nil
+	})
+
+	if schemaError == nil {
+		return nil
+	}
+	schemaError.locate(d.pool)
+	return schemaError
+}
@@ -0,0 +1,122 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema linter.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"type":"object",
+		"additionalProperties":false,
+		"required":["id","other"],
+		"properties":{
+			"id":{"type":"integer","minimum":5,"maximum":5,"exclusiveMaximum":true},
+			"name":{"type":"string","minimum":1},
+			"kind":{"type":"string","enum":["a",1]},
+			"flag":{"type":"boolean","enum":[1,2]},
+			"never":{"not":{}},
+			"single":{"oneOf":[{"type":"string"}]}
+		},
+		"definitions":{"tags":{"minItems":2,"maxItems":1}}
+	}`)
+
+	expected := []string{
+		`/ required : other is required but not allowed by additionalProperties`,
+		`/definitions/tags minItems : minItems 2 is above maxItems 1`,
+		`/properties/flag enum : enum value 1 is not of type boolean`,
+		`/properties/flag enum : enum value 2 is not of type boolean`,
+		`/properties/flag enum : no enum value is of type boolean`,
+		`/properties/id minimum : no number is between minimum 5 and maximum 5`,
+		`/properties/kind enum : enum value 1 is not of type string`,
+		`/properties/name minimum : minimum only applies to number values but the type is string`,
+		`/properties/never not : not of an empty schema never validates`,
+		`/properties/single oneOf : a single schema in oneOf can be used directly`,
+	}
+
+	findings := schemaDocument.Lint()
+	if len(findings) != len(expected) {
+		for _, finding := range findings {
+			t.Log(finding.String())
+		}
+		t.Fatalf("Expects %d findings, given %d", len(expected), len(findings))
+	}
+	for i := range expected {
+		if findings[i].String() != expected[i] {
+			t.Errorf("Expects %q, given %q", expected[i], findings[i].String())
+		}
+	}
+
+	if findings := mustNewSchemaDocument(t, `{"type":"number","minimum":0,"enum":[1,2.5]}`).Lint(); len(findings) != 0 {
+		t.Errorf("Expects no finding, given %v", findings)
+	}
+}
+
+func TestLintExamples(t *testing.T) {
+
+	schema := `{
+		"properties": {
+			"age": {"type": "integer", "minimum": 0, "default": -1, "examples": [12, "twelve"]},
+			"tags": {"items": {"type": "string"}, "default": ["a"], "examples": [["b"], ["c", 1]]}
+		}
+	}`
+	schemaDocument := mustNewSchemaDocument(t, schema)
+
+	var findings []string
+	for _, finding := range schemaDocument.Lint() {
+		findings = append(findings, finding.String())
+	}
+	expected := []string{
+		"/properties/age default : the default value does not validate : default : age (-1) must be greater than 0",
+		"/properties/age examples : example 1 does not validate : examples.1 : age must be of type integer",
+		"/properties/tags examples : example 1 does not validate : examples.1.1 : items must be of type string",
+	}
+	if strings.Join(findings, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects findings :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(findings, "\n"))
+	}
+
+	// as schema errors
+	_, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, `{"type":"string","examples":["a",1]}`), SchemaOptions{CheckExamples: true})
+	var schemaError *SchemaError
+	if !errors.As(err, &schemaError) || schemaError.Pointer != "/examples/1" {
+		t.Errorf("Expects a schema error at /examples/1, given %v", err)
+	}
+	if _, err := NewJsonSchemaDocumentWithOptions(mustParseJson(t, `{"type":"string","examples":["a"],"default":"b"}`), SchemaOptions{CheckExamples: true}); err != nil {
+		t.Errorf("Expects valid examples to be accepted, given %s", err.Error())
+	}
+
+	// the defaults are not applied to the examples
+	schemaDocument = mustNewSchemaDocument(t, `{"properties":{"a":{"default":1}},"examples":[{}]}`)
+	schemaDocument.SetUseDefaults(true)
+	schemaDocument.Lint()
+	if example := schemaDocument.Root().Examples()[0].(map[string]interface{}); len(example) != 0 {
+		t.Errorf("Expects the examples to be left unchanged, given %v", example)
+	}
+}
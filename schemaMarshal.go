@@ -0,0 +1,278 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Serializes a compiled schema back to json.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON writes the compiled schema back to json, references within the
+// document are written as json pointers relative to the document
+func (d *JsonSchemaDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.rootSchema.toJson(documentUrl(d.documentReference)))
+}
+
+// MarshalJSON writes the schema back to json, references within the
+// document of the schema are written as json pointers relative to it
+func (n *SchemaNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.schema.toJson(n.Document()))
+}
+
+// toJson returns the schema as a json value, document is the url references are relative to
+func (v *jsonSchema) toJson(document string) map[string]interface{} {
+
+	m := make(map[string]interface{})
+
+	if v.schema != nil {
+		m[KEY_SCHEMA] = v.schema.String()
+	}
+	if v.refSchema != nil {
+		target := &SchemaNode{schema: v.refSchema}
+		if target.Document() == document {
+			m[KEY_REF] = "#" + target.Pointer()
+		} else {
+			m[KEY_REF] = target.Reference()
+		}
+	}
+	if v.id != nil {
+		m[KEY_ID] = *v.id
+	}
+	if v.comment != nil {
+		m[KEY_COMMENT] = *v.comment
+	}
+	if v.deprecated {
+		m[KEY_DEPRECATED] = true
+	}
+	if v.readOnly {
+		m[KEY_READ_ONLY] = true
+	}
+	if v.writeOnly {
+		m[KEY_WRITE_ONLY] = true
+	}
+	if v.intOrString {
+		m[KEY_INT_OR_STRING] = true
+	}
+	if v.preserveUnknownFields {
+		m[KEY_PRESERVE_UNKNOWN_FIELDS] = true
+	}
+	if v.title != nil {
+		m[KEY_TITLE] = *v.title
+	}
+	if v.description != nil {
+		m[KEY_DESCRIPTION] = *v.description
+	}
+	if v.hasDefault {
+		m[KEY_DEFAULT] = v.defaultValue
+	}
+	if v.examples != nil {
+		m[KEY_EXAMPLES] = v.examples
+	}
+
+	switch len(v.types.types) {
+	case 0:
+	case 1:
+		m[KEY_TYPE] = v.types.types[0]
+	default:
+		m[KEY_TYPE] = v.types.types
+	}
+
+	if len(v.definitions) > 0 {
+		m[KEY_DEFINITIONS] = schemasToJson(v.definitions, document)
+	}
+
+	// number / integer
+	if v.multipleOf != nil {
+		m[KEY_MULTIPLE_OF] = *v.multipleOf
+	}
+	if v.minimum != nil {
+		m[KEY_MINIMUM] = *v.minimum
+		if v.exclusiveMinimum {
+			m[KEY_EXCLUSIVE_MINIMUM] = true
+		}
+	}
+	if v.maximum != nil {
+		m[KEY_MAXIMUM] = *v.maximum
+		if v.exclusiveMaximum {
+			m[KEY_EXCLUSIVE_MAXIMUM] = true
+		}
+	}
+	if v.exclusiveMinimumValue != nil {
+		m[KEY_EXCLUSIVE_MINIMUM] = *v.exclusiveMinimumValue
+	}
+	if v.exclusiveMaximumValue != nil {
+		m[KEY_EXCLUSIVE_MAXIMUM] = *v.exclusiveMaximumValue
+	}
+	// written as in the schema, their float64 can be rounded
+	for keyword, exact := range v.exactNumbers {
+		m[keyword] = json.Number(exact.literal)
+	}
+
+	// string
+	if v.minLength != nil {
+		m[KEY_MIN_LENGTH] = *v.minLength
+	}
+	if v.maxLength != nil {
+		m[KEY_MAX_LENGTH] = *v.maxLength
+	}
+	if v.pattern != nil {
+		m[KEY_PATTERN] = v.pattern.String()
+	}
+	if v.format != nil {
+		m[KEY_FORMAT] = *v.format
+	}
+
+	// object
+	if v.minProperties != nil {
+		m[KEY_MIN_PROPERTIES] = *v.minProperties
+	}
+	if v.maxProperties != nil {
+		m[KEY_MAX_PROPERTIES] = *v.maxProperties
+	}
+	if len(v.required) > 0 {
+		m[KEY_REQUIRED] = v.required
+	}
+	if len(v.propertiesChildren) > 0 {
+		properties := make(map[string]interface{}, len(v.propertiesChildren))
+		for _, property := range v.propertiesChildren {
+			properties[property.property] = property.toJson(document)
+		}
+		m[KEY_PROPERTIES] = properties
+	}
+	if len(v.patternProperties) > 0 {
+		m[KEY_PATTERN_PROPERTIES] = schemasToJson(v.patternProperties, document)
+	}
+	if additionalProperties := additionalToJson(v.additionalProperties, document); additionalProperties != nil {
+		m[KEY_ADDITIONAL_PROPERTIES] = additionalProperties
+	}
+	if len(v.dependencies) > 0 {
+		dependencies := make(map[string]interface{}, len(v.dependencies))
+		for k, dependency := range v.dependencies {
+			switch dependency := dependency.(type) {
+			case *jsonSchema:
+				dependencies[k] = dependency.toJson(document)
+			default:
+				dependencies[k] = dependency
+			}
+		}
+		m[KEY_DEPENDENCIES] = dependencies
+	}
+
+	// array
+	if len(v.itemsChildren) > 0 {
+		if v.itemsChildrenIsSingleSchema {
+			m[KEY_ITEMS] = v.itemsChildren[0].toJson(document)
+		} else {
+			m[KEY_ITEMS] = schemaListToJson(v.itemsChildren, document)
+		}
+	}
+	if additionalItems := additionalToJson(v.additionalItems, document); additionalItems != nil {
+		m[KEY_ADDITIONAL_ITEMS] = additionalItems
+	}
+	if v.minItems != nil {
+		m[KEY_MIN_ITEMS] = *v.minItems
+	}
+	if v.maxItems != nil {
+		m[KEY_MAX_ITEMS] = *v.maxItems
+	}
+	if v.uniqueItems {
+		m[KEY_UNIQUE_ITEMS] = true
+	}
+
+	// all
+	if len(v.enum) > 0 {
+		enum := make([]json.RawMessage, len(v.enum))
+		for i, value := range v.enum {
+			enum[i] = json.RawMessage(value)
+		}
+		m[KEY_ENUM] = enum
+	}
+
+	// schema
+	if len(v.allOf) > 0 {
+		m[KEY_ALL_OF] = schemaListToJson(v.allOf, document)
+	}
+	if len(v.anyOf) > 0 {
+		m[KEY_ANY_OF] = schemaListToJson(v.anyOf, document)
+	}
+	if len(v.oneOf) > 0 {
+		m[KEY_ONE_OF] = schemaListToJson(v.oneOf, document)
+	}
+	if v.not != nil {
+		m[KEY_NOT] = v.not.toJson(document)
+	}
+
+	if v.discriminator != nil {
+		m[KEY_DISCRIMINATOR] = v.discriminator.toJson(v.oneOf, document)
+	}
+
+	return m
+}
+
+// toJson returns the discriminator as a json value, the mapping is written
+// with the $ref of the oneOf schemas
+func (d *jsonSchemaDiscriminator) toJson(oneOf []*jsonSchema, document string) map[string]interface{} {
+
+	m := map[string]interface{}{KEY_PROPERTY_NAME: d.propertyName}
+
+	mapping := make(map[string]interface{})
+	for value, i := range d.mapping {
+		if ref, ok := oneOf[i].toJson(document)[KEY_REF].(string); ok {
+			mapping[value] = ref
+		}
+	}
+	if len(mapping) > 0 {
+		m[KEY_MAPPING] = mapping
+	}
+
+	return m
+}
+
+// additionalToJson returns additionalProperties or additionalItems as a json value, nil when not set
+func additionalToJson(additional interface{}, document string) interface{} {
+	switch additional := additional.(type) {
+	case bool:
+		return additional
+	case *jsonSchema:
+		return additional.toJson(document)
+	}
+	return nil
+}
+
+func schemasToJson(schemas map[string]*jsonSchema, document string) map[string]interface{} {
+	m := make(map[string]interface{}, len(schemas))
+	for k, schema := range schemas {
+		m[k] = schema.toJson(document)
+	}
+	return m
+}
+
+func schemaListToJson(schemas []*jsonSchema, document string) []interface{} {
+	list := make([]interface{}, len(schemas))
+	for i, schema := range schemas {
+		list[i] = schema.toJson(document)
+	}
+	return list
+}
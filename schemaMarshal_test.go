@@ -0,0 +1,86 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the serialization of compiled schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalSchema(t *testing.T) {
+
+	schema := `{
+		"$schema":"http://json-schema.org/draft-04/schema",
+		"title":"Pet",
+		"examples":[{"kind":"cat","lives":9}],
+		"definitions":{
+			"cat":{"type":"object","properties":{"kind":{"enum":["cat"]},"lives":{"type":"integer","minimum":0,"maximum":9,"exclusiveMaximum":true}}},
+			"dog":{"type":"object","properties":{"kind":{"enum":["dog"]},"name":{"type":["string","null"],"pattern":"^[A-Z]","default":null}},"additionalProperties":false}
+		},
+		"oneOf":[{"$ref":"#/definitions/cat"},{"$ref":"#/definitions/dog"}],
+		"discriminator":{"propertyName":"kind","mapping":{"feline":"#/definitions/cat"}},
+		"dependencies":{"a":["b"],"c":{"required":["d"]}},
+		"items":[{"format":"email"}],
+		"additionalItems":{"uniqueItems":true,"minItems":1}
+	}`
+
+	// internal references can only be resolved on a schema loaded from a file
+	schemaFile := filepath.Join(t.TempDir(), "marshal.json")
+	if err := os.WriteFile(schemaFile, []byte(schema), 0644); err != nil {
+		t.Fatalf("Could not write schema : %s", err.Error())
+	}
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions("file://"+filepath.ToSlash(schemaFile), SchemaOptions{Dialect: DIALECT_OPENAPI_3_0})
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	marshalled, err := json.Marshal(schemaDocument)
+	if err != nil {
+		t.Fatalf("Could not marshal schema : %s", err.Error())
+	}
+
+	var expected, given map[string]interface{}
+	json.Unmarshal([]byte(schema), &expected)
+	json.Unmarshal(marshalled, &given)
+
+	// the implicit mapping of the discriminator is written out
+	expected["discriminator"].(map[string]interface{})["mapping"].(map[string]interface{})["cat"] = "#/definitions/cat"
+	expected["discriminator"].(map[string]interface{})["mapping"].(map[string]interface{})["dog"] = "#/definitions/dog"
+
+	if !reflect.DeepEqual(expected, given) {
+		t.Errorf("Expects :\n%s\ngiven :\n%s", schema, string(marshalled))
+	}
+
+	// a single node, references are relative to its document
+	node, err := json.Marshal(schemaDocument.Root().OneOf()[1])
+	if err != nil || string(node) != `{"$ref":"#/definitions/dog"}` {
+		t.Errorf("Unexpected marshalled node %s", string(node))
+	}
+}
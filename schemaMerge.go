@@ -0,0 +1,392 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Folds allOf sub-schemas into their parent schema.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// MergeAllOf returns a copy of a json schema where the allOf sub-schemas are
+// folded into their parent when their constraints can be combined : bounds
+// are intersected, required unioned, types and enums intersected, properties
+// merged recursively. Sub-schemas that cannot be folded, like references or
+// contradicting ones, are left in allOf
+func MergeAllOf(schema map[string]interface{}) (map[string]interface{}, error) {
+	merged, err := mergeAllOfRecursive(schema)
+	if err != nil {
+		return nil, err
+	}
+	return merged.(map[string]interface{}), nil
+}
+
+func mergeAllOfRecursive(node interface{}) (interface{}, error) {
+
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, STRING_SCHEMA, STRING_OBJECT))
+	}
+
+	merged := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		var err error
+		merged[key], err = mergeAllOfChildren(key, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allOf, ok := merged[KEY_ALL_OF].([]interface{})
+	if !ok {
+		return merged, nil
+	}
+
+	// the keywords next to a $ref are ignored, nothing can be folded
+	_, parentHasRef := merged[KEY_REF]
+
+	var remaining []interface{}
+	for _, branch := range allOf {
+		branchSchema, ok := branch.(map[string]interface{})
+		if !ok {
+			return nil, errors.New(fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, KEY_ALL_OF, STRING_ARRAY_OF_SCHEMAS))
+		}
+		if _, hasRef := branchSchema[KEY_REF]; !hasRef && !parentHasRef {
+			if folded, ok := mergeSchemas(merged, branchSchema); ok {
+				merged = folded
+				continue
+			}
+		}
+		remaining = append(remaining, branch)
+	}
+
+	if len(remaining) == 0 {
+		delete(merged, KEY_ALL_OF)
+	} else {
+		merged[KEY_ALL_OF] = remaining
+	}
+	return merged, nil
+}
+
+// mergeAllOfChildren merges the allOf of the sub-schemas held by a keyword
+func mergeAllOfChildren(key string, value interface{}) (interface{}, error) {
+
+	switch key {
+
+	case KEY_PROPERTIES, KEY_PATTERN_PROPERTIES, KEY_DEFINITIONS, KEY_DEPENDENCIES:
+		if m, ok := value.(map[string]interface{}); ok {
+			children := make(map[string]interface{}, len(m))
+			for name, child := range m {
+				if _, isSchema := child.(map[string]interface{}); !isSchema {
+					children[name] = copyJson(child)
+					continue
+				}
+				mergedChild, err := mergeAllOfRecursive(child)
+				if err != nil {
+					return nil, err
+				}
+				children[name] = mergedChild
+			}
+			return children, nil
+		}
+
+	case KEY_ITEMS, KEY_ALL_OF, KEY_ANY_OF, KEY_ONE_OF:
+		if a, ok := value.([]interface{}); ok {
+			children := make([]interface{}, len(a))
+			for i, child := range a {
+				mergedChild, err := mergeAllOfRecursive(child)
+				if err != nil {
+					return nil, err
+				}
+				children[i] = mergedChild
+			}
+			return children, nil
+		}
+		if _, ok := value.(map[string]interface{}); ok {
+			return mergeAllOfRecursive(value)
+		}
+
+	case KEY_ADDITIONAL_PROPERTIES, KEY_ADDITIONAL_ITEMS, KEY_NOT:
+		if _, ok := value.(map[string]interface{}); ok {
+			return mergeAllOfRecursive(value)
+		}
+	}
+
+	return copyJson(value), nil
+}
+
+// mergeSchemas combines the constraints of two schemas in a new one,
+// ok is false when they cannot be expressed by a single schema
+func mergeSchemas(a map[string]interface{}, b map[string]interface{}) (merged map[string]interface{}, ok bool) {
+
+	// additional properties depend on the properties of the same schema
+	if constrainsAdditionalProperties(a) && declaresProperties(b) || constrainsAdditionalProperties(b) && declaresProperties(a) {
+		return nil, false
+	}
+	// so do the additional items on the items of the same schema
+	if constrainsAdditionalItems(a) && declaresTupleItems(b) || constrainsAdditionalItems(b) && declaresTupleItems(a) {
+		return nil, false
+	}
+
+	merged = copyJson(a).(map[string]interface{})
+
+	for key, bValue := range b {
+
+		// merged with their bound
+		if key == KEY_EXCLUSIVE_MINIMUM || key == KEY_EXCLUSIVE_MAXIMUM {
+			continue
+		}
+
+		aValue, exists := merged[key]
+		if !exists {
+			merged[key] = copyJson(bValue)
+			if exclusiveKey, isBound := boundExclusiveKeys[key]; isBound && b[exclusiveKey] == true {
+				merged[exclusiveKey] = true
+			}
+			continue
+		}
+
+		switch key {
+
+		// annotations, the parent ones are kept
+		case KEY_TITLE, KEY_DESCRIPTION, KEY_DEFAULT, KEY_EXAMPLES, KEY_COMMENT, KEY_DEPRECATED:
+			continue
+
+		case KEY_MINIMUM:
+			if !mergeBound(merged, b, KEY_MINIMUM, KEY_EXCLUSIVE_MINIMUM, 1) {
+				return nil, false
+			}
+			continue
+		case KEY_MAXIMUM:
+			if !mergeBound(merged, b, KEY_MAXIMUM, KEY_EXCLUSIVE_MAXIMUM, -1) {
+				return nil, false
+			}
+			continue
+		case KEY_MIN_LENGTH, KEY_MIN_ITEMS, KEY_MIN_PROPERTIES:
+			aNumber, aOk := aValue.(float64)
+			bNumber, bOk := bValue.(float64)
+			if !aOk || !bOk {
+				return nil, false
+			}
+			if bNumber > aNumber {
+				merged[key] = bNumber
+			}
+			continue
+		case KEY_MAX_LENGTH, KEY_MAX_ITEMS, KEY_MAX_PROPERTIES:
+			aNumber, aOk := aValue.(float64)
+			bNumber, bOk := bValue.(float64)
+			if !aOk || !bOk {
+				return nil, false
+			}
+			if bNumber < aNumber {
+				merged[key] = bNumber
+			}
+			continue
+
+		case KEY_UNIQUE_ITEMS:
+			merged[key] = aValue == true || bValue == true
+			continue
+
+		case KEY_REQUIRED:
+			merged[key] = unionStrings(aValue, bValue)
+			continue
+
+		case KEY_TYPE:
+			types := intersectTypes(aValue, bValue)
+			if len(types) == 0 {
+				return nil, false
+			}
+			if len(types) == 1 {
+				merged[key] = types[0]
+			} else {
+				merged[key] = types
+			}
+			continue
+
+		case KEY_ENUM:
+			aEnum, aOk := aValue.([]interface{})
+			bEnum, bOk := bValue.([]interface{})
+			if !aOk || !bOk {
+				return nil, false
+			}
+			var enum []interface{}
+			for _, aElement := range aEnum {
+				for _, bElement := range bEnum {
+					if equal, err := jsonEquals(aElement, bElement); err == nil && equal {
+						enum = append(enum, aElement)
+						break
+					}
+				}
+			}
+			if len(enum) == 0 {
+				return nil, false
+			}
+			merged[key] = enum
+			continue
+
+		case KEY_PROPERTIES:
+			aProperties, aOk := aValue.(map[string]interface{})
+			bProperties, bOk := bValue.(map[string]interface{})
+			if !aOk || !bOk {
+				return nil, false
+			}
+			for name, bProperty := range bProperties {
+				aProperty, exists := aProperties[name]
+				if !exists {
+					aProperties[name] = copyJson(bProperty)
+					continue
+				}
+				aPropertySchema, aOk := aProperty.(map[string]interface{})
+				bPropertySchema, bOk := bProperty.(map[string]interface{})
+				if !aOk || !bOk {
+					return nil, false
+				}
+				_, aHasRef := aPropertySchema[KEY_REF]
+				_, bHasRef := bPropertySchema[KEY_REF]
+				if aHasRef || bHasRef {
+					return nil, false
+				}
+				mergedProperty, ok := mergeSchemas(aPropertySchema, bPropertySchema)
+				if !ok {
+					return nil, false
+				}
+				aProperties[name] = mergedProperty
+			}
+			continue
+		}
+
+		// other keywords can only be merged when they are the same
+		if !reflect.DeepEqual(aValue, bValue) {
+			return nil, false
+		}
+	}
+
+	return merged, true
+}
+
+var boundExclusiveKeys = map[string]string{
+	KEY_MINIMUM: KEY_EXCLUSIVE_MINIMUM,
+	KEY_MAXIMUM: KEY_EXCLUSIVE_MAXIMUM,
+}
+
+// mergeBound keeps the most restrictive of the bounds of merged and b,
+// direction is 1 for minimums and -1 for maximums
+func mergeBound(merged map[string]interface{}, b map[string]interface{}, boundKey string, exclusiveKey string, direction float64) bool {
+
+	aBound, aOk := merged[boundKey].(float64)
+	bBound, bOk := b[boundKey].(float64)
+	if !aOk || !bOk {
+		return false
+	}
+	aExclusive := merged[exclusiveKey] == true
+	bExclusive := b[exclusiveKey] == true
+
+	switch {
+	case bBound*direction > aBound*direction:
+		merged[boundKey] = bBound
+		aExclusive = bExclusive
+	case bBound == aBound:
+		aExclusive = aExclusive || bExclusive
+	}
+
+	if aExclusive {
+		merged[exclusiveKey] = true
+	} else {
+		delete(merged, exclusiveKey)
+	}
+	return true
+}
+
+func constrainsAdditionalProperties(schema map[string]interface{}) bool {
+	additionalProperties, exists := schema[KEY_ADDITIONAL_PROPERTIES]
+	return exists && additionalProperties != true
+}
+
+func declaresProperties(schema map[string]interface{}) bool {
+	_, hasProperties := schema[KEY_PROPERTIES]
+	_, hasPatternProperties := schema[KEY_PATTERN_PROPERTIES]
+	return hasProperties || hasPatternProperties
+}
+
+func constrainsAdditionalItems(schema map[string]interface{}) bool {
+	additionalItems, exists := schema[KEY_ADDITIONAL_ITEMS]
+	return exists && additionalItems != true
+}
+
+func declaresTupleItems(schema map[string]interface{}) bool {
+	_, isTuple := schema[KEY_ITEMS].([]interface{})
+	return isTuple
+}
+
+// unionStrings merges two arrays of strings, without duplicates
+func unionStrings(a interface{}, b interface{}) []interface{} {
+	var union []interface{}
+	for _, array := range []interface{}{a, b} {
+		elements, _ := array.([]interface{})
+		for _, element := range elements {
+			if !isValueInSlice(union, element) {
+				union = append(union, element)
+			}
+		}
+	}
+	return union
+}
+
+func isValueInSlice(slice []interface{}, element interface{}) bool {
+	for _, e := range slice {
+		if e == element {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectTypes returns the types allowed by both type keywords,
+// integer being a subset of number
+func intersectTypes(a interface{}, b interface{}) []interface{} {
+
+	toTypes := func(value interface{}) []interface{} {
+		if array, ok := value.([]interface{}); ok {
+			return array
+		}
+		return []interface{}{value}
+	}
+	aTypes := toTypes(a)
+	bTypes := toTypes(b)
+
+	var types []interface{}
+	for _, aType := range aTypes {
+		switch {
+		case isValueInSlice(bTypes, aType):
+			types = append(types, aType)
+		case aType == TYPE_INTEGER && isValueInSlice(bTypes, TYPE_NUMBER):
+			types = append(types, aType)
+		case aType == TYPE_NUMBER && isValueInSlice(bTypes, TYPE_INTEGER):
+			types = append(types, TYPE_INTEGER)
+		}
+	}
+	return types
+}
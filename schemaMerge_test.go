@@ -0,0 +1,78 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the allOf merge.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestMergeAllOf(t *testing.T) {
+
+	tests := []struct {
+		schema   string
+		expected string
+	}{
+		{
+			`{"type":"object","required":["a"],"allOf":[{"required":["b","a"],"properties":{"a":{"minimum":1}}},{"properties":{"a":{"maximum":5,"minimum":3,"exclusiveMinimum":true}}}]}`,
+			`{"properties":{"a":{"exclusiveMinimum":true,"maximum":5,"minimum":3}},"required":["a","b"],"type":"object"}`,
+		},
+		{
+			`{"type":["number","string"],"minLength":2,"allOf":[{"type":"integer","maxLength":10,"minLength":4},{"enum":[1,2,"a"]},{"enum":[2,"a",3]}]}`,
+			`{"enum":[2,"a"],"maxLength":10,"minLength":4,"type":"integer"}`,
+		},
+		{
+			`{"allOf":[{"$ref":"#/definitions/a"},{"type":"string"},{"type":"number"}]}`,
+			`{"allOf":[{"$ref":"#/definitions/a"},{"type":"number"}],"type":"string"}`,
+		},
+		{
+			`{"additionalProperties":false,"allOf":[{"properties":{"a":{}}},{"pattern":"^a"},{"pattern":"^b"}]}`,
+			`{"additionalProperties":false,"allOf":[{"properties":{"a":{}}},{"pattern":"^b"}],"pattern":"^a"}`,
+		},
+		{
+			`{"properties":{"a":{"allOf":[{"maximum":3},{"maximum":2}]}},"items":[{"allOf":[{"uniqueItems":true}]}]}`,
+			`{"items":[{"uniqueItems":true}],"properties":{"a":{"maximum":2}}}`,
+		},
+		{
+			`{"allOf":[{"items":[{}]},{"additionalItems":false}]}`,
+			`{"allOf":[{"additionalItems":false}],"items":[{}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		merged, err := MergeAllOf(mustParseJson(t, test.schema).(map[string]interface{}))
+		if err != nil {
+			t.Errorf("Could not merge %s : %s", test.schema, err.Error())
+			continue
+		}
+		if given := *mustMarshalToString(t, merged); given != test.expected {
+			t.Errorf("Expects %s for %s, given %s", test.expected, test.schema, given)
+		}
+	}
+
+	if _, err := MergeAllOf(mustParseJson(t, `{"allOf":[1]}`).(map[string]interface{})); err == nil {
+		t.Errorf("Expects an allOf of numbers to be an error")
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Read-only view of compiled schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+)
+
+// SchemaNode is a read-only view of a compiled schema
+type SchemaNode struct {
+	schema *jsonSchema
+}
+
+// Pointer returns the json pointer of the schema in its document
+func (n *SchemaNode) Pointer() string {
+	return n.schema.pointer
+}
+
+// Document returns the url of the document of the schema, empty for a schema given as json
+func (n *SchemaNode) Document() string {
+	if n.schema.ref == nil {
+		return ""
+	}
+	return documentUrl(*n.schema.ref)
+}
+
+// Reference returns the resolved $ref of the schema, empty when it has none
+func (n *SchemaNode) Reference() string {
+	if n.schema.refSchema == nil {
+		return ""
+	}
+	target := &SchemaNode{schema: n.schema.refSchema}
+	return target.Document() + "#" + target.Pointer()
+}
+
+// Root returns the root schema of the document
+func (d *JsonSchemaDocument) Root() *SchemaNode {
+	return &SchemaNode{schema: d.rootSchema}
+}
+
+// Resolve returns the schema referenced by $ref, or the schema itself
+func (n *SchemaNode) Resolve() *SchemaNode {
+	schema := n.schema
+	visited := map[*jsonSchema]bool{}
+	for schema.refSchema != nil && !visited[schema] {
+		visited[schema] = true
+		schema = schema.refSchema
+	}
+	return &SchemaNode{schema: schema}
+}
+
+func (n *SchemaNode) Title() string {
+	if n.schema.title == nil {
+		return ""
+	}
+	return *n.schema.title
+}
+
+func (n *SchemaNode) Description() string {
+	if n.schema.description == nil {
+		return ""
+	}
+	return *n.schema.description
+}
+
+// Comment returns the $comment of the schema, a note to its maintainers
+func (n *SchemaNode) Comment() string {
+	if n.schema.comment == nil {
+		return ""
+	}
+	return *n.schema.comment
+}
+
+// Deprecated tells whether the values of the schema are reported as warnings
+func (n *SchemaNode) Deprecated() bool {
+	return n.schema.deprecated
+}
+
+// IntOrString tells whether the schema has x-kubernetes-int-or-string
+func (n *SchemaNode) IntOrString() bool {
+	return n.schema.intOrString
+}
+
+// PreservesUnknownFields tells whether the schema has x-kubernetes-preserve-unknown-fields
+func (n *SchemaNode) PreservesUnknownFields() bool {
+	return n.schema.preserveUnknownFields
+}
+
+// Annotations returns the annotation keywords of the schema ( title,
+// description, default, examples, $comment, deprecated, readOnly and
+// writeOnly ) with their values
+func (n *SchemaNode) Annotations() map[string]interface{} {
+	annotations := make(map[string]interface{})
+	if n.schema.title != nil {
+		annotations[KEY_TITLE] = *n.schema.title
+	}
+	if n.schema.description != nil {
+		annotations[KEY_DESCRIPTION] = *n.schema.description
+	}
+	if n.schema.hasDefault {
+		annotations[KEY_DEFAULT] = copyJson(n.schema.defaultValue)
+	}
+	if n.schema.examples != nil {
+		annotations[KEY_EXAMPLES] = copyJson(n.schema.examples)
+	}
+	if n.schema.comment != nil {
+		annotations[KEY_COMMENT] = *n.schema.comment
+	}
+	if n.schema.deprecated {
+		annotations[KEY_DEPRECATED] = true
+	}
+	if n.schema.readOnly {
+		annotations[KEY_READ_ONLY] = true
+	}
+	if n.schema.writeOnly {
+		annotations[KEY_WRITE_ONLY] = true
+	}
+	return annotations
+}
+
+// Default returns the default value, ok is false when there is none
+func (n *SchemaNode) Default() (value interface{}, ok bool) {
+	return copyJson(n.schema.defaultValue), n.schema.hasDefault
+}
+
+// Examples returns a copy of the examples, nil when there are none
+func (n *SchemaNode) Examples() []interface{} {
+	if n.schema.examples == nil {
+		return nil
+	}
+	return copyJson(n.schema.examples).([]interface{})
+}
+
+// Types returns the declared types, empty when any type is allowed
+func (n *SchemaNode) Types() []string {
+	return append([]string(nil), n.schema.types.types...)
+}
+
+// Properties returns the schemas of the declared properties by name
+func (n *SchemaNode) Properties() map[string]*SchemaNode {
+	properties := make(map[string]*SchemaNode, len(n.schema.propertiesChildren))
+	for _, property := range n.schema.propertiesChildren {
+		properties[property.property] = &SchemaNode{schema: property}
+	}
+	return properties
+}
+
+// PatternProperties returns the schemas of patternProperties by pattern
+func (n *SchemaNode) PatternProperties() map[string]*SchemaNode {
+	patternProperties := make(map[string]*SchemaNode, len(n.schema.patternProperties))
+	for pattern, schema := range n.schema.patternProperties {
+		patternProperties[pattern] = &SchemaNode{schema: schema}
+	}
+	return patternProperties
+}
+
+// AdditionalProperties returns whether additional properties are allowed and
+// their schema when there is one
+func (n *SchemaNode) AdditionalProperties() (allowed bool, schema *SchemaNode) {
+	switch additionalProperties := n.schema.additionalProperties.(type) {
+	case bool:
+		return additionalProperties, nil
+	case *jsonSchema:
+		return true, &SchemaNode{schema: additionalProperties}
+	}
+	return true, nil
+}
+
+// Items returns the schemas of items, tuple is true when each item has its own schema
+func (n *SchemaNode) Items() (items []*SchemaNode, tuple bool) {
+	for _, item := range n.schema.itemsChildren {
+		items = append(items, &SchemaNode{schema: item})
+	}
+	return items, len(items) > 0 && !n.schema.itemsChildrenIsSingleSchema
+}
+
+func (n *SchemaNode) AllOf() []*SchemaNode {
+	return newSchemaNodes(n.schema.allOf)
+}
+
+func (n *SchemaNode) AnyOf() []*SchemaNode {
+	return newSchemaNodes(n.schema.anyOf)
+}
+
+func (n *SchemaNode) OneOf() []*SchemaNode {
+	return newSchemaNodes(n.schema.oneOf)
+}
+
+// Not returns the schema of not, nil when there is none
+func (n *SchemaNode) Not() *SchemaNode {
+	if n.schema.not == nil {
+		return nil
+	}
+	return &SchemaNode{schema: n.schema.not}
+}
+
+func newSchemaNodes(schemas []*jsonSchema) []*SchemaNode {
+	var nodes []*SchemaNode
+	for _, schema := range schemas {
+		nodes = append(nodes, &SchemaNode{schema: schema})
+	}
+	return nodes
+}
+
+// SchemaConstraints are the validation keywords of a schema, nil or empty
+// values are keywords that are not declared
+type SchemaConstraints struct {
+	// number
+	MultipleOf       *float64
+	Minimum          *float64
+	ExclusiveMinimum bool
+	Maximum          *float64
+	ExclusiveMaximum bool
+	// draft-06 exclusiveMinimum / exclusiveMaximum, given as numbers
+	ExclusiveMinimumValue *float64
+	ExclusiveMaximumValue *float64
+
+	// string
+	MinLength *int
+	MaxLength *int
+	Pattern   string
+	Format    string
+
+	// array
+	MinItems    *int
+	MaxItems    *int
+	UniqueItems bool
+
+	// object
+	MinProperties *int
+	MaxProperties *int
+	Required      []string
+
+	// all
+	Enum []interface{}
+}
+
+// Constraints returns a copy of the validation keywords of the schema
+func (n *SchemaNode) Constraints() SchemaConstraints {
+
+	s := n.schema
+	constraints := SchemaConstraints{
+		MultipleOf:       copyFloat64(s.multipleOf),
+		Minimum:          copyFloat64(s.minimum),
+		ExclusiveMinimum: s.exclusiveMinimum,
+		Maximum:          copyFloat64(s.maximum),
+		ExclusiveMaximum: s.exclusiveMaximum,
+		MinLength:        copyInt(s.minLength),
+		MaxLength:        copyInt(s.maxLength),
+		MinItems:         copyInt(s.minItems),
+		MaxItems:         copyInt(s.maxItems),
+		UniqueItems:      s.uniqueItems,
+		MinProperties:    copyInt(s.minProperties),
+		MaxProperties:    copyInt(s.maxProperties),
+		Required:         append([]string(nil), s.required...),
+	}
+	constraints.ExclusiveMinimumValue = copyFloat64(s.exclusiveMinimumValue)
+	constraints.ExclusiveMaximumValue = copyFloat64(s.exclusiveMaximumValue)
+	if s.pattern != nil {
+		constraints.Pattern = s.pattern.String()
+	}
+	if s.format != nil {
+		constraints.Format = *s.format
+	}
+	for _, value := range s.enum {
+		var enumValue interface{}
+		if err := json.Unmarshal([]byte(value), &enumValue); err == nil {
+			constraints.Enum = append(constraints.Enum, enumValue)
+		}
+	}
+
+	return constraints
+}
+
+func copyFloat64(f *float64) *float64 {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	return &c
+}
+
+func copyInt(i *int) *int {
+	if i == nil {
+		return nil
+	}
+	c := *i
+	return &c
+}
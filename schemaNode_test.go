@@ -0,0 +1,142 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the read-only view of schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSchemaNode(t *testing.T) {
+
+	schemaDocument, err := NewJsonSchemaDocument(map[string]interface{}{
+		"title":       "Person",
+		"description": "A person",
+		"type":        "object",
+		"required":    []interface{}{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "minLength": 1.0, "pattern": "^[A-Z]"},
+			"age":  map[string]interface{}{"type": []interface{}{"integer", "null"}, "minimum": 0.0, "default": 18.0},
+			"tags": map[string]interface{}{"items": map[string]interface{}{"enum": []interface{}{"a", "b"}}, "uniqueItems": true},
+		},
+		"additionalProperties": false,
+	})
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	root := schemaDocument.Root()
+	if root.Title() != "Person" || root.Description() != "A person" {
+		t.Errorf("Unexpected title %q and description %q", root.Title(), root.Description())
+	}
+	if !reflect.DeepEqual(root.Types(), []string{"object"}) {
+		t.Errorf("Unexpected types %v", root.Types())
+	}
+	if !reflect.DeepEqual(root.Constraints().Required, []string{"name"}) {
+		t.Errorf("Unexpected required %v", root.Constraints().Required)
+	}
+	if allowed, schema := root.AdditionalProperties(); allowed || schema != nil {
+		t.Errorf("Expects additional properties not to be allowed")
+	}
+
+	properties := root.Properties()
+	if len(properties) != 3 {
+		t.Fatalf("Expects 3 properties, given %d", len(properties))
+	}
+
+	name := properties["name"].Constraints()
+	if name.MinLength == nil || *name.MinLength != 1 || name.Pattern != "^[A-Z]" || name.MaxLength != nil {
+		t.Errorf("Unexpected constraints of name %+v", name)
+	}
+
+	age := properties["age"]
+	if !reflect.DeepEqual(age.Types(), []string{"integer", "null"}) {
+		t.Errorf("Unexpected types of age %v", age.Types())
+	}
+	if value, ok := age.Default(); !ok || value != 18.0 {
+		t.Errorf("Unexpected default of age %v", value)
+	}
+	if age.Pointer() != "/properties/age" {
+		t.Errorf("Unexpected pointer of age %q", age.Pointer())
+	}
+
+	items, tuple := properties["tags"].Items()
+	if len(items) != 1 || tuple {
+		t.Fatalf("Expects a single items schema")
+	}
+	if !reflect.DeepEqual(items[0].Constraints().Enum, []interface{}{"a", "b"}) {
+		t.Errorf("Unexpected enum of tags items %v", items[0].Constraints().Enum)
+	}
+
+	// the view is read-only
+	*name.MinLength = 5
+	if *properties["name"].Constraints().MinLength != 1 {
+		t.Errorf("Expects constraints to be a copy")
+	}
+}
+
+func TestSchemaNodeAnnotations(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"$comment": "kept in sync with the orders table",
+		"title": "Order",
+		"properties": {"status": {"$comment": "see the state machine", "default": "new", "examples": ["new", "paid"]}}
+	}`)
+
+	root := schemaDocument.Root()
+	if root.Comment() != "kept in sync with the orders table" {
+		t.Errorf("Expects the comment of the root, given '%s'", root.Comment())
+	}
+	expected := map[string]interface{}{KEY_COMMENT: "see the state machine", KEY_DEFAULT: "new", KEY_EXAMPLES: []interface{}{"new", "paid"}}
+	if annotations := root.Properties()["status"].Annotations(); !reflect.DeepEqual(annotations, expected) {
+		t.Errorf("Expects the annotations %v, given %v", expected, annotations)
+	}
+
+	// the comments are kept by the serializations
+	text, err := json.Marshal(schemaDocument)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reparsed := mustNewSchemaDocument(t, string(text)); reparsed.Root().Comment() != root.Comment() {
+		t.Errorf("Expects the comment to be marshaled, given %s", text)
+	}
+	data, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Root().Properties()["status"].Comment() != "see the state machine" {
+		t.Errorf("Expects the comment to be exported")
+	}
+
+	if _, err := NewJsonSchemaDocument(mustParseJson(t, `{"$comment": 1}`)); err == nil {
+		t.Errorf("Expects a $comment that is not a string to be refused")
+	}
+}
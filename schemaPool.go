@@ -27,17 +27,40 @@
 package gojsonschema
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/sigu-399/gojsonreference"
-	"io/ioutil"
+	"io/fs"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type schemaPool struct {
 	schemaPoolDocuments map[string]*schemaPoolDocument
+
+	// directory of the disk cache of the remote documents, no cache when empty
+	cacheDirectory string
+
+	// receives the loaded documents, no logs when nil
+	logger *slog.Logger
+
+	// limits of the loaded json documents, the size only for yaml
+	limits Limits
+
+	// urls allowed, and the client loading them, http.DefaultClient when nil
+	policy       RemotePolicy
+	client       *http.Client
+	fetchOptions FetchOptions
+
+	// file system of the file urls, the one of the os when nil
+	fileSystem fs.FS
+
+	// loads the documents before the file and http loaders, when not nil
+	resolver Resolver
 }
 
 func newSchemaPool() *schemaPool {
@@ -50,11 +73,6 @@ func (p *schemaPool) GetPoolDocument(reference gojsonreference.JsonReference) (*
 
 	var err error
 
-	// It is not possible to load anything that is not canonical...
-	if !reference.IsCanonical() {
-		return nil, errors.New(fmt.Sprintf("Reference must be canonical %s", reference))
-	}
-
 	refToUrl := reference
 	refToUrl.GetUrl().Fragment = ""
 
@@ -71,53 +89,140 @@ func (p *schemaPool) GetPoolDocument(reference gojsonreference.JsonReference) (*
 		return spd, nil
 	}
 
+	// It is not possible to load anything that is not canonical...
+	if !reference.IsCanonical() {
+		return nil, errors.New(fmt.Sprintf("Reference must be canonical %s", reference.String()))
+	}
+
+	if err := p.policy.check(refToUrl.GetUrl()); err != nil {
+		return nil, err
+	}
+
 	// Load the document
 
+	start := time.Now()
+
 	var document interface{}
+	var text []byte
+
+	resolved := false
+	if p.resolver != nil {
+		text, err = p.resolver.Resolve(refToUrl.String())
+		resolved = !errors.Is(err, ErrUnresolved)
+	}
+
+	switch {
+
+	case resolved:
+
+		// Load from the resolver
+		if err == nil {
+			err = p.limits.checkJson(text)
+		}
+		if err == nil {
+			err = json.Unmarshal(text, &document)
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	if reference.HasFileScheme {
+	case reference.HasFileScheme:
 
 		// Load from file
 		filename := strings.Replace(refToUrl.String(), "file://", "", -1)
-		document, err = GetFileJson(filename)
+		if isYamlFilename(filename) {
+			var content []byte
+			content, err = p.readFile(filename)
+			if err == nil {
+				document, err = GetYamlDocument(content)
+			}
+		} else {
+			text, err = p.readFile(filename)
+			if err == nil && isJsoncFilename(filename) {
+				// comments are blanked, positions in the text are kept
+				text, err = stripJsonComments(text)
+			}
+			if err == nil {
+				err = p.limits.checkJson(text)
+			}
+			if err == nil {
+				err = json.Unmarshal(text, &document)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
 
-	} else {
+	default:
 
 		// Load from HTTP
-		document, err = GetHttpJson(refToUrl.String())
+		text, err = p.fetch(refToUrl.String())
+		if err == nil {
+			err = p.limits.checkJson(text)
+		}
+		if err == nil {
+			err = json.Unmarshal(text, &document)
+		}
 		if err != nil {
 			return nil, err
 		}
 
 	}
 
-	spd = &schemaPoolDocument{Document: document}
+	if p.logger != nil {
+		p.logger.LogAttrs(context.Background(), slog.LevelDebug, LOG_REMOTE_SCHEMA_FETCHED,
+			slog.String(LOG_KEY_URL, refToUrl.String()),
+			slog.Duration(LOG_KEY_DURATION, time.Since(start)))
+	}
+
+	spd = &schemaPoolDocument{Document: document, text: text}
 	// add the document to the pool for potential later use
 	p.schemaPoolDocuments[refToUrl.String()] = spd
 
 	return spd, nil
 }
 
+// readFile reads a file of a file url, from the file system of the pool when it has one :
+// file:///schemas/a.json is the file schemas/a.json of the file system
+func (p *schemaPool) readFile(filename string) ([]byte, error) {
+	if p.fileSystem == nil {
+		return readFileLimited(filename, p.limits.MaxBytes)
+	}
+	return readFSFileLimited(p.fileSystem, strings.TrimPrefix(filename, "/"), p.limits.MaxBytes)
+}
+
+// addDocument adds a document given as json, references within it are resolved
+// from the pool instead of being loaded
+func (p *schemaPool) addDocument(reference gojsonreference.JsonReference, document interface{}) {
+	p.addPoolDocument(reference, &schemaPoolDocument{Document: document})
+}
+
+// Adds a document loaded by another pool, with its json text
+func (p *schemaPool) addPoolDocument(reference gojsonreference.JsonReference, spd *schemaPoolDocument) {
+	refToUrl := reference
+	refToUrl.GetUrl().Fragment = ""
+	p.schemaPoolDocuments[refToUrl.String()] = spd
+}
+
 type schemaPoolDocument struct {
 	Document interface{}
+	// json text of the document, to locate errors
+	text []byte
 }
 
 // Helper function to read a json from a http request
 func GetHttpJson(url string) (interface{}, error) {
+	return GetHttpJsonWithLimits(url, Limits{})
+}
 
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
+// GetHttpJsonWithLimits reads a json from a http request, the body is refused
+// before being decoded when it exceeds the limits
+func GetHttpJsonWithLimits(url string, limits Limits) (interface{}, error) {
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("Could not access schema " + resp.Status)
+	bodyBuff, err := getHttpBody(nil, url, limits.MaxBytes)
+	if err == nil {
+		err = limits.checkJson(bodyBuff)
 	}
-
-	bodyBuff, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -131,10 +236,39 @@ func GetHttpJson(url string) (interface{}, error) {
 	return document, nil
 }
 
+// getHttpBody reads the body of a http request, of at most maxBytes unless 0
+// client is http.DefaultClient when nil
+func getHttpBody(client *http.Client, url string, maxBytes int) ([]byte, error) {
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHttpStatusError(resp)
+	}
+
+	return readLimited(resp.Body, maxBytes)
+}
+
 // Helper function to read a json from a filepath
 func GetFileJson(filepath string) (interface{}, error) {
+	return GetFileJsonWithLimits(filepath, Limits{})
+}
+
+// GetFileJsonWithLimits reads a json from a filepath, the file is refused
+// before being decoded when it exceeds the limits
+func GetFileJsonWithLimits(filepath string, limits Limits) (interface{}, error) {
 
-	bodyBuff, err := ioutil.ReadFile(filepath)
+	bodyBuff, err := readFileLimited(filepath, limits.MaxBytes)
+	if err == nil {
+		err = limits.checkJson(bodyBuff)
+	}
 	if err != nil {
 		return nil, err
 	}
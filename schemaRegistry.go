@@ -0,0 +1,301 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Registry of schemas by name and semantic version.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SchemaRegistry stores compiled schemas by name and semantic version ( 1.4.2, v2.0.0-beta.1 ),
+// so producers and consumers of payloads can agree on the latest version they are compatible with
+// A SchemaRegistry is safe for concurrent use
+type SchemaRegistry struct {
+	mutex   sync.RWMutex
+	schemas map[string][]registeredSchema
+
+	// reject versions with breaking changes within a major version
+	strictCompatibility bool
+}
+
+type registeredSchema struct {
+	version schemaVersion
+	schema  *JsonSchemaDocument
+}
+
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{schemas: make(map[string][]registeredSchema)}
+}
+
+// SetStrictCompatibility makes Register reject a minor or patch version with breaking changes
+// ( see DiffSchemas ) from the previous version of the same major version, or to which
+// the next version of the same major version has breaking changes
+func (r *SchemaRegistry) SetStrictCompatibility(strictCompatibility bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.strictCompatibility = strictCompatibility
+}
+
+// Register adds a version of a schema, a version cannot be registered twice
+func (r *SchemaRegistry) Register(name string, version string, schema *JsonSchemaDocument) error {
+
+	v, err := parseSchemaVersion(version)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	versions := r.schemas[name]
+	i := sort.Search(len(versions), func(i int) bool {
+		return versions[i].version.compare(v) >= 0
+	})
+	if i < len(versions) && versions[i].version.compare(v) == 0 {
+		return errors.New(fmt.Sprintf("schema %s %s is already registered", name, version))
+	}
+
+	// a version inserted between two others is checked against both
+	if r.strictCompatibility && i > 0 && versions[i-1].version.major == v.major {
+		for _, change := range DiffSchemas(versions[i-1].schema, schema) {
+			if change.Breaking {
+				return errors.New(fmt.Sprintf("schema %s %s breaks %s : %s", name, version, versions[i-1].version, change))
+			}
+		}
+	}
+	if r.strictCompatibility && i < len(versions) && versions[i].version.major == v.major {
+		for _, change := range DiffSchemas(schema, versions[i].schema) {
+			if change.Breaking {
+				return errors.New(fmt.Sprintf("schema %s %s is broken by %s : %s", name, version, versions[i].version, change))
+			}
+		}
+	}
+
+	versions = append(versions, registeredSchema{})
+	copy(versions[i+1:], versions[i:])
+	versions[i] = registeredSchema{version: v, schema: schema}
+	r.schemas[name] = versions
+
+	return nil
+}
+
+// Get returns the given version of a schema
+func (r *SchemaRegistry) Get(name string, version string) (*JsonSchemaDocument, bool) {
+
+	v, err := parseSchemaVersion(version)
+	if err != nil {
+		return nil, false
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, registered := range r.schemas[name] {
+		if registered.version.compare(v) == 0 {
+			return registered.schema, true
+		}
+	}
+	return nil, false
+}
+
+// Latest returns the highest version of a schema, pre-releases excluded unless there is no release
+func (r *SchemaRegistry) Latest(name string) (*JsonSchemaDocument, string, bool) {
+	if schema, version, ok := r.latest(name, func(v schemaVersion) bool { return v.preRelease == "" }); ok {
+		return schema, version, true
+	}
+	return r.latest(name, func(v schemaVersion) bool { return true })
+}
+
+// LatestCompatible returns the highest version of a schema compatible with the given one :
+// the same major version and not lower ( the same minor version for 0.x versions )
+// Pre-releases are only returned when asked for explicitly
+func (r *SchemaRegistry) LatestCompatible(name string, version string) (*JsonSchemaDocument, string, bool) {
+
+	requested, err := parseSchemaVersion(version)
+	if err != nil {
+		return nil, "", false
+	}
+
+	return r.latest(name, func(v schemaVersion) bool {
+		if v.major != requested.major || (v.major == 0 && v.minor != requested.minor) || v.compare(requested) < 0 {
+			return false
+		}
+		return v.preRelease == "" || v.compare(requested) == 0
+	})
+}
+
+// latest returns the highest version accepted by the filter
+func (r *SchemaRegistry) latest(name string, accept func(v schemaVersion) bool) (*JsonSchemaDocument, string, bool) {
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	versions := r.schemas[name]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if accept(versions[i].version) {
+			return versions[i].schema, versions[i].version.String(), true
+		}
+	}
+
+	return nil, "", false
+}
+
+// Versions returns the registered versions of a schema, in ascending order
+func (r *SchemaRegistry) Versions(name string) []string {
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var versions []string
+	for _, registered := range r.schemas[name] {
+		versions = append(versions, registered.version.String())
+	}
+	return versions
+}
+
+// Names returns the names of the registered schemas, sorted
+func (r *SchemaRegistry) Names() []string {
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.schemas))
+	for name := range r.schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// schemaVersion is a semantic version, build metadata is ignored
+type schemaVersion struct {
+	major, minor, patch int
+	preRelease          string
+}
+
+func parseSchemaVersion(version string) (schemaVersion, error) {
+
+	var v schemaVersion
+	invalid := errors.New(fmt.Sprintf("%s is not a semantic version", version))
+
+	s := strings.TrimPrefix(version, "v")
+	if i := strings.Index(s, "+"); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.Index(s, "-"); i >= 0 {
+		v.preRelease = s[i+1:]
+		s = s[:i]
+		if v.preRelease == "" {
+			return v, invalid
+		}
+	}
+
+	numbers := strings.Split(s, ".")
+	if len(numbers) != 3 {
+		return v, invalid
+	}
+	var parsed [3]int
+	for i, number := range numbers {
+		n, err := strconv.Atoi(number)
+		if err != nil || n < 0 || (len(number) > 1 && number[0] == '0') {
+			return v, invalid
+		}
+		parsed[i] = n
+	}
+	v.major, v.minor, v.patch = parsed[0], parsed[1], parsed[2]
+
+	return v, nil
+}
+
+func (v schemaVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+	if v.preRelease != "" {
+		s += "-" + v.preRelease
+	}
+	return s
+}
+
+// compare returns -1, 0 or 1 when v is lower, equal or greater than other, following semver precedence
+func (v schemaVersion) compare(other schemaVersion) int {
+
+	for _, c := range [][2]int{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// a pre-release is lower than its release
+	switch {
+	case v.preRelease == other.preRelease:
+		return 0
+	case v.preRelease == "":
+		return 1
+	case other.preRelease == "":
+		return -1
+	}
+
+	identifiers, otherIdentifiers := strings.Split(v.preRelease, "."), strings.Split(other.preRelease, ".")
+	for i := 0; i < len(identifiers) && i < len(otherIdentifiers); i++ {
+		if c := comparePreReleaseIdentifiers(identifiers[i], otherIdentifiers[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(identifiers) < len(otherIdentifiers):
+		return -1
+	case len(identifiers) > len(otherIdentifiers):
+		return 1
+	}
+	return 0
+}
+
+// numeric identifiers are compared numerically and are lower than alphanumeric ones
+func comparePreReleaseIdentifiers(a string, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+		return 0
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
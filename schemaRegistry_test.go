@@ -0,0 +1,121 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema registry.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSchemaRegistry(t *testing.T) {
+
+	registry := NewSchemaRegistry()
+	schemas := make(map[string]*JsonSchemaDocument)
+	for _, version := range []string{"1.2.0", "1.0.0", "v2.0.0-beta.1", "1.10.1", "0.1.0", "0.2.3", "2.0.0-beta.2", "1.11.0-rc.1"} {
+		schema, err := NewJsonSchemaDocument(mustParseJson(t, `{"title":"`+version+`"}`))
+		if err != nil {
+			t.Fatalf("Could not parse schema : %s", err.Error())
+		}
+		schemas[version] = schema
+		if err := registry.Register("order", version, schema); err != nil {
+			t.Fatalf("Could not register %s : %s", version, err.Error())
+		}
+	}
+
+	expectedVersions := []string{"0.1.0", "0.2.3", "1.0.0", "1.2.0", "1.10.1", "1.11.0-rc.1", "2.0.0-beta.1", "2.0.0-beta.2"}
+	if versions := registry.Versions("order"); !reflect.DeepEqual(versions, expectedVersions) {
+		t.Errorf("Expects versions %v, given %v", expectedVersions, versions)
+	}
+
+	if err := registry.Register("order", "1.0.0", schemas["1.0.0"]); err == nil {
+		t.Errorf("Expects a version not to be registered twice")
+	}
+	if err := registry.Register("order", "1.0", schemas["1.0.0"]); err == nil || err.Error() != "1.0 is not a semantic version" {
+		t.Errorf("Expects an invalid version to be rejected, given %v", err)
+	}
+
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.0.0", "1.10.1"},
+		{"1.10.1", "1.10.1"},
+		{"1.11.0", ""},
+		{"1.11.0-rc.1", "1.11.0-rc.1"},
+		{"0.1.0", "0.1.0"},
+		{"0.2.0", "0.2.3"},
+		{"2.0.0", ""},
+		{"3.0.0", ""},
+	}
+	for _, test := range tests {
+		schema, version, ok := registry.LatestCompatible("order", test.version)
+		if version != test.expected || ok != (test.expected != "") || (ok && schema != schemas[version]) {
+			t.Errorf("Expects %q compatible with %s, given %q", test.expected, test.version, version)
+		}
+	}
+
+	if _, version, _ := registry.Latest("order"); version != "1.10.1" {
+		t.Errorf("Expects the latest release to be 1.10.1, given %s", version)
+	}
+	if schema, ok := registry.Get("order", "v1.2.0"); !ok || schema != schemas["1.2.0"] {
+		t.Errorf("Expects to get the schema of 1.2.0")
+	}
+	if !reflect.DeepEqual(registry.Names(), []string{"order"}) {
+		t.Errorf("Unexpected names %v", registry.Names())
+	}
+}
+
+func TestSchemaRegistryStrictCompatibility(t *testing.T) {
+
+	registry := NewSchemaRegistry()
+	registry.SetStrictCompatibility(true)
+
+	register := func(version string, schema string) error {
+		schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, schema))
+		if err != nil {
+			t.Fatalf("Could not parse schema : %s", err.Error())
+		}
+		return registry.Register("user", version, schemaDocument)
+	}
+
+	if err := register("1.0.0", `{"properties":{"name":{"type":"string"}}}`); err != nil {
+		t.Fatalf("Could not register : %s", err.Error())
+	}
+	if err := register("1.1.0", `{"properties":{"name":{"type":["string","null"]},"age":{}}}`); err != nil {
+		t.Errorf("Expects a compatible version to be registered, given %s", err.Error())
+	}
+	if err := register("1.2.0", `{"properties":{"name":{"type":"string"}},"required":["name"]}`); err == nil || !strings.HasPrefix(err.Error(), "schema user 1.2.0 breaks 1.1.0 : breaking") {
+		t.Errorf("Expects a breaking minor version to be rejected, given %v", err)
+	}
+	// compatible with 1.0.0, but 1.1.0 is stricter
+	if err := register("1.0.5", `{"properties":{"name":{}}}`); err == nil || !strings.HasPrefix(err.Error(), "schema user 1.0.5 is broken by 1.1.0 : breaking") {
+		t.Errorf("Expects a version broken by the next one to be rejected, given %v", err)
+	}
+	if err := register("2.0.0", `{"properties":{"name":{"type":"string"}},"required":["name"]}`); err != nil {
+		t.Errorf("Expects a breaking major version to be registered, given %s", err.Error())
+	}
+}
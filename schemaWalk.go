@@ -0,0 +1,128 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Walks the tree of compiled schemas.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Walk calls visit on the root schema and its sub-schemas, depth first
+// ( definitions, properties, patternProperties, additionalProperties,
+// dependencies, items, additionalItems, allOf, anyOf, oneOf, not )
+// path is the json pointer of the schema from the root, a referenced schema is
+// visited once, under the path of the first reference followed by /$ref
+// Returning false from visit skips the sub-schemas of a schema
+func (d *JsonSchemaDocument) Walk(visit func(path string, node *SchemaNode) bool) {
+
+	visited := make(map[*jsonSchema]bool)
+
+	var visitAndFollow func(path string, schema *jsonSchema) bool
+	visitAndFollow = func(path string, schema *jsonSchema) bool {
+		if !visit(path, &SchemaNode{schema: schema}) {
+			return false
+		}
+		if schema.refSchema != nil && !visited[schema.refSchema] {
+			visited[schema.refSchema] = true
+			schema.refSchema.walk(path+"/"+KEY_REF, visitAndFollow)
+		}
+		return true
+	}
+
+	d.rootSchema.walk("", visitAndFollow)
+}
+
+// walk calls visit on the schema and its sub-schemas, depth first and sorted by
+// name, with the json pointer of each schema in the document ( "" for the root )
+// References are not followed, returning false from visit skips the sub-schemas
+func (v *jsonSchema) walk(path string, visit func(path string, schema *jsonSchema) bool) {
+
+	if !visit(path, v) {
+		return
+	}
+
+	for _, name := range sortedSchemaNames(v.definitions) {
+		v.definitions[name].walk(path+"/"+KEY_DEFINITIONS+"/"+escapeJsonPointerToken(name), visit)
+	}
+
+	properties := append([]*jsonSchema(nil), v.propertiesChildren...)
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].property < properties[j].property
+	})
+	for _, property := range properties {
+		property.walk(path+"/"+KEY_PROPERTIES+"/"+escapeJsonPointerToken(property.property), visit)
+	}
+	for _, pattern := range sortedSchemaNames(v.patternProperties) {
+		v.patternProperties[pattern].walk(path+"/"+KEY_PATTERN_PROPERTIES+"/"+escapeJsonPointerToken(pattern), visit)
+	}
+	if additionalProperties, ok := v.additionalProperties.(*jsonSchema); ok {
+		additionalProperties.walk(path+"/"+KEY_ADDITIONAL_PROPERTIES, visit)
+	}
+
+	dependencies := make([]string, 0, len(v.dependencies))
+	for name := range v.dependencies {
+		dependencies = append(dependencies, name)
+	}
+	sort.Strings(dependencies)
+	for _, name := range dependencies {
+		if dependency, ok := v.dependencies[name].(*jsonSchema); ok {
+			dependency.walk(path+"/"+KEY_DEPENDENCIES+"/"+escapeJsonPointerToken(name), visit)
+		}
+	}
+
+	if v.itemsChildrenIsSingleSchema {
+		v.itemsChildren[0].walk(path+"/"+KEY_ITEMS, visit)
+	} else {
+		for i, item := range v.itemsChildren {
+			item.walk(path+"/"+KEY_ITEMS+"/"+strconv.Itoa(i), visit)
+		}
+	}
+	if additionalItems, ok := v.additionalItems.(*jsonSchema); ok {
+		additionalItems.walk(path+"/"+KEY_ADDITIONAL_ITEMS, visit)
+	}
+
+	for i, schema := range v.allOf {
+		schema.walk(path+"/"+KEY_ALL_OF+"/"+strconv.Itoa(i), visit)
+	}
+	for i, schema := range v.anyOf {
+		schema.walk(path+"/"+KEY_ANY_OF+"/"+strconv.Itoa(i), visit)
+	}
+	for i, schema := range v.oneOf {
+		schema.walk(path+"/"+KEY_ONE_OF+"/"+strconv.Itoa(i), visit)
+	}
+	if v.not != nil {
+		v.not.walk(path+"/"+KEY_NOT, visit)
+	}
+}
+
+func sortedSchemaNames(schemas map[string]*jsonSchema) []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
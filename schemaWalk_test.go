@@ -0,0 +1,78 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema walk.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+
+	// internal references can only be resolved on a schema loaded from a file
+	schemaFile := filepath.Join(t.TempDir(), "walk.json")
+	err := os.WriteFile(schemaFile, []byte(`{
+		"definitions":{"node":{"properties":{"children":{"items":{"$ref":"#/definitions/node"}}}}},
+		"properties":{
+			"root":{"$ref":"#/definitions/node"},
+			"skipped":{"allOf":[{"type":"string"}]}
+		}
+	}`), 0644)
+	if err != nil {
+		t.Fatalf("Could not write schema : %s", err.Error())
+	}
+
+	schemaDocument, err := NewJsonSchemaDocument("file://" + filepath.ToSlash(schemaFile))
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	var paths []string
+	schemaDocument.Walk(func(path string, node *SchemaNode) bool {
+		if reference := node.Reference(); reference != "" {
+			path += " -> " + reference[strings.Index(reference, "#"):]
+		}
+		paths = append(paths, path)
+		return !strings.HasSuffix(path, "/skipped")
+	})
+
+	expected := []string{
+		``,
+		`/definitions/node`,
+		`/definitions/node/properties/children`,
+		`/definitions/node/properties/children/items -> #/definitions/node`,
+		`/definitions/node/properties/children/items/$ref`,
+		`/definitions/node/properties/children/items/$ref/properties/children`,
+		`/definitions/node/properties/children/items/$ref/properties/children/items -> #/definitions/node`,
+		`/properties/root -> #/definitions/node`,
+		`/properties/skipped`,
+	}
+	if strings.Join(paths, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects paths :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(paths, "\n"))
+	}
+}
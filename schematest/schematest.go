@@ -0,0 +1,179 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Assertions on json schema validations, for tests.
+//
+// created          16-10-2026
+
+// Package schematest asserts the validations of json schemas in tests, the
+// failed assertions list the errors of the validation.
+//
+//	schematest.AssertValid(t, schema, `{"name":"joe"}`)
+//	result := schematest.AssertInvalid(t, schema, `{"ages":[1,200]}`)
+//	schematest.AssertErrorAt(t, result, "/ages/1", "maximum")
+package schematest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"sort"
+	"strings"
+)
+
+// TestingT is the part of testing.TB used by the assertions
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertValid fails the test when the schema does not validate the document
+// A document given as a string or []byte is json text
+func AssertValid(t TestingT, schema *gojsonschema.JsonSchemaDocument, document interface{}) *gojsonschema.ValidationResult {
+	t.Helper()
+	result, ok := validate(t, schema, document)
+	if ok && !result.IsValid() {
+		t.Errorf("Expects a valid document, given errors :\n%s", formatErrors(result.GetErrors(), nil))
+	}
+	return result
+}
+
+// AssertInvalid fails the test when the schema validates the document
+// A document given as a string or []byte is json text
+func AssertInvalid(t TestingT, schema *gojsonschema.JsonSchemaDocument, document interface{}) *gojsonschema.ValidationResult {
+	t.Helper()
+	result, ok := validate(t, schema, document)
+	if ok && result.IsValid() {
+		t.Errorf("Expects an invalid document, given a valid one")
+	}
+	return result
+}
+
+// AssertErrorAt fails the test unless the result has an error of keyword at
+// the json pointer of the document ( "" being the root )
+// The errors at the pointer or of the keyword are marked with a ~ in the failure
+func AssertErrorAt(t TestingT, result *gojsonschema.ValidationResult, pointer string, keyword string) bool {
+	t.Helper()
+	for _, resultError := range result.GetErrors() {
+		if resultError.Pointer() == pointer && resultError.Keyword == keyword {
+			return true
+		}
+	}
+	near := func(resultError *gojsonschema.ResultError) bool {
+		return resultError.Pointer() == pointer || resultError.Keyword == keyword
+	}
+	t.Errorf("Expects an error %s at '%s', given errors :\n%s", keyword, pointer, formatErrors(result.GetErrors(), near))
+	return false
+}
+
+// AssertNoErrorAt fails the test when the result has an error at the json
+// pointer of the document
+func AssertNoErrorAt(t TestingT, result *gojsonschema.ValidationResult, pointer string) bool {
+	t.Helper()
+	at := func(resultError *gojsonschema.ResultError) bool {
+		return resultError.Pointer() == pointer
+	}
+	for _, resultError := range result.GetErrors() {
+		if at(resultError) {
+			t.Errorf("Expects no error at '%s', given errors :\n%s", pointer, formatErrors(result.GetErrors(), at))
+			return false
+		}
+	}
+	return true
+}
+
+// AssertErrors fails the test unless the errors of the result are exactly the
+// expected ones, each given as "pointer keyword" ( ex: "/ages/1 maximum" ),
+// the failure is a diff : - for the missing errors, + for the unexpected ones
+func AssertErrors(t TestingT, result *gojsonschema.ValidationResult, expected ...string) bool {
+	t.Helper()
+
+	given := make(map[string]*gojsonschema.ResultError)
+	for _, resultError := range result.GetErrors() {
+		given[errorKey(resultError)] = resultError
+	}
+	wanted := make(map[string]bool)
+	for _, key := range expected {
+		wanted[key] = true
+	}
+
+	var diff []string
+	for _, key := range expected {
+		if given[key] == nil {
+			diff = append(diff, "- "+key)
+		}
+	}
+	for key, resultError := range given {
+		if !wanted[key] {
+			diff = append(diff, "+ "+key+" : "+resultError.Description)
+		}
+	}
+	if len(diff) == 0 {
+		return true
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i][2:] < diff[j][2:]
+	})
+	t.Errorf("Expects other errors ( - missing, + unexpected ) :\n%s", strings.Join(diff, "\n"))
+	return false
+}
+
+func validate(t TestingT, schema *gojsonschema.JsonSchemaDocument, document interface{}) (*gojsonschema.ValidationResult, bool) {
+	t.Helper()
+	if text, ok := document.(string); ok {
+		document = []byte(text)
+	}
+	if text, ok := document.([]byte); ok {
+		var decoded interface{}
+		if err := json.Unmarshal(text, &decoded); err != nil {
+			t.Errorf("Could not parse the document : %s", err.Error())
+			return nil, false
+		}
+		document = decoded
+	}
+	return schema.Validate(document), true
+}
+
+func errorKey(resultError *gojsonschema.ResultError) string {
+	return resultError.Pointer() + " " + resultError.Keyword
+}
+
+// Lists the errors, one per line and aligned, the ones matching marked with a ~
+func formatErrors(resultErrors []*gojsonschema.ResultError, marked func(*gojsonschema.ResultError) bool) string {
+	if len(resultErrors) == 0 {
+		return "  ( none )"
+	}
+	width := 0
+	for _, resultError := range resultErrors {
+		if len(errorKey(resultError)) > width {
+			width = len(errorKey(resultError))
+		}
+	}
+	lines := make([]string, len(resultErrors))
+	for i, resultError := range resultErrors {
+		mark := " "
+		if marked != nil && marked(resultError) {
+			mark = "~"
+		}
+		lines[i] = fmt.Sprintf("%s %-*s : %s", mark, width, errorKey(resultError), resultError.Description)
+	}
+	return strings.Join(lines, "\n")
+}
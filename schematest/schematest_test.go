@@ -0,0 +1,98 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema assertions.
+//
+// created          16-10-2026
+
+package schematest
+
+import (
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"strings"
+	"testing"
+)
+
+type recordingT struct {
+	failures []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+func newSchema(t *testing.T) *gojsonschema.JsonSchemaDocument {
+	schema, err := gojsonschema.NewJsonSchemaDocument(map[string]interface{}{
+		"required": []interface{}{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"ages": map[string]interface{}{"items": map[string]interface{}{"maximum": 150.0}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func TestAssertions(t *testing.T) {
+
+	schema := newSchema(t)
+
+	// passing assertions
+	AssertValid(t, schema, `{"name":"joe"}`)
+	result := AssertInvalid(t, schema, []byte(`{"ages":[1,200]}`))
+	AssertErrorAt(t, result, "/ages/1", "maximum")
+	AssertNoErrorAt(t, result, "/ages/0")
+	AssertErrors(t, result, "/ages/1 maximum", " required")
+
+	// failing assertions
+	recorder := &recordingT{}
+	AssertValid(recorder, schema, `{"ages":[1,200]}`)
+	AssertInvalid(recorder, schema, map[string]interface{}{"name": "joe"})
+	AssertErrorAt(recorder, result, "/ages/0", "maximum")
+	AssertNoErrorAt(recorder, result, "")
+	AssertErrors(recorder, result, "/ages/0 maximum", " required")
+	AssertValid(recorder, schema, `{`)
+
+	expected := []string{
+		"Expects a valid document, given errors :\n" +
+			"   required       : name property is required\n" +
+			"  /ages/1 maximum : items (200) must be lower than 150",
+		"Expects an invalid document, given a valid one",
+		"Expects an error maximum at '/ages/0', given errors :\n" +
+			"   required       : name property is required\n" +
+			"~ /ages/1 maximum : items (200) must be lower than 150",
+		"Expects no error at '', given errors :\n" +
+			"~  required       : name property is required\n" +
+			"  /ages/1 maximum : items (200) must be lower than 150",
+		"Expects other errors ( - missing, + unexpected ) :\n" +
+			"- /ages/0 maximum\n" +
+			"+ /ages/1 maximum : items (200) must be lower than 150",
+		"Could not parse the document : unexpected end of JSON input",
+	}
+	if strings.Join(recorder.failures, "\n\n") != strings.Join(expected, "\n\n") {
+		t.Errorf("Expects failures :\n%s\n\ngiven :\n%s", strings.Join(expected, "\n\n"), strings.Join(recorder.failures, "\n\n"))
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Reloads schemas when their files change.
+//
+// created          16-10-2026
+
+// Package schemawatch keeps a schema up to date with its files
+//
+// The schema file and the files it references are watched, a change compiles
+// the schema again and swaps it in atomically. A schema that does not compile
+// is reported and the previous one is kept.
+//
+//	watcher, err := schemawatch.Watch("file:///etc/app/order.json", schemawatch.Options{
+//		OnError: func(err error) { log.Printf("order schema : %s", err) },
+//	})
+//	...
+//	result := watcher.Schema().Validate(document)
+package schemawatch
+
+import (
+	"github.com/fsnotify/fsnotify"
+	"github.com/sigu-399/gojsonschema"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DEFAULT_DELAY is the time waited after a change before compiling, editors write files in several steps
+const DEFAULT_DELAY = 100 * time.Millisecond
+
+type Options struct {
+	// Compile compiles the schema, gojsonschema.NewJsonSchemaDocument when nil
+	// Use it to set the options of the schema
+	Compile func(reference string) (*gojsonschema.JsonSchemaDocument, error)
+	// OnReload is called with each newly compiled schema
+	OnReload func(schema *gojsonschema.JsonSchemaDocument)
+	// OnError is called when the schema cannot be compiled or the files cannot be watched
+	OnError func(err error)
+	// Delay after a change before compiling, DEFAULT_DELAY when zero
+	Delay time.Duration
+}
+
+// Watcher holds the last compiled version of a schema
+type Watcher struct {
+	reference string
+	options   Options
+
+	schema  atomic.Pointer[gojsonschema.JsonSchemaDocument]
+	watcher *fsnotify.Watcher
+
+	// files of the schema and watched directories, only used by the watch loop
+	files       map[string]bool
+	directories map[string]bool
+
+	done      chan struct{}
+	closeOnce sync.Once
+	stopped   sync.WaitGroup
+}
+
+// Watch compiles the schema of a file reference ( file:// ) and starts watching its files
+func Watch(reference string, options Options) (*Watcher, error) {
+
+	if options.Compile == nil {
+		options.Compile = func(reference string) (*gojsonschema.JsonSchemaDocument, error) {
+			return gojsonschema.NewJsonSchemaDocument(reference)
+		}
+	}
+	if options.Delay == 0 {
+		options.Delay = DEFAULT_DELAY
+	}
+
+	schema, err := options.Compile(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		reference:   reference,
+		options:     options,
+		watcher:     watcher,
+		directories: make(map[string]bool),
+		done:        make(chan struct{}),
+	}
+	w.schema.Store(schema)
+	if err := w.watchFiles(schema); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	w.stopped.Add(1)
+	go w.loop()
+
+	return w, nil
+}
+
+// Schema returns the last compiled version of the schema
+func (w *Watcher) Schema() *gojsonschema.JsonSchemaDocument {
+	return w.schema.Load()
+}
+
+// Close stops watching the files, Schema keeps returning the last compiled version
+func (w *Watcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+		err = w.watcher.Close()
+		w.stopped.Wait()
+	})
+	return err
+}
+
+func (w *Watcher) loop() {
+
+	defer w.stopped.Done()
+
+	timer := time.NewTimer(w.options.Delay)
+	timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if w.files[filepath.Clean(event.Name)] {
+				timer.Reset(w.options.Delay)
+			}
+
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.onError(err)
+
+		case <-timer.C:
+			w.reload()
+		}
+	}
+}
+
+func (w *Watcher) reload() {
+
+	schema, err := w.options.Compile(w.reference)
+	if err != nil {
+		w.onError(err)
+		return
+	}
+
+	w.schema.Store(schema)
+	if err := w.watchFiles(schema); err != nil {
+		w.onError(err)
+	}
+	if w.options.OnReload != nil {
+		w.options.OnReload(schema)
+	}
+}
+
+// watchFiles watches the directories of the files of the schema and the files it references,
+// directories rather than files so files replaced by a rename are still watched
+func (w *Watcher) watchFiles(schema *gojsonschema.JsonSchemaDocument) error {
+
+	files := map[string]bool{}
+	if file, ok := filePath(w.reference); ok {
+		files[file] = true
+	}
+	for _, node := range schema.ReferenceGraph().Nodes {
+		if file, ok := filePath(node[:strings.Index(node, "#")]); ok {
+			files[file] = true
+		}
+	}
+	w.files = files
+
+	for file := range files {
+		directory := filepath.Dir(file)
+		if !w.directories[directory] {
+			if err := w.watcher.Add(directory); err != nil {
+				return err
+			}
+			w.directories[directory] = true
+		}
+	}
+	return nil
+}
+
+func (w *Watcher) onError(err error) {
+	if w.options.OnError != nil {
+		w.options.OnError(err)
+	}
+}
+
+// filePath returns the path of a file:// document
+func filePath(document string) (string, bool) {
+	u, err := url.Parse(document)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.Clean(filepath.FromSlash(u.Path)), true
+}
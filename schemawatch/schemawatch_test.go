@@ -0,0 +1,104 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the reloading of schemas.
+//
+// created          16-10-2026
+
+package schemawatch
+
+import (
+	"github.com/sigu-399/gojsonschema"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+
+	directory := t.TempDir()
+	writeFile := func(name string, content string) {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Could not write %s : %s", name, err.Error())
+		}
+	}
+	writeFile("main.json", `{"properties":{"name":{"$ref":"name.json"}}}`)
+	writeFile("name.json", `{"type":"string"}`)
+
+	reloads := make(chan *gojsonschema.JsonSchemaDocument, 10)
+	errs := make(chan error, 10)
+	watcher, err := Watch("file://"+filepath.ToSlash(filepath.Join(directory, "main.json")), Options{
+		OnReload: func(schema *gojsonschema.JsonSchemaDocument) { reloads <- schema },
+		OnError:  func(err error) { errs <- err },
+		Delay:    10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Could not watch the schema : %s", err.Error())
+	}
+	defer watcher.Close()
+
+	document := map[string]interface{}{"name": 1.0}
+	if watcher.Schema().Validate(document).IsValid() {
+		t.Fatalf("Expects the first version of the schema to reject a number")
+	}
+
+	// a change of a referenced file reloads the schema
+	writeFile("name.json", `{"type":"number"}`)
+	select {
+	case schema := <-reloads:
+		if schema != watcher.Schema() || !schema.Validate(document).IsValid() {
+			t.Errorf("Expects the new version of the schema to accept a number")
+		}
+	case err := <-errs:
+		t.Fatalf("Unexpected error : %s", err.Error())
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expects the schema to be reloaded")
+	}
+
+	// an invalid schema is reported and the previous version is kept
+	previous := watcher.Schema()
+	writeFile("main.json", `{"properties":{"name":{"type":"text"}}}`)
+	select {
+	case <-errs:
+		if watcher.Schema() != previous {
+			t.Errorf("Expects the previous version of the schema to be kept")
+		}
+	case <-reloads:
+		t.Fatalf("Expects an invalid schema not to be reloaded")
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expects the invalid schema to be reported")
+	}
+
+	if err := watcher.Close(); err != nil {
+		t.Errorf("Could not close the watcher : %s", err.Error())
+	}
+	if watcher.Schema() != previous {
+		t.Errorf("Expects the schema to be kept after closing")
+	}
+}
+
+func TestWatchInvalidSchema(t *testing.T) {
+
+	if _, err := Watch("file:///does/not/exist.json", Options{}); err == nil {
+		t.Errorf("Expects an error for a schema that cannot be compiled")
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Severity of the findings, errors and warnings.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+// Severity tells whether a finding makes a document invalid ( an error ) or is
+// only reported ( a warning )
+type Severity int
+
+const (
+	SEVERITY_ERROR Severity = iota
+	SEVERITY_WARNING
+)
+
+func (s Severity) String() string {
+	if s == SEVERITY_WARNING {
+		return "warning"
+	}
+	return "error"
+}
+
+// GetWarnings returns the findings that do not make the document invalid : the
+// deprecated values, and the values not matching their format when format is
+// an annotation ( see SetFormatAssertion )
+func (v *ValidationResult) GetWarnings() []*ResultError {
+	for _, warning := range v.warnings {
+		warning.formatMessage()
+	}
+	return v.warnings
+}
+
+func (v *ValidationResult) HasWarnings() bool {
+	return len(v.warnings) > 0
+}
+
+// Adds a warning, formatted like the errors of addError, it neither changes
+// the score nor fails the keyword
+func (v *ValidationResult) addWarning(context *jsonContext, keyword string, format string, args ...interface{}) *ResultError {
+	warning := &ResultError{Keyword: keyword, Type: keywordErrorTypes[keyword], Severity: SEVERITY_WARNING, context: context, pending: true, format: format, args: args}
+	v.warnings = append(v.warnings, warning)
+	return warning
+}
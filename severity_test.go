@@ -0,0 +1,88 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tests of the warnings.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+)
+
+func TestWarnings(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{
+		"legacy":{"deprecated":true},
+		"mail":{"type":"string","format":"email"},
+		"age":{"type":"integer"}
+	}}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"legacy":1,"mail":"a@b"}`))
+	if !result.IsValid() {
+		t.Errorf("Expects a deprecated value to be valid, given %v", result.GetErrors())
+	}
+	warnings := result.GetWarnings()
+	if len(warnings) != 1 || warnings[0].Keyword != KEY_DEPRECATED || warnings[0].Severity != SEVERITY_WARNING || warnings[0].Description != "legacy is deprecated" {
+		t.Errorf("Expects the deprecated value to be warned, given %v", warnings)
+	}
+	if result = schemaDocument.Validate(mustParseJson(t, `{"age":1}`)); result.HasWarnings() {
+		t.Errorf("Expects no warning without deprecated value, given %v", result.GetWarnings())
+	}
+
+	schemaDocument.SetFormatAssertion(false)
+	result = schemaDocument.Validate(mustParseJson(t, `{"mail":"nope","age":"x"}`))
+	if len(result.GetErrors()) != 1 || result.GetErrors()[0].Severity != SEVERITY_ERROR {
+		t.Errorf("Expects only the type to be an error, given %v", result.GetErrors())
+	}
+	warnings = result.GetWarnings()
+	if len(warnings) != 1 || warnings[0].Keyword != KEY_FORMAT || warnings[0].Context != "ROOT.mail" {
+		t.Errorf("Expects the format annotation failure to be warned, given %v", warnings)
+	}
+
+	if _, err := NewJsonSchemaDocument(mustParseJson(t, `{"$schema":"https://json-schema.org/draft/2020-12/schema","properties":{"a":{"deprecated":"yes"}}}`)); err == nil {
+		t.Errorf("Expects deprecated to be a boolean since draft 2019-09")
+	}
+	// an unknown keyword of the earlier drafts
+	for _, schema := range []string{`{"deprecated":"use foo"}`, `{"$schema":"http://json-schema.org/draft-04/schema#","deprecated":"use foo"}`} {
+		schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, schema))
+		if err != nil {
+			t.Errorf("Expects deprecated to be ignored in %s, given %s", schema, err.Error())
+		} else if result := schemaDocument.Validate(1); result.HasWarnings() {
+			t.Errorf("Expects deprecated to be ignored in %s, given %v", schema, result.GetWarnings())
+		}
+	}
+}
+
+func TestLintSeverity(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"not":{},"anyOf":[{"type":"string"}]}`)
+	findings := schemaDocument.Lint()
+	if len(findings) != 2 {
+		t.Fatalf("Expects 2 findings, given %v", findings)
+	}
+	for _, finding := range findings {
+		if finding.Contradiction != (finding.Severity == SEVERITY_ERROR) {
+			t.Errorf("Expects the contradictions to be errors and the other findings warnings, given %+v", finding)
+		}
+	}
+}
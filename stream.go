@@ -0,0 +1,220 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Streaming validation of documents too large to be loaded in memory.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// ValidateArrayStream validates a document made of a top-level array, reading
+// one element at a time so that the whole array is never loaded in memory
+// Each element is validated against the items schema and its result passed to
+// onElement ( can be nil ), the returned result holds the errors of every
+// element and of the array itself ( minItems, maxItems, uniqueItems... ), data
+// other than whitespace after the array is an error
+// The root schema can only constrain the array with type, items,
+// additionalItems, minItems, maxItems and uniqueItems
+func (v *JsonSchemaDocument) ValidateArrayStream(reader io.Reader, onElement func(index int, result *ValidationResult)) (*ValidationResult, error) {
+
+	rootSchema := v.rootSchema
+	for rootSchema.refSchema != nil {
+		rootSchema = rootSchema.refSchema
+	}
+	if len(rootSchema.anyOf) > 0 || len(rootSchema.oneOf) > 0 || len(rootSchema.allOf) > 0 || rootSchema.not != nil || len(rootSchema.enum) > 0 {
+		return nil, errors.New("the root schema cannot be streamed, it has keywords applying to the whole array")
+	}
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// the options are read once, they cannot change in the middle of the stream
+	options := v.getOptions()
+	context := options.rootContext()
+	result := &ValidationResult{options: options, context: context}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		tokenType := streamTokenType(token)
+		if rootSchema.types.HasTypeInSchema() && !rootSchema.types.HasType(tokenType) && !(tokenType == TYPE_INTEGER && rootSchema.types.HasType(TYPE_NUMBER)) {
+			result.addError(context, KEY_TYPE, ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, rootSchema.property, rootSchema.types.String())
+			return result, nil
+		}
+		return nil, errors.New("the document is not an array")
+	}
+	if rootSchema.types.HasTypeInSchema() && !rootSchema.types.HasType(TYPE_ARRAY) {
+		result.addError(context, KEY_TYPE, ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, rootSchema.property, rootSchema.types.String())
+		return result, nil
+	}
+
+	// hashes of the elements already read, for uniqueItems
+	var hashes map[[sha256.Size]byte]bool
+	if rootSchema.uniqueItems {
+		hashes = make(map[[sha256.Size]byte]bool)
+	}
+
+	nbItems := 0
+	for ; decoder.More(); nbItems++ {
+
+		var element interface{}
+		err = decoder.Decode(&element)
+		if err != nil {
+			return nil, err
+		}
+
+		var elementSchema *jsonSchema
+		if options.ignores(KEY_ITEMS) {
+			// the elements are not checked
+		} else if rootSchema.itemsChildrenIsSingleSchema {
+			elementSchema = rootSchema.itemsChildren[0]
+		} else if nbItems < len(rootSchema.itemsChildren) {
+			elementSchema = rootSchema.itemsChildren[nbItems]
+		} else if additionalItemSchema, ok := rootSchema.additionalItems.(*jsonSchema); ok && len(rootSchema.itemsChildren) > 0 && !options.ignores(KEY_ADDITIONAL_ITEMS) {
+			elementSchema = additionalItemSchema
+		}
+
+		elementResult := &ValidationResult{options: options}
+		if elementSchema != nil {
+			elementContext := consJsonIndexContext(nbItems, context)
+			elementResult = elementSchema.Validate(element, elementContext, options)
+			elementResult.sortErrors()
+			// not mergeChild, which recycles the valid results onElement is given
+			result.Merge(elementResult)
+			result.addChild(elementResult, KEY_ITEMS, strconv.Itoa(nbItems))
+			if !elementResult.IsValid() {
+				result.traceFailure(KEY_ITEMS)
+			}
+		}
+
+		if hashes != nil {
+			elementString, err := marshalToCanonicalString(element)
+			if err != nil {
+				return nil, err
+			}
+			hash := sha256.Sum256([]byte(*elementString))
+			if hashes[hash] {
+				result.addError(context, KEY_UNIQUE_ITEMS, "%s items must be unique", rootSchema.property)
+			}
+			hashes[hash] = true
+		}
+
+		if onElement != nil {
+			onElement(nbItems, elementResult)
+		}
+	}
+
+	// closing ], then only whitespace until the end of the stream
+	if _, err = decoder.Token(); err != nil {
+		return nil, err
+	}
+	if _, err = decoder.Token(); err != io.EOF {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("the stream has data after the array")
+	}
+
+	if additionalItems, ok := rootSchema.additionalItems.(bool); ok && !additionalItems && !rootSchema.itemsChildrenIsSingleSchema && len(rootSchema.itemsChildren) > 0 && nbItems > len(rootSchema.itemsChildren) {
+		result.addError(context, KEY_ADDITIONAL_ITEMS, "No additional item allowed on %s", rootSchema.property)
+	}
+	if rootSchema.minItems != nil && nbItems < *rootSchema.minItems {
+		result.addError(context, KEY_MIN_ITEMS, "%s must have at least %d items", rootSchema.property, *rootSchema.minItems)
+	}
+	if rootSchema.maxItems != nil && nbItems > *rootSchema.maxItems {
+		result.addError(context, KEY_MAX_ITEMS, "%s must have at the most %d items", rootSchema.property, *rootSchema.maxItems)
+	}
+
+	result.sortErrors()
+	result.removeDuplicateErrors()
+	return result, nil
+}
+
+// ValidateLines validates a newline-delimited JSON ( NDJSON, JSON Lines ) stream
+// Each non blank line is validated as a document of its own and its result is
+// passed to onLine with its line number ( starting at 1 ), err is set instead
+// when the line is not valid JSON
+// Returns an error only when the stream cannot be read
+func (v *JsonSchemaDocument) ValidateLines(reader io.Reader, onLine func(line int, result *ValidationResult, err error)) error {
+
+	bufferedReader := bufio.NewReader(reader)
+
+	for line := 1; ; line++ {
+
+		content, readErr := bufferedReader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+
+		content = bytes.TrimSpace(content)
+		if len(content) > 0 {
+			decoder := json.NewDecoder(bytes.NewReader(content))
+			decoder.UseNumber()
+
+			// decoding again, decoder.More() does not see a trailing } or ]
+			var document, extra interface{}
+			err := decoder.Decode(&document)
+			if err == nil && decoder.Decode(&extra) != io.EOF {
+				err = errors.New("the line has data after its document")
+			}
+			if err != nil {
+				onLine(line, nil, err)
+			} else {
+				onLine(line, v.Validate(document), nil)
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
+
+// streamTokenType returns the json type of a document starting with token
+func streamTokenType(token json.Token) string {
+	switch t := token.(type) {
+	case json.Delim:
+		return TYPE_OBJECT
+	case bool:
+		return TYPE_BOOLEAN
+	case string:
+		return TYPE_STRING
+	case json.Number:
+		if _, err := t.Int64(); err == nil {
+			return TYPE_INTEGER
+		}
+		return TYPE_NUMBER
+	}
+	return TYPE_NULL
+}
@@ -0,0 +1,134 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the streaming validation.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateArrayStream(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"type":"array","items":{"properties":{"id":{"type":"integer"}},"required":["id"]},"maxItems":3,"uniqueItems":true}`)
+
+	var invalidIndexes []int
+	result, err := schemaDocument.ValidateArrayStream(strings.NewReader(`[{"id":1},{"id":"2"},{"id":1},{}]`), func(index int, result *ValidationResult) {
+		if !result.IsValid() {
+			invalidIndexes = append(invalidIndexes, index)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Could not validate stream : %s", err.Error())
+	}
+
+	expected := []string{
+		`ROOT : (root) must have at the most 3 items`,
+		`ROOT : (root) items must be unique`,
+		`ROOT.1.id : id must be of type integer`,
+		`ROOT.3 : id property is required`,
+	}
+	messages := result.GetErrorMessages()
+	if len(messages) != len(expected) {
+		t.Fatalf("Expects %v, given %v", expected, messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Errorf("Expects %q, given %q", expected[i], messages[i])
+		}
+	}
+	if len(invalidIndexes) != 2 || invalidIndexes[0] != 1 || invalidIndexes[1] != 3 {
+		t.Errorf("Expects elements 1 and 3 to be invalid, given %v", invalidIndexes)
+	}
+
+	if _, err := schemaDocument.ValidateArrayStream(strings.NewReader(`[{"id":1},`), nil); err == nil {
+		t.Errorf("Expects a truncated stream to be an error")
+	}
+	for _, stream := range []string{`[{"id":1}] garbage`, `[][]`} {
+		if _, err := schemaDocument.ValidateArrayStream(strings.NewReader(stream), nil); err == nil {
+			t.Errorf("Expects data after the array of %s to be an error", stream)
+		}
+	}
+	if _, err := schemaDocument.ValidateArrayStream(strings.NewReader("[{\"id\":1}] \n"), nil); err != nil {
+		t.Errorf("Expects whitespace after the array to be allowed, given %s", err.Error())
+	}
+
+	if result, err := schemaDocument.ValidateArrayStream(strings.NewReader(`{}`), nil); err != nil || result.IsValid() {
+		t.Errorf("Expects an object to be of the wrong type, given %v", err)
+	}
+
+	objectDocument := mustNewSchemaDocument(t, `{"type":"object"}`)
+	if result, err := objectDocument.ValidateArrayStream(strings.NewReader(`[1,2]`), nil); err != nil || result.IsValid() || !hasErrorType(result, ERROR_TYPE_TYPE) {
+		t.Errorf("Expects an array to be of the wrong type, given %v", err)
+	}
+}
+
+func TestValidateArrayStreamKeptResults(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"type":"array","items":{"type":"integer"}}`)
+
+	// the results are only read once the stream is validated
+	var results []*ValidationResult
+	if _, err := schemaDocument.ValidateArrayStream(strings.NewReader(`[1,2,3,"4"]`), func(index int, result *ValidationResult) {
+		results = append(results, result)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range results {
+		if result.IsValid() != (i != 3) {
+			t.Errorf("Expects the result of element %d to be kept, given %v", i, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestValidateLines(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"required":["id"]}`)
+
+	var validLines, invalidLines, brokenLines []int
+	err := schemaDocument.ValidateLines(strings.NewReader("{\"id\":1}\n{}\n\n{\"id\":\n{\"id\":2}\n{\"id\":3}}\n{\"id\":4} {}\n[]]"), func(line int, result *ValidationResult, err error) {
+		switch {
+		case err != nil:
+			brokenLines = append(brokenLines, line)
+		case result.IsValid():
+			validLines = append(validLines, line)
+		default:
+			invalidLines = append(invalidLines, line)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Could not validate lines : %s", err.Error())
+	}
+
+	if len(validLines) != 2 || validLines[0] != 1 || validLines[1] != 5 {
+		t.Errorf("Expects lines 1 and 5 to be valid, given %v", validLines)
+	}
+	if len(invalidLines) != 1 || invalidLines[0] != 2 {
+		t.Errorf("Expects line 2 to be invalid, given %v", invalidLines)
+	}
+	if len(brokenLines) != 4 || brokenLines[0] != 4 || brokenLines[1] != 6 || brokenLines[2] != 7 || brokenLines[3] != 8 {
+		t.Errorf("Expects lines 4, 6, 7 and 8 not to be valid JSON, given %v", brokenLines)
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Runner of the JSON-Schema-Test-Suite.
+//
+// created          16-10-2026
+
+// Package testsuite runs the files of the JSON-Schema-Test-Suite
+// ( https://github.com/json-schema/JSON-Schema-Test-Suite ) and reports the
+// conformance of the validator, draft by draft.
+//
+//	reports, err := testsuite.RunSuite("JSON-Schema-Test-Suite/tests", testsuite.Options{})
+//	for _, report := range reports {
+//		fmt.Println(report)
+//	}
+package testsuite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sigu-399/gojsonschema"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Address the suite serves its remotes directory from, in the references of its schemas
+const REMOTES_ADDRESS = "localhost:1234"
+
+// Case is a schema with the documents it is tested against
+type Case struct {
+	Description string      `json:"description"`
+	Schema      interface{} `json:"schema"`
+	Tests       []Test      `json:"tests"`
+}
+
+// Test is a document and whether the schema of its case must validate it
+type Test struct {
+	Description string      `json:"description"`
+	Data        interface{} `json:"data"`
+	Valid       bool        `json:"valid"`
+}
+
+// Options change how the cases are run
+type Options struct {
+	// Options of the schemas of the cases
+	SchemaOptions gojsonschema.SchemaOptions
+
+	// Called on the schema of each case before its tests, to add format
+	// checkers, custom keywords...
+	Configure func(schemaDocument *gojsonschema.JsonSchemaDocument)
+
+	// Skip the files of the optional directory
+	SkipOptional bool
+}
+
+// Result is the outcome of a test
+type Result struct {
+	// file of the test, relative to the directory of its draft
+	File     string
+	Case     string
+	Test     string
+	Expected bool
+	Valid    bool
+	// set when the schema could not be compiled or the validation panicked
+	Err error
+}
+
+// Passed tells whether the validator agrees with the suite
+func (r Result) Passed() bool {
+	return r.Err == nil && r.Valid == r.Expected
+}
+
+// Report lists the results of the tests of a draft
+type Report struct {
+	Draft   string
+	Results []Result
+}
+
+// Passed returns the number of tests passed
+func (r *Report) Passed() int {
+	passed := 0
+	for _, result := range r.Results {
+		if result.Passed() {
+			passed++
+		}
+	}
+	return passed
+}
+
+// Failures returns the results of the tests failed
+func (r *Report) Failures() []Result {
+	var failures []Result
+	for _, result := range r.Results {
+		if !result.Passed() {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// String summarizes the report, one line per failure
+func (r *Report) String() string {
+	percent := 100.0
+	if len(r.Results) > 0 {
+		percent = float64(r.Passed()) * 100 / float64(len(r.Results))
+	}
+	lines := []string{fmt.Sprintf("%s : passed %d of %d tests ( %.2f%% )", r.Draft, r.Passed(), len(r.Results), percent)}
+	for _, failure := range r.Failures() {
+		line := fmt.Sprintf("- %s : %s : %s", failure.File, failure.Case, failure.Test)
+		if failure.Err != nil {
+			line += " : " + failure.Err.Error()
+		} else {
+			line += fmt.Sprintf(" : expects valid=%t", failure.Expected)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// LoadFile reads the cases of a file of the suite
+func LoadFile(filename string) ([]Case, error) {
+	text, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var cases []Case
+	if err := json.Unmarshal(text, &cases); err != nil {
+		return nil, errors.New(fmt.Sprintf("Could not parse %s : %s", filename, err.Error()))
+	}
+	return cases, nil
+}
+
+// RunSuite runs every draft directory ( draft4, draft2019-09... ) of the
+// tests directory of the suite
+func RunSuite(testsDirectory string, options Options) ([]*Report, error) {
+	entries, err := ioutil.ReadDir(testsDirectory)
+	if err != nil {
+		return nil, err
+	}
+	var reports []*Report
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), "draft") {
+			continue
+		}
+		report, err := RunDraft(filepath.Join(testsDirectory, entry.Name()), options)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// RunDraft runs the files of a draft directory, and those of its optional directory
+func RunDraft(draftDirectory string, options Options) (*Report, error) {
+	report := &Report{Draft: filepath.Base(draftDirectory)}
+
+	var filenames []string
+	err := filepath.Walk(draftDirectory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && options.SkipOptional && info.Name() == "optional" {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".json") {
+			filenames = append(filenames, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		cases, err := LoadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		file, _ := filepath.Rel(draftDirectory, filename)
+		for _, testCase := range cases {
+			report.Results = append(report.Results, RunCase(filepath.ToSlash(file), testCase, options)...)
+		}
+	}
+	return report, nil
+}
+
+// RunCase runs the tests of a case, file is reported in the results
+func RunCase(file string, testCase Case, options Options) []Result {
+	results := make([]Result, len(testCase.Tests))
+	for i, test := range testCase.Tests {
+		results[i] = Result{File: file, Case: testCase.Description, Test: test.Description, Expected: test.Valid}
+	}
+
+	schemaDocument, err := compile(testCase.Schema, options)
+	if err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+	for i, test := range testCase.Tests {
+		results[i].Valid, results[i].Err = validate(schemaDocument, test.Data)
+	}
+	return results
+}
+
+func compile(schema interface{}, options Options) (schemaDocument *gojsonschema.JsonSchemaDocument, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New(fmt.Sprintf("Compiling the schema panicked : %v", r))
+		}
+	}()
+	schemaDocument, err = gojsonschema.NewJsonSchemaDocumentWithOptions(schema, options.SchemaOptions)
+	if err != nil {
+		return nil, err
+	}
+	if options.Configure != nil {
+		options.Configure(schemaDocument)
+	}
+	return schemaDocument, nil
+}
+
+func validate(schemaDocument *gojsonschema.JsonSchemaDocument, document interface{}) (valid bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New(fmt.Sprintf("Validating the document panicked : %v", r))
+		}
+	}()
+	return schemaDocument.Validate(document).IsValid(), nil
+}
+
+// ServeRemotes serves the remotes directory of the suite on REMOTES_ADDRESS,
+// where the schemas of the suite reference it, until close is called
+func ServeRemotes(remotesDirectory string) (close func() error, err error) {
+	listener, err := net.Listen("tcp", REMOTES_ADDRESS)
+	if err != nil {
+		return nil, err
+	}
+	server := &http.Server{Handler: http.FileServer(http.Dir(remotesDirectory))}
+	go server.Serve(listener)
+	return server.Close, nil
+}
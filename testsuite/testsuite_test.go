@@ -0,0 +1,101 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the test suite runner.
+//
+// created          16-10-2026
+
+package testsuite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeSuiteFile(t *testing.T, filename string, text string) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunSuite(t *testing.T) {
+
+	tests := t.TempDir()
+	writeSuiteFile(t, filepath.Join(tests, "draft4", "minimum.json"), `[
+		{
+			"description": "minimum validation",
+			"schema": {"minimum": 1.1},
+			"tests": [
+				{"description": "above the minimum is valid", "data": 2.6, "valid": true},
+				{"description": "below the minimum is invalid", "data": 0.6, "valid": false},
+				{"description": "wrong expectation", "data": 0.6, "valid": true}
+			]
+		},
+		{
+			"description": "invalid schema",
+			"schema": {"minimum": "1"},
+			"tests": [
+				{"description": "any document", "data": 1, "valid": true}
+			]
+		}
+	]`)
+	writeSuiteFile(t, filepath.Join(tests, "draft4", "optional", "format.json"), `[
+		{
+			"description": "validation of e-mail addresses",
+			"schema": {"format": "email"},
+			"tests": [
+				{"description": "a valid e-mail address", "data": "joe.bloggs@example.com", "valid": true}
+			]
+		}
+	]`)
+	writeSuiteFile(t, filepath.Join(tests, "README.md"), `not a draft`)
+
+	reports, err := RunSuite(tests, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].Draft != "draft4" {
+		t.Fatalf("Expects a report of draft4, given %v", reports)
+	}
+	report := reports[0]
+	if len(report.Results) != 5 || report.Passed() != 3 {
+		t.Errorf("Expects 3 of 5 tests passed, given %d of %d", report.Passed(), len(report.Results))
+	}
+	failures := report.Failures()
+	if len(failures) != 2 || failures[0].Test != "wrong expectation" || failures[1].Err == nil {
+		t.Errorf("Expects the wrong expectation and the invalid schema to fail, given %v", failures)
+	}
+	if failures[0].File != "minimum.json" || report.Results[4].File != "optional/format.json" {
+		t.Errorf("Expects the files relative to the draft, given %s and %s", failures[0].File, report.Results[4].File)
+	}
+
+	report, err = RunDraft(filepath.Join(tests, "draft4"), Options{SkipOptional: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Results) != 4 {
+		t.Errorf("Expects the optional tests to be skipped, given %d results", len(report.Results))
+	}
+}
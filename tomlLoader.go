@@ -0,0 +1,116 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Loads TOML documents into the JSON data model.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"io/ioutil"
+	"strconv"
+	"time"
+)
+
+// Helper function to read a toml document from bytes
+func GetTomlDocument(content []byte) (interface{}, error) {
+
+	var document map[string]interface{}
+	_, err := toml.Decode(string(content), &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeToml(document)
+}
+
+// Helper function to read a toml from a filepath
+func GetFileToml(filepath string) (interface{}, error) {
+
+	bodyBuff, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetTomlDocument(bodyBuff)
+}
+
+// normalizeToml converts a decoded toml value into the values encoding/json
+// would produce, dates and times become their string representation
+func normalizeToml(value interface{}) (interface{}, error) {
+
+	switch v := value.(type) {
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			normalized, err := normalizeToml(element)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = normalized
+		}
+		return m, nil
+
+	case []map[string]interface{}:
+		a := make([]interface{}, len(v))
+		for i, element := range v {
+			normalized, err := normalizeToml(element)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = normalized
+		}
+		return a, nil
+
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, element := range v {
+			normalized, err := normalizeToml(element)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = normalized
+		}
+		return a, nil
+
+	case int64:
+		// as a json.Number, a float64 would round the integers beyond 2^53
+		return json.Number(strconv.FormatInt(v, 10)), nil
+
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+
+	case bool, string, float64:
+		return v, nil
+
+	case fmt.Stringer:
+		// local dates and times
+		return v.String(), nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("toml value %v of type %T cannot be converted to json", value, value))
+}
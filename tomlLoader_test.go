@@ -0,0 +1,63 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the toml loader.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTomlDocument(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"title":{"type":"string"},"servers":{"items":{"required":["port"],"properties":{"port":{"type":"integer"}}}}}}`)
+
+	document, err := GetTomlDocument([]byte("title = \"cli\"\n\n[[servers]]\nport = 80\n\n[[servers]]\nhost = \"local\"\n"))
+	if err != nil {
+		t.Fatalf("Could not parse document : %s", err.Error())
+	}
+
+	result := schemaDocument.Validate(document)
+	if messages := result.GetErrorMessages(); len(messages) != 1 || messages[0] != "ROOT.servers.1 : port property is required" {
+		t.Errorf("Expects the second server to miss its port, given %v", messages)
+	}
+}
+
+func TestTomlLargeIntegers(t *testing.T) {
+
+	document, err := GetTomlDocument([]byte("id = 9007199254740993\n"))
+	if err != nil {
+		t.Fatalf("Could not parse document : %s", err.Error())
+	}
+
+	if id := document.(map[string]interface{})["id"]; id != json.Number("9007199254740993") {
+		t.Errorf("Expects the id to keep its digits, given %v", id)
+	}
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"id":{"type":"integer","enum":[9007199254740992]}}}`)
+	if result := schemaDocument.Validate(document); result.IsValid() {
+		t.Errorf("Expects 9007199254740993 not to match 9007199254740992")
+	}
+}
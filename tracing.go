@@ -0,0 +1,75 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Tracing hook of the validations.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"io"
+)
+
+// ValidationInfo describes a validation to its tracer
+type ValidationInfo struct {
+	// $id of the root schema, or the reference of the schema document
+	SchemaId string
+	// Size of the json text of the document, -1 when it was given decoded
+	DocumentSize int
+}
+
+// Tracer is called around each validation of a document, see SetTracer
+// Its methods are called by the validating goroutines, concurrently
+type Tracer interface {
+	// Called before the validation, typically to start a span child of ctx,
+	// the returned function is called with the result of the validation
+	StartValidation(ctx context.Context, info ValidationInfo) func(result *ValidationResult)
+}
+
+// Wraps the validations in calls to tracer, nil stops them
+// ValidateContext and ValidateReaderContext pass the context of the caller
+// See the oteltracing package for OpenTelemetry spans
+func (d *JsonSchemaDocument) SetTracer(tracer Tracer) {
+	d.setOptions(func(options *validationOptions) {
+		options.tracer = tracer
+	})
+}
+
+func (d *JsonSchemaDocument) schemaId() string {
+	if d.rootSchema.id != nil {
+		return *d.rootSchema.id
+	}
+	return d.documentReference.String()
+}
+
+// countingReader counts the bytes read
+type countingReader struct {
+	reader io.Reader
+	count  int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += n
+	return n, err
+}
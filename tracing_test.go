@@ -0,0 +1,67 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the tracing hook.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type testTracerKey struct{}
+
+type testTracer struct {
+	spans []string
+}
+
+func (t *testTracer) StartValidation(ctx context.Context, info ValidationInfo) func(result *ValidationResult) {
+	parent, _ := ctx.Value(testTracerKey{}).(string)
+	return func(result *ValidationResult) {
+		t.spans = append(t.spans, strings.Join([]string{parent, info.SchemaId, strconv.Itoa(info.DocumentSize), strconv.Itoa(len(result.GetErrors()))}, " "))
+	}
+}
+
+func TestTracer(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"$id":"http://example.com/user","required":["name"]}`)
+	tracer := &testTracer{}
+	schemaDocument.SetTracer(tracer)
+
+	ctx := context.WithValue(context.Background(), testTracerKey{}, "request")
+	schemaDocument.ValidateContext(ctx, mustParseJson(t, `{}`))
+	schemaDocument.ValidateReaderContext(ctx, strings.NewReader(`{"name":"a"}`))
+	schemaDocument.ValidateBytes([]byte(`{ }`))
+
+	expected := []string{
+		`request http://example.com/user -1 1`,
+		`request http://example.com/user 12 0`,
+		` http://example.com/user 3 1`,
+	}
+	if strings.Join(tracer.spans, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects spans :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(tracer.spans, "\n"))
+	}
+}
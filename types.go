@@ -33,6 +33,10 @@ const (
 	KEY_REF                   = "$ref"
 	KEY_TITLE                 = "title"
 	KEY_DESCRIPTION           = "description"
+	KEY_DEFAULT               = "default"
+	KEY_EXAMPLES              = "examples"
+	KEY_COMMENT               = "$comment"
+	KEY_DEPRECATED            = "deprecated"
 	KEY_TYPE                  = "type"
 	KEY_ITEMS                 = "items"
 	KEY_ADDITIONAL_ITEMS      = "additionalItems"
@@ -48,6 +52,7 @@ const (
 	KEY_MIN_LENGTH            = "minLength"
 	KEY_MAX_LENGTH            = "maxLength"
 	KEY_PATTERN               = "pattern"
+	KEY_FORMAT                = "format"
 	KEY_MIN_PROPERTIES        = "minProperties"
 	KEY_MAX_PROPERTIES        = "maxProperties"
 	KEY_DEPENDENCIES          = "dependencies"
@@ -60,6 +65,22 @@ const (
 	KEY_ANY_OF                = "anyOf"
 	KEY_ALL_OF                = "allOf"
 	KEY_NOT                   = "not"
+	KEY_DISCRIMINATOR         = "discriminator"
+	KEY_PROPERTY_NAME         = "propertyName"
+	KEY_MAPPING               = "mapping"
+	KEY_NULLABLE              = "nullable"
+	KEY_EXAMPLE               = "example"
+	KEY_READ_ONLY             = "readOnly"
+	KEY_WRITE_ONLY            = "writeOnly"
+	KEY_XML                   = "xml"
+	KEY_EXTERNAL_DOCS         = "externalDocs"
+	KEY_OPENAPI               = "openapi"
+	KEY_SWAGGER               = "swagger"
+	KEY_COMPONENTS            = "components"
+	KEY_SCHEMAS               = "schemas"
+
+	KEY_INT_OR_STRING           = "x-kubernetes-int-or-string"
+	KEY_PRESERVE_UNKNOWN_FIELDS = "x-kubernetes-preserve-unknown-fields"
 
 	STRING_STRING                     = "string"
 	STRING_BOOLEAN                    = "boolean"
@@ -89,6 +110,12 @@ const (
 	TYPE_STRING  = `string`
 )
 
+// Enum values listed in the errors, see SetEnumErrorLimit
+const DEFAULT_ENUM_ERROR_LIMIT = 10
+
+// Name of the document in the contexts of the errors, see SetRootName
+const DEFAULT_ROOT_NAME = "ROOT"
+
 var JSON_TYPES []string
 var SCHEMA_TYPES []string
 
@@ -0,0 +1,239 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of untrusted json text within resource limits.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Limits bound the resources an untrusted document may use, a zero limit is unlimited
+type Limits struct {
+	// Size of the json text
+	MaxBytes int
+	// Nesting of the arrays and objects
+	MaxDepth int
+	// Properties of all the objects of the document
+	MaxKeys int
+	// Values of the document : objects, arrays, strings, numbers, booleans and nulls
+	MaxNodes int
+	// Errors reported, the first ones in the document order are kept
+	MaxErrors int
+	// Digits of a number, those of its exponent included
+	MaxNumberDigits int
+	// Absolute value of the exponent of a number, 1e400 has an exponent of 400
+	MaxNumberExponent int
+}
+
+// Limits suitable for the bodies of http requests
+var DefaultLimits = Limits{MaxBytes: 1 << 20, MaxDepth: 64, MaxKeys: 10000, MaxNodes: 100000, MaxErrors: 100, MaxNumberDigits: 1000, MaxNumberExponent: 1000}
+
+// ErrLimitExceeded is wrapped by the errors of the documents exceeding their limits
+var ErrLimitExceeded = errors.New("document exceeds the limits")
+
+// ValidateUntrusted validates json text received from an untrusted source :
+// its size, nesting and number of properties are checked on the raw text
+// before it is decoded, so that oversized documents are rejected cheaply
+// Numbers are decoded as json.Number so that no precision is lost
+func (v *JsonSchemaDocument) ValidateUntrusted(document []byte, limits Limits) (*ValidationResult, error) {
+
+	if err := limits.checkJson(document); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+
+	var documentNode interface{}
+	if err := decoder.Decode(&documentNode); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the json document")
+	}
+
+	// the errors over the limit are dropped before the metrics, statistics and
+	// logs see them
+	options := v.getOptions()
+	if limits.MaxErrors > 0 {
+		limitedOptions := *options
+		limitedOptions.maxErrors = limits.MaxErrors
+		options = &limitedOptions
+	}
+	return v.validateWithOptions(context.Background(), documentNode, len(document), options), nil
+}
+
+// checkJson checks the size of json text, then scans it for its nesting depth,
+// number of object keys and of values, without decoding it : the text may be
+// invalid json, the decoder reports it afterwards
+func (limits Limits) checkJson(text []byte) error {
+	if limits.MaxBytes > 0 && len(text) > limits.MaxBytes {
+		return fmt.Errorf("%w: %d bytes, the maximum is %d", ErrLimitExceeded, len(text), limits.MaxBytes)
+	}
+	if limits.MaxDepth <= 0 && limits.MaxKeys <= 0 && limits.MaxNodes <= 0 && limits.MaxNumberDigits <= 0 && limits.MaxNumberExponent <= 0 {
+		return nil
+	}
+
+	// the keys are strings, they are not counted as values
+	depth, keys, nodes := 0, 0, 0
+	inString, escaped, inLiteral := false, false, false
+	literalStart := 0
+	for i, c := range text {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+		// numbers, true, false and null
+		isLiteral := c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if isLiteral && !inLiteral {
+			nodes++
+			literalStart = i
+		}
+		if !isLiteral && inLiteral {
+			if err := limits.checkNumber(text[literalStart:i]); err != nil {
+				return err
+			}
+		}
+		inLiteral = isLiteral
+
+		switch c {
+		case '"':
+			inString = true
+			nodes++
+		case '{', '[':
+			nodes++
+			depth++
+			if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+				return fmt.Errorf("%w: nested deeper than %d", ErrLimitExceeded, limits.MaxDepth)
+			}
+		case '}', ']':
+			depth--
+		case ':':
+			// each key of an object is followed by a colon
+			keys++
+			if limits.MaxKeys > 0 && keys > limits.MaxKeys {
+				return fmt.Errorf("%w: more than %d keys", ErrLimitExceeded, limits.MaxKeys)
+			}
+		}
+		if limits.MaxNodes > 0 && nodes-keys > limits.MaxNodes {
+			return fmt.Errorf("%w: more than %d values", ErrLimitExceeded, limits.MaxNodes)
+		}
+	}
+	if inLiteral {
+		return limits.checkNumber(text[literalStart:])
+	}
+	return nil
+}
+
+// checkNumber checks the digits and the exponent of a literal, the exact value
+// of a number with a large exponent is expensive to compute
+func (limits Limits) checkNumber(literal []byte) error {
+	if len(literal) == 0 || literal[0] != '-' && (literal[0] < '0' || literal[0] > '9') {
+		return nil
+	}
+
+	digits := 0
+	for _, c := range literal {
+		if c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	if limits.MaxNumberDigits > 0 && digits > limits.MaxNumberDigits {
+		return fmt.Errorf("%w: a number has more than %d digits", ErrLimitExceeded, limits.MaxNumberDigits)
+	}
+
+	if limits.MaxNumberExponent > 0 {
+		if e := bytes.IndexAny(literal, "eE"); e != -1 {
+			exponent := strings.TrimLeft(strings.TrimLeft(string(literal[e+1:]), "+-"), "0")
+			if len(exponent) > len(strconv.Itoa(limits.MaxNumberExponent)) {
+				return fmt.Errorf("%w: a number has an exponent greater than %d", ErrLimitExceeded, limits.MaxNumberExponent)
+			}
+			if value, err := strconv.Atoi(exponent); err == nil && value > limits.MaxNumberExponent {
+				return fmt.Errorf("%w: a number has an exponent greater than %d", ErrLimitExceeded, limits.MaxNumberExponent)
+			}
+		}
+	}
+	return nil
+}
+
+// readLimited reads at most maxBytes of reader, unlimited when 0
+func readLimited(reader io.Reader, maxBytes int) ([]byte, error) {
+	if maxBytes <= 0 {
+		return ioutil.ReadAll(reader)
+	}
+	text, err := ioutil.ReadAll(io.LimitReader(reader, int64(maxBytes)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(text) > maxBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrLimitExceeded, maxBytes)
+	}
+	return text, nil
+}
+
+// readFileLimited reads a file of at most maxBytes, unlimited when 0, larger
+// files are refused from their size without being read
+func readFileLimited(filename string, maxBytes int) ([]byte, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	return readOpenedFileLimited(file, maxBytes)
+}
+
+// readFSFileLimited is readFileLimited for the files of fsys
+func readFSFileLimited(fsys fs.FS, name string, maxBytes int) ([]byte, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return readOpenedFileLimited(file, maxBytes)
+}
+
+func readOpenedFileLimited(file fs.File, maxBytes int) ([]byte, error) {
+	defer file.Close()
+
+	if info, err := file.Stat(); err == nil && maxBytes > 0 && info.Size() > int64(maxBytes) {
+		return nil, fmt.Errorf("%w: %d bytes, the maximum is %d", ErrLimitExceeded, info.Size(), maxBytes)
+	}
+	return readLimited(file, maxBytes)
+}
@@ -0,0 +1,178 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the validation of untrusted documents.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateUntrusted(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"items":{"type":"integer"}}`)
+	limits := Limits{MaxBytes: 64, MaxDepth: 3, MaxKeys: 2, MaxErrors: 2}
+
+	tests := []struct {
+		document string
+		errors   int
+		exceeded bool
+	}{
+		{`[1,2,3]`, 0, false},
+		{`["a","b","c"]`, 2, false},
+		{`[[[1]]]`, 1, false},
+		{`[[[[1]]]]`, 0, true},
+		{`[{"a":1,"b":2}]`, 1, false},
+		{`[{"a":1,"b":2,"c":3}]`, 0, true},
+		// brackets and colons within strings are not counted
+		{`["[[[[:::"]`, 1, false},
+		{`["\\\"[[[[:::"]`, 1, false},
+		{`[` + strings.Repeat(`1,`, 40) + `1]`, 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := schemaDocument.ValidateUntrusted([]byte(test.document), limits)
+		if test.exceeded {
+			if !errors.Is(err, ErrLimitExceeded) {
+				t.Errorf("Expects %s to exceed the limits, given %v", test.document, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Could not validate %s : %s", test.document, err.Error())
+			continue
+		}
+		if len(result.GetErrors()) != test.errors {
+			t.Errorf("Expects %d errors for %s, given %v", test.errors, test.document, result.GetErrorMessages())
+		}
+	}
+
+	if _, err := schemaDocument.ValidateUntrusted([]byte(`[1] [2]`), limits); err == nil {
+		t.Errorf("Expects the data after the document to be refused")
+	}
+
+	// the errors over the limit are not counted
+	statistics := NewKeywordStatistics()
+	schemaDocument.SetKeywordStatistics(statistics)
+	if _, err := schemaDocument.ValidateUntrusted([]byte(`["a","b","c"]`), limits); err != nil {
+		t.Fatal(err)
+	}
+	if failures := statistics.Failures(); len(failures) != 1 || failures[0].Count != 2 {
+		t.Errorf("Expects 2 counted errors, given %v", failures)
+	}
+}
+
+func TestValidateUntrustedLargeExponents(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"items":{"type":"number"}}`)
+	document := []byte(`[` + strings.Repeat(`1e999999,`, 99) + `1e999999]`)
+
+	if _, err := schemaDocument.ValidateUntrusted(document, DefaultLimits); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the exponents to exceed the limits, given %v", err)
+	}
+	if _, err := schemaDocument.ValidateUntrusted([]byte(`[1e1000,-1.5E-1000,`+strings.Repeat("9", 1000)+`]`), DefaultLimits); err != nil {
+		t.Errorf("Expects the numbers within the limits to be validated, given %v", err)
+	}
+	if _, err := schemaDocument.ValidateUntrusted([]byte(`[`+strings.Repeat("9", 1001)+`]`), DefaultLimits); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the digits to exceed the limits, given %v", err)
+	}
+
+	// without numeric keywords the exact values are not computed
+	start := time.Now()
+	result, err := schemaDocument.ValidateUntrusted(append(document[:len(document)-1], []byte(`,1e9999999]`)...), Limits{})
+	if err != nil || !result.IsValid() {
+		t.Errorf("Expects the numbers to be valid, given %v %v", err, result)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expects the numbers not to be evaluated, took %s", elapsed)
+	}
+}
+
+func TestLimitsNodes(t *testing.T) {
+
+	limits := Limits{MaxNodes: 6}
+	tests := []struct {
+		text     string
+		exceeded bool
+	}{
+		// the array, the object, "a", 1.5e3, true, null
+		{`[{"k":"a"}, 1.5e3, true, null]`, false},
+		{`[{"k":"a"}, 1.5e3, true, null, -2]`, true},
+		// the keys are not values
+		{`{"a":{"b":{"c":{"d":{"e":1}}}}}`, false},
+		{`{"a":[],"b":[],"c":[],"d":[],"e":[],"f":[]}`, true},
+	}
+	for _, test := range tests {
+		err := limits.checkJson([]byte(test.text))
+		if errors.Is(err, ErrLimitExceeded) != test.exceeded {
+			t.Errorf("Expects %s to exceed the limits : %t, given %v", test.text, test.exceeded, err)
+		}
+	}
+}
+
+func TestLoadWithLimits(t *testing.T) {
+
+	schema := `{"properties":{"name":{"type":"string"},"age":{"type":"integer"}}}`
+	limits := Limits{MaxBytes: len(schema)}
+
+	filename := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(filename, []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(schema))
+	}))
+	defer server.Close()
+
+	if _, err := GetFileJsonWithLimits(filename, limits); err != nil {
+		t.Errorf("Could not load the file : %s", err.Error())
+	}
+	if _, err := GetHttpJsonWithLimits(server.URL, limits); err != nil {
+		t.Errorf("Could not load the url : %s", err.Error())
+	}
+
+	limits = Limits{MaxBytes: len(schema) - 1}
+	if _, err := GetFileJsonWithLimits(filename, limits); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the file to exceed the limits, given %v", err)
+	}
+	if _, err := GetHttpJsonWithLimits(server.URL, limits); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the url to exceed the limits, given %v", err)
+	}
+	if _, err := GetHttpJsonWithLimits(server.URL, Limits{MaxKeys: 3}); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the url to exceed the limits, given %v", err)
+	}
+	if _, err := NewJsonSchemaDocumentWithOptions("file://"+filename, SchemaOptions{Limits: limits}); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the schema to exceed the limits, given %v", err)
+	}
+	if _, err := NewJsonSchemaDocumentWithOptions(server.URL+"/schema.json", SchemaOptions{Limits: Limits{MaxDepth: 2}}); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Expects the schema to exceed the limits, given %v", err)
+	}
+}
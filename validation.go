@@ -26,61 +26,650 @@
 package gojsonschema
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
-	"regexp"
+	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
+// ErrInvalidDocument is wrapped by the error returned from AsError, so that
+// callers can test for a validation failure with errors.Is
+var ErrInvalidDocument = errors.New("document is not valid")
+
+// ResultError describes one validation failure and the context it occured in
+type ResultError struct {
+	Context     string
+	Keyword     string
+	Description string
+	Type        ErrorType
+	// SEVERITY_WARNING for the findings of GetWarnings
+	Severity Severity
+
+	// Set when more than one branch of a oneOf validates
+	MatchedBranches []MatchedBranch
+
+	// Declared property an additional property is likely a typo of
+	Suggestion string
+
+	// Json of the values of the enum not matched, shared with the schema : not to be modified
+	EnumValues []string
+
+	annotation string
+	context    *jsonContext
+	// schema where the error occurred, set when counting keyword statistics
+	schema *jsonSchema
+
+	// Context and Description are formatted when the error is first read,
+	// most errors of the anyOf / oneOf branches are discarded unread
+	pending bool
+	format  string
+	args    []interface{}
+}
+
+// Fills Context and Description from the context and the message format
+func (e *ResultError) formatMessage() {
+	if !e.pending {
+		return
+	}
+	e.Context = e.context.String()
+	if e.args != nil {
+		e.Description = fmt.Sprintf(e.format, e.args...)
+	} else {
+		e.Description = e.format
+	}
+	e.pending, e.format, e.args = false, "", nil
+}
+
+// MatchedBranch identifies a sub-schema of a oneOf by its index, $id and title
+type MatchedBranch struct {
+	Index int
+	Id    string
+	Title string
+}
+
+// BranchMatch is the anyOf / oneOf branch validated by a location of the document
+type BranchMatch struct {
+	Context string
+	Keyword string
+	MatchedBranch
+}
+
+type branchMatch struct {
+	context *jsonContext
+	keyword string
+	branch  MatchedBranch
+}
+
+func newMatchedBranch(index int, schema *jsonSchema) MatchedBranch {
+	branch := MatchedBranch{Index: index}
+	if schema.id != nil {
+		branch.Id = *schema.id
+	}
+	if schema.title != nil {
+		branch.Title = *schema.title
+	}
+	return branch
+}
+
+// Pointer returns the json pointer of the location of the error in the
+// document, ex: /items/0 for ROOT.items.0
+func (e *ResultError) Pointer() string {
+	return e.context.pointer()
+}
+
+// Tokens returns the location of the error in the document as the property
+// names and array indexes leading to it, ex: [items 0] for ROOT.items.0
+func (e *ResultError) Tokens() []string {
+	if e.context == nil {
+		return nil
+	}
+	return e.context.tokens()[1:]
+}
+
+// Path returns the location of the error in the document like Tokens, with the
+// array indexes as int, ex: [items 0] for ROOT.items.0 where 0 is an int
+func (e *ResultError) Path() []interface{} {
+	return e.context.path()
+}
+
+func (e *ResultError) Error() string {
+	e.formatMessage()
+	message := fmt.Sprintf("%v : %v", e.Context, e.Description)
+	if e.annotation != "" {
+		message = e.annotation + ` ` + message
+	}
+	return message
+}
+
+// Annotation is an information collected on a location of the document,
+// it does not make the document invalid
+type Annotation struct {
+	Context string
+	Keyword string
+	Value   interface{}
+	// Why the document does not satisfy the keyword, when the keyword is an
+	// assertion only collected as an annotation ( format )
+	Description string
+
+	context *jsonContext
+}
+
 type ValidationResult struct {
-	errorMessages []string
+	resultErrors []*ResultError
+	warnings     []*ResultError
+	annotations  []*Annotation
 
 	// Scores how well the validation matched.  Useful in generating
 	// better error messages for anyOf and oneOf.
-	score         int
+	score int
+
+	options *validationOptions
+
+	// hierarchy : the results of the sub-schemas that failed
+	keyword  string
+	location string
+	context  *jsonContext
+	children []*ValidationResult
+
+	// rendering of the locations, see SetPathFormat
+	pathFormat PathFormat
+
+	// anyOf / oneOf branches validated
+	branchMatches []branchMatch
+
+	// keyword evaluations, when tracing
+	trace          *EvaluationTrace
+	failedKeywords []string
 }
 
 func (v *ValidationResult) IsValid() bool {
-	return len(v.errorMessages) == 0
+	return len(v.resultErrors) == 0
 }
 
 func (v *ValidationResult) GetErrorMessages() []string {
-	return v.errorMessages
+	var errorMessages []string
+	for _, resultError := range v.GetErrors() {
+		errorMessages = append(errorMessages, resultError.Error())
+	}
+	return errorMessages
+}
+
+func (v *ValidationResult) GetErrors() []*ResultError {
+	for _, resultError := range v.resultErrors {
+		resultError.formatMessage()
+	}
+	return v.resultErrors
+}
+
+func (v *ValidationResult) GetAnnotations() []*Annotation {
+	return v.annotations
+}
+
+// GetMatchedBranches returns the anyOf / oneOf branches the document validated,
+// sorted by location, to dispatch the processing of a document on its variant
+// An anyOf reports its first valid branch, the following ones are not evaluated
+func (v *ValidationResult) GetMatchedBranches() []BranchMatch {
+	var matches []BranchMatch
+	for _, match := range v.branchMatches {
+		matches = append(matches, BranchMatch{Context: v.formatPath(match.context), Keyword: match.keyword, MatchedBranch: match.branch})
+	}
+	return matches
+}
+
+func (v *ValidationResult) addBranchMatch(context *jsonContext, keyword string, branch MatchedBranch) {
+	v.branchMatches = append(v.branchMatches, branchMatch{context: context, keyword: keyword, branch: branch})
+}
+
+// Keeps what the validated branch of an anyOf / oneOf collected, its result is discarded
+func (v *ValidationResult) mergeValidBranch(branchResult *ValidationResult) {
+	v.warnings = append(v.warnings, branchResult.warnings...)
+	v.annotations = append(v.annotations, branchResult.annotations...)
+	v.branchMatches = append(v.branchMatches, branchResult.branchMatches...)
+}
+
+// GetAnnotationsByContext groups the annotations by the location of the document they apply to
+func (v *ValidationResult) GetAnnotationsByContext() map[string][]*Annotation {
+	annotations := make(map[string][]*Annotation)
+	for _, annotation := range v.annotations {
+		annotations[annotation.Context] = append(annotations[annotation.Context], annotation)
+	}
+	return annotations
+}
+
+func (v *ValidationResult) addAnnotation(context *jsonContext, keyword string, value interface{}, description string) {
+	v.annotations = append(v.annotations, &Annotation{Context: v.formatPath(context), Keyword: keyword, Value: value, Description: description, context: context})
+}
+
+// Adds the title, description, default and examples of a schema applied to a location
+func (v *ValidationResult) addSchemaAnnotations(schema *jsonSchema, context *jsonContext) {
+	if schema.title != nil {
+		v.addAnnotation(context, KEY_TITLE, *schema.title, "")
+	}
+	if schema.description != nil {
+		v.addAnnotation(context, KEY_DESCRIPTION, *schema.description, "")
+	}
+	if schema.hasDefault {
+		v.addAnnotation(context, KEY_DEFAULT, copyJson(schema.defaultValue), "")
+	}
+	if schema.examples != nil {
+		v.addAnnotation(context, KEY_EXAMPLES, copyJson(schema.examples), "")
+	}
+}
+
+// Error makes a ValidationResult usable as an error, it joins all the error messages
+func (v *ValidationResult) Error() string {
+	return strings.Join(v.GetErrorMessages(), "\n")
+}
+
+// Unwrap exposes every ResultError to errors.Is and errors.As
+func (v *ValidationResult) Unwrap() []error {
+	var errs []error
+	for _, resultError := range v.GetErrors() {
+		errs = append(errs, resultError)
+	}
+	return errs
+}
+
+// AsError returns nil when the document is valid, otherwise an error wrapping
+// ErrInvalidDocument and every ResultError
+func (v *ValidationResult) AsError() error {
+	if v.IsValid() {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidDocument, errors.Join(v.Unwrap()...))
+}
+
+// Keyword of the parent schema that lead to this result ( items, anyOf, properties... )
+func (v *ValidationResult) Keyword() string {
+	return v.keyword
+}
+
+// Location of this result within its parent : a property name, an array index
+// or a sub-schema index
+func (v *ValidationResult) Location() string {
+	return v.location
+}
+
+// Children returns the results of the failed sub-schema validations, in the
+// order they were validated, so the failure tree can be walked
+func (v *ValidationResult) Children() []*ValidationResult {
+	return v.children
+}
+
+// Child returns the result of the failed sub-schema validation of the keyword
+// at the location ( ex: items and 2, oneOf and 0 ), nil when it validated
+func (v *ValidationResult) Child(keyword string, location string) *ValidationResult {
+	for _, child := range v.children {
+		if child.keyword == keyword && child.location == location {
+			return child
+		}
+	}
+	return nil
+}
+
+// Context returns the location in the document this result applies to, ex: ROOT.items.2
+func (v *ValidationResult) Context() string {
+	return v.formatPath(v.context)
+}
+
+// Pointer returns the json pointer of the location in the document this result applies to, ex: /items/2
+func (v *ValidationResult) Pointer() string {
+	return v.context.pointer()
+}
+
+// Score tells how well the document matched the schema, the ScoringStrategy
+// adds to it for each group of keywords checked and for each error, so that
+// with DefaultScoringStrategy it grows with the constraints satisfied and
+// drops with the errors reported
+// The score of a result includes those of its sub-schemas, for an anyOf / oneOf
+// only the best branch counts. Scores are only comparable between results of
+// the same scoring strategy, the highest being the closest match : when no
+// branch validates, the errors of the highest scored one are reported
+func (v *ValidationResult) Score() int {
+	return v.score
 }
 
 // Used to copy errors from a sub-schema validation to the main one
 func (v *ValidationResult) Merge(otherResult *ValidationResult) {
-	v.errorMessages = append(v.errorMessages, otherResult.GetErrorMessages()...)
+	v.resultErrors = append(v.resultErrors, otherResult.resultErrors...)
+	v.warnings = append(v.warnings, otherResult.warnings...)
+	v.annotations = append(v.annotations, otherResult.annotations...)
+	v.branchMatches = append(v.branchMatches, otherResult.branchMatches...)
 	v.score += otherResult.score
 }
 
-func (v *ValidationResult) MergeWithAnnotation(otherResult *ValidationResult, annotation string) {
-	for _, errorMessage := range otherResult.GetErrorMessages() {
-		v.errorMessages = append(v.errorMessages, annotation+` `+ errorMessage)
+// Merges a sub-schema validation and keeps it as a child of the main one,
+// a valid child is not kept and must not be used afterwards
+func (v *ValidationResult) mergeChild(childResult *ValidationResult, keyword string, location string) {
+	v.Merge(childResult)
+	v.addChild(childResult, keyword, location)
+	if childResult.IsValid() {
+		releaseValidationResult(childResult)
+	} else {
+		v.traceFailure(keyword)
 	}
+}
+
+// Keeps a sub-schema validation as a child of the main one, without copying its errors
+func (v *ValidationResult) addChild(childResult *ValidationResult, keyword string, location string) {
+	if childResult.IsValid() {
+		return
+	}
+	childResult.keyword = keyword
+	childResult.location = location
+	v.children = append(v.children, childResult)
+}
+
+// Deprecated: the annotation only prefixes the error messages, sub-schema
+// validations are available as a tree through Children
+func (v *ValidationResult) MergeWithAnnotation(otherResult *ValidationResult, annotation string) {
+	v.appendErrorsWithAnnotation(otherResult, annotation)
 	v.score += otherResult.score
 }
 
+// Copies the errors of a sub-schema validation, leaves the score untouched
+func (v *ValidationResult) appendErrorsWithAnnotation(otherResult *ValidationResult, annotation string) {
+	for _, resultError := range otherResult.resultErrors {
+		annotatedError := *resultError
+		if annotatedError.annotation != "" {
+			annotatedError.annotation = annotation + ` ` + annotatedError.annotation
+		} else {
+			annotatedError.annotation = annotation
+		}
+		v.resultErrors = append(v.resultErrors, &annotatedError)
+	}
+}
+
+// Copies the errors of every branch of an anyOf / oneOf, each annotated with its
+// branch index, only the score of the best branch is kept
+func (v *ValidationResult) mergeBranches(branchResults []*ValidationResult, bestResult *ValidationResult, keyword string) {
+	for i, branchResult := range branchResults {
+		v.appendErrorsWithAnnotation(branchResult, fmt.Sprintf("%s[%d]", keyword, i))
+		v.addChild(branchResult, keyword, strconv.Itoa(i))
+	}
+	if bestResult != nil {
+		v.score += bestResult.score
+	}
+}
+
+// ScoringStrategy scores how well a document matches a schema, when no anyOf /
+// oneOf branch validates, the errors of the best scored branch are reported
+type ScoringStrategy interface {
+	// Added to the score each time a group of keywords is checked
+	StepScore() int
+	// Added to the score each time an error is reported for the keyword
+	ErrorScore(keyword string) int
+}
+
+// DefaultScoringStrategy gives +1 per step and -2 per error, so that a step
+// reporting an error nets -1
+type DefaultScoringStrategy struct{}
+
+func (DefaultScoringStrategy) StepScore() int {
+	return 1
+}
+
+func (DefaultScoringStrategy) ErrorScore(keyword string) int {
+	return -2
+}
+
 func (v *ValidationResult) IncrementScore() {
-	v.score++
+	v.score += v.options.scoring().StepScore()
+}
+
+// Sorts the errors and the warnings by context, then keyword and description, so
+// that two validations of the same document always report them in the same order
+func (v *ValidationResult) sortErrors() {
+	sortResultErrors(v.resultErrors)
+	sortResultErrors(v.warnings)
 }
 
-func (v *ValidationResult) addErrorMessage(context *jsonContext, message string) {
-	fullMessage := fmt.Sprintf("%v : %v", context, message)
-	v.errorMessages = append(v.errorMessages, fullMessage)
-	v.score -= 2 // results in a net -1 when added to the +1 we get at the end of the validation function
+func sortResultErrors(resultErrors []*ResultError) {
+	sort.SliceStable(resultErrors, func(i, j int) bool {
+		a, b := resultErrors[i], resultErrors[j]
+		if c := a.context.compare(b.context); c != 0 {
+			return c < 0
+		}
+		if a.Keyword != b.Keyword {
+			return a.Keyword < b.Keyword
+		}
+		// only the errors of the same context and keyword are formatted
+		return a.Error() < b.Error()
+	})
+}
+
+// Sorts the annotations and the matched branches by context, those of a context
+// stay in the order they were collected
+func (v *ValidationResult) sortAnnotations() {
+	sort.SliceStable(v.annotations, func(i, j int) bool {
+		return v.annotations[i].context.compare(v.annotations[j].context) < 0
+	})
+	sort.SliceStable(v.branchMatches, func(i, j int) bool {
+		return v.branchMatches[i].context.compare(v.branchMatches[j].context) < 0
+	})
+}
+
+// Removes the errors and warnings reported more than once, which happens when
+// several sub-schemas ( allOf, anyOf... ) share the same constraints
+// The errors must be sorted beforehand
+func (v *ValidationResult) removeDuplicateErrors() {
+	v.resultErrors = uniqueResultErrors(v.resultErrors)
+	v.warnings = uniqueResultErrors(v.warnings)
+}
+
+func uniqueResultErrors(resultErrors []*ResultError) []*ResultError {
+	var uniqueErrors []*ResultError
+	for i, resultError := range resultErrors {
+		if i > 0 {
+			previousError := resultErrors[i-1]
+			if previousError.Keyword == resultError.Keyword && previousError.context.compare(resultError.context) == 0 && previousError.Error() == resultError.Error() {
+				continue
+			}
+		}
+		uniqueErrors = append(uniqueErrors, resultError)
+	}
+	return uniqueErrors
+}
+
+func (v *ValidationResult) addErrorMessage(context *jsonContext, keyword string, message string) *ResultError {
+	return v.addError(context, keyword, message)
+}
+
+// Adds an error whose description is formatted with fmt.Sprintf when it is read,
+// the arguments must not be modified afterwards
+func (v *ValidationResult) addError(context *jsonContext, keyword string, format string, args ...interface{}) *ResultError {
+	resultError := &ResultError{Keyword: keyword, Type: keywordErrorTypes[keyword], context: context, pending: true, format: format, args: args}
+	// the error of an ignored keyword is not kept
+	if v.options.ignores(keyword) {
+		return resultError
+	}
+	v.resultErrors = append(v.resultErrors, resultError)
+	v.score += v.options.scoring().ErrorScore(keyword)
+	v.traceFailure(keyword)
+	return resultError
+}
+
+// The results of the sub-schema validations are reused once they are no longer referenced
+var validationResultPool = sync.Pool{
+	New: func() interface{} {
+		return &ValidationResult{}
+	},
+}
+
+func newValidationResult(options *validationOptions) *ValidationResult {
+	result := validationResultPool.Get().(*ValidationResult)
+	result.options = options
+	return result
+}
+
+// Makes a result available for reuse, nothing must reference it or its errors anymore
+func releaseValidationResult(result *ValidationResult) {
+	clear(result.resultErrors)
+	clear(result.warnings)
+	clear(result.annotations)
+	*result = ValidationResult{resultErrors: result.resultErrors[:0], warnings: result.warnings[:0], annotations: result.annotations[:0], branchMatches: result.branchMatches[:0], failedKeywords: result.failedKeywords[:0]}
+	validationResultPool.Put(result)
 }
 
 func (v *JsonSchemaDocument) Validate(document interface{}) *ValidationResult {
-	result := &ValidationResult{}
-	context := consJsonContext("ROOT", nil)
+	return v.ValidateContext(context.Background(), document)
+}
+
+// ValidateContext validates the document, ctx is the parent of the span started
+// by the tracer of the document, see SetTracer
+func (v *JsonSchemaDocument) ValidateContext(ctx context.Context, document interface{}) *ValidationResult {
+	return v.validateWithOptions(ctx, document, -1, v.getOptions())
+}
+
+// ValidateAndSanitize validates a copy of the document from which the properties
+// not allowed by the schema are removed, and returns this sanitized copy
+func (v *JsonSchemaDocument) ValidateAndSanitize(document interface{}) (interface{}, *ValidationResult) {
+	options := *v.getOptions()
+	options.removeAdditional = true
+	sanitizedDocument := copyJson(document)
+	return sanitizedDocument, v.validateWithOptions(context.Background(), sanitizedDocument, -1, &options)
+}
+
+// ValidateIgnoring validates the document without checking the keywords, as if
+// they were not in the schemas ( ex: required for the body of a PATCH ), in
+// addition to those of SetIgnoredKeywords
+func (v *JsonSchemaDocument) ValidateIgnoring(document interface{}, keywords ...string) *ValidationResult {
+	return v.validateWithOptions(context.Background(), document, -1, v.getOptions().ignoring(keywords))
+}
+
+// ValidateInto validates a raw json document and, when it is valid, unmarshals
+// it into target ( a pointer, as for json.Unmarshal )
+// The error is either a json decoding error or the validation result as an error
+func (v *JsonSchemaDocument) ValidateInto(document []byte, target interface{}) (*ValidationResult, error) {
+
+	var documentNode interface{}
+	err := json.Unmarshal(document, &documentNode)
+	if err != nil {
+		return nil, err
+	}
+
+	options := v.getOptions()
+	result := v.validateWithOptions(context.Background(), documentNode, len(document), options)
+	if !result.IsValid() {
+		return result, result.AsError()
+	}
+
+	// defaults and removed properties are only in the validated document
+	if options.useDefaults || options.removeAdditional {
+		document, err = json.Marshal(documentNode)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, json.Unmarshal(document, target)
+}
+
+// ValidateMergePatch applies a json merge patch ( RFC 7396 ) to a copy of the
+// document and validates the merged document
+func (v *JsonSchemaDocument) ValidateMergePatch(document interface{}, patch []byte) (interface{}, *ValidationResult, error) {
+
+	var patchNode interface{}
+	err := json.Unmarshal(patch, &patchNode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isJsonValue(document) {
+		document, err = toJsonValue(document)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	merged := applyJsonMergePatch(document, patchNode)
+	return merged, v.Validate(merged), nil
+}
+
+// ValidateBytes decodes a json document and validates it
+// Numbers are decoded as json.Number so that no precision is lost
+func (v *JsonSchemaDocument) ValidateBytes(document []byte) (*ValidationResult, error) {
+	return v.ValidateReader(bytes.NewReader(document))
+}
+
+// ValidateReader reads a json document and validates it
+// Numbers are decoded as json.Number so that no precision is lost
+func (v *JsonSchemaDocument) ValidateReader(reader io.Reader) (*ValidationResult, error) {
+	return v.ValidateReaderContext(context.Background(), reader)
+}
+
+// ValidateReaderContext is ValidateReader with the parent context of the tracer span
+func (v *JsonSchemaDocument) ValidateReaderContext(ctx context.Context, reader io.Reader) (*ValidationResult, error) {
+
+	// the size of the document is traced
+	countingReader := &countingReader{reader: reader}
+	decoder := json.NewDecoder(countingReader)
+	decoder.UseNumber()
+
+	var documentNode interface{}
+	err := decoder.Decode(&documentNode)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the json document")
+	}
+
+	return v.validateWithOptions(ctx, documentNode, countingReader.count, v.getOptions()), nil
+}
+
+// documentSize is the size of the json text of the document, -1 when unknown
+func (v *JsonSchemaDocument) validateWithOptions(ctx context.Context, document interface{}, documentSize int, options *validationOptions) (result *ValidationResult) {
+	if options != nil && options.tracer != nil {
+		end := options.tracer.StartValidation(ctx, ValidationInfo{SchemaId: v.schemaId(), DocumentSize: documentSize})
+		defer func() {
+			end(result)
+		}()
+	}
+
+	var start time.Time
+	if options != nil && options.metrics != nil {
+		start = time.Now()
+	}
+
+	options = options.withTrace()
+	result = &ValidationResult{options: options}
+	if options != nil {
+		result.trace = options.evaluationTrace
+	}
+	context := options.rootContext()
+	result.context = context
 	v.rootSchema.validateRecursive(v.rootSchema, document, result, context)
+	result.sortErrors()
+	result.removeDuplicateErrors()
+	result.sortAnnotations()
+	if options != nil && options.maxErrors > 0 && len(result.resultErrors) > options.maxErrors {
+		result.resultErrors = result.resultErrors[:options.maxErrors]
+	}
+
+	if options != nil && options.metrics != nil {
+		result.observe(options.metrics, start)
+	}
+	if options != nil && options.keywordStatistics != nil {
+		options.keywordStatistics.add(result)
+	}
+	if options != nil && options.logger != nil && !result.IsValid() {
+		result.log(ctx, options.logger, v.schemaId())
+	}
 	return result
 }
 
-func (v *jsonSchema) Validate(document interface{}, context *jsonContext) *ValidationResult {
-	result := &ValidationResult{}
+func (v *jsonSchema) Validate(document interface{}, context *jsonContext, options *validationOptions) *ValidationResult {
+	result := newValidationResult(options)
+	result.context = context
 	v.validateRecursive(v, document, result, context)
 	return result
 }
@@ -88,120 +677,165 @@ func (v *jsonSchema) Validate(document interface{}, context *jsonContext) *Valid
 // Walker function to validate the json recursively against the schema
 func (v *jsonSchema) validateRecursive(currentSchema *jsonSchema, currentNode interface{}, result *ValidationResult, context *jsonContext) {
 
+	if result.countingFailures() {
+		defer result.locateErrors(currentSchema, len(result.resultErrors))
+	}
+
+	if result.tracing() || result.hooked() {
+		failedFrom := len(result.failedKeywords)
+		var outcomes []keywordOutcome
+		if result.hooked() {
+			outcomes = result.beforeKeywords(currentSchema, currentNode, context)
+		}
+		defer func() {
+			// the failures of the referenced schema make the $ref fail
+			if currentSchema.refSchema != nil && len(result.failedKeywords) > failedFrom {
+				result.traceFailure(KEY_REF)
+			}
+			if result.tracing() {
+				result.traceSchema(currentSchema, context, failedFrom)
+			}
+			result.afterKeywords(outcomes, failedFrom)
+		}()
+	}
+
 	// Handle referenced schemas, returns directly when a $ref is found
 	if currentSchema.refSchema != nil {
-		v.validateRecursive(currentSchema.refSchema, currentNode, result, context)
+		if !result.options.ignores(KEY_REF) {
+			v.validateRecursive(currentSchema.refSchema, currentNode, result, context)
+		}
 		return
 	}
 
-	// Check for null value
-	if currentNode == nil {
-		if currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_NULL) {
-			result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
+	if currentSchema.deprecated {
+		result.addWarning(context, KEY_DEPRECATED, "%s is deprecated", currentSchema.property)
+	}
+	result.checkAccessMode(currentSchema, context)
+
+	// Go values ( structs, typed maps and slices... ) are validated as their json equivalent
+	if !isJsonValue(currentNode) {
+		jsonNode, err := toJsonValue(currentNode)
+		if err != nil {
+			result.addErrorMessage(context, KEY_TYPE, err.Error()).Type = ERROR_TYPE_INVALID_VALUE
 			return
 		}
+		currentNode = jsonNode
+	}
 
-		currentSchema.validateSchema(currentSchema, currentNode, result, context)
-		v.validateCommon(currentSchema, currentNode, result, context)
-	} else { // Not null value :
-
-		rValue := reflect.ValueOf(currentNode)
-		rKind := rValue.Kind()
-
-		switch rKind {
-
-		// Slice => JSON array
-
-		case reflect.Slice:
+	checksType := currentSchema.types.HasTypeInSchema() && !result.options.ignores(KEY_TYPE)
+	typeError := func() {
+		result.addError(context, KEY_TYPE, ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String())
+	}
 
-			if currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_ARRAY) {
-				result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
-				return
-			}
+	switch value := currentNode.(type) {
 
-			castCurrentNode := currentNode.([]interface{})
+	case nil:
+		if checksType && !currentSchema.types.HasType(TYPE_NULL) {
+			typeError()
+			return
+		}
 
-			currentSchema.validateSchema(currentSchema, castCurrentNode, result, context)
+		currentSchema.validateSchema(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
 
-			v.validateArray(currentSchema, castCurrentNode, result, context)
-			v.validateCommon(currentSchema, castCurrentNode, result, context)
+	// Slice => JSON array
 
-		// Map => JSON object
+	case []interface{}:
+		if checksType && !currentSchema.types.HasType(TYPE_ARRAY) {
+			typeError()
+			return
+		}
 
-		case reflect.Map:
-			if currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_OBJECT) {
-				result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
-				return
-			}
+		currentSchema.validateSchema(currentSchema, value, result, context)
 
-			castCurrentNode := currentNode.(map[string]interface{})
+		v.validateArray(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
 
-			currentSchema.validateSchema(currentSchema, castCurrentNode, result, context)
+	// Map => JSON object
 
-			v.validateObject(currentSchema, castCurrentNode, result, context)
-			v.validateCommon(currentSchema, castCurrentNode, result, context)
+	case map[string]interface{}:
+		if checksType && !currentSchema.types.HasType(TYPE_OBJECT) {
+			typeError()
+			return
+		}
 
-			for _, pSchema := range currentSchema.propertiesChildren {
-				nextNode, ok := castCurrentNode[pSchema.property]
-				if ok {
-					subContext := consJsonContext(pSchema.property, context)
-					v.validateRecursive(pSchema, nextNode, result, subContext)
-				}
-			}
+		if result.options != nil && result.options.useDefaults {
+			v.applyDefaults(currentSchema, value)
+		}
 
-		// Simple JSON values : string, number, boolean
+		currentSchema.validateSchema(currentSchema, value, result, context)
 
-		case reflect.Bool:
+		v.validateObject(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
 
-			if currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_BOOLEAN) {
-				result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
-				return
+		for _, pSchema := range currentSchema.propertiesChildren {
+			nextNode, ok := value[pSchema.property]
+			if ok && !result.options.ignores(KEY_PROPERTIES) {
+				subContext := consJsonContext(pSchema.property, context)
+				validationResult := pSchema.Validate(nextNode, subContext, result.options)
+				result.mergeChild(validationResult, KEY_PROPERTIES, pSchema.property)
 			}
+		}
 
-			value := currentNode.(bool)
+	// Simple JSON values : string, number, boolean
 
-			currentSchema.validateSchema(currentSchema, value, result, context)
-			v.validateNumber(currentSchema, value, result, context)
-			v.validateCommon(currentSchema, value, result, context)
-			v.validateString(currentSchema, value, result, context)
+	case bool:
+		if checksType && !currentSchema.types.HasType(TYPE_BOOLEAN) {
+			typeError()
+			return
+		}
 
-		case reflect.String:
+		currentSchema.validateSchema(currentSchema, value, result, context)
+		v.validateNumber(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
+		v.validateString(currentSchema, value, result, context)
 
-			if currentSchema.types.HasTypeInSchema() && !currentSchema.types.HasType(TYPE_STRING) {
-				result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
-				return
-			}
+	case string:
+		if checksType && !currentSchema.types.HasType(TYPE_STRING) {
+			typeError()
+			return
+		}
 
-			value := currentNode.(string)
+		currentSchema.validateSchema(currentSchema, value, result, context)
+		v.validateNumber(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
+		v.validateString(currentSchema, value, result, context)
 
-			currentSchema.validateSchema(currentSchema, value, result, context)
-			v.validateNumber(currentSchema, value, result, context)
-			v.validateCommon(currentSchema, value, result, context)
-			v.validateString(currentSchema, value, result, context)
+	// json.Number is produced by a json.Decoder using UseNumber
 
-		case reflect.Float64:
+	case float64, json.Number:
+		number, ok := result.options.newNumberValue(value)
+		if !ok {
+			result.addError(context, KEY_TYPE, "%s is not a valid number", currentSchema.property).Type = ERROR_TYPE_INVALID_VALUE
+			return
+		}
 
-			value := currentNode.(float64)
+		// Note: JSON only understand one kind of numeric ( can be float or int )
+		// JSON schema make a distinction between fload and int
+		// An integer can be a number, but a number ( with decimals ) cannot be an integer
+		// Here is the test:
+		isInteger := number.isInteger() // "weird" (?) thing: Go's Atoi accepts 1.0, 45.0 as integers...
+		if isInteger && result.options != nil && result.options.strictIntegers {
+			isInteger = number.isIntegerLiteral()
+		}
 
-			// Note: JSON only understand one kind of numeric ( can be float or int )
-			// JSON schema make a distinction between fload and int
-			// An integer can be a number, but a number ( with decimals ) cannot be an integer
-			// Here is the test:
-			isInteger := isFloat64AnInteger(value) // "weird" (?) thing: Go's Atoi accepts 1.0, 45.0 as integers...
+		formatIsCorrect := currentSchema.types.HasType(TYPE_NUMBER) || (isInteger && currentSchema.types.HasType(TYPE_INTEGER))
 
-			formatIsCorrect := currentSchema.types.HasType(TYPE_NUMBER) || (isInteger && currentSchema.types.HasType(TYPE_INTEGER))
+		if checksType && !formatIsCorrect {
+			typeError()
+			return
+		}
 
-			if currentSchema.types.HasTypeInSchema() && !formatIsCorrect {
-				result.addErrorMessage(context, fmt.Sprintf(ERROR_MESSAGE_X_MUST_BE_OF_TYPE_Y, currentSchema.property, currentSchema.types.String()))
-				return
-			}
+		currentSchema.validateSchema(currentSchema, value, result, context)
+		v.validateNumber(currentSchema, value, result, context)
+		v.validateCommon(currentSchema, value, result, context)
+		v.validateString(currentSchema, value, result, context)
+	}
 
-			currentSchema.validateSchema(currentSchema, value, result, context)
-			v.validateNumber(currentSchema, value, result, context)
-			v.validateCommon(currentSchema, value, result, context)
-			v.validateString(currentSchema, value, result, context)
-		}
+	if result.options != nil && result.options.collectAnnotations {
+		result.addSchemaAnnotations(currentSchema, context)
 	}
+
 	result.IncrementScore()
 }
 
@@ -210,41 +844,73 @@ func (v *jsonSchema) validateRecursive(currentSchema *jsonSchema, currentNode in
 
 func (v *jsonSchema) validateSchema(currentSchema *jsonSchema, currentNode interface{}, result *ValidationResult, context *jsonContext) {
 
-	if len(currentSchema.anyOf) > 0 {
+	if len(currentSchema.anyOf) > 0 && !result.options.ignores(KEY_ANY_OF) {
 		validatedAnyOf := false
-		var bestValidationResult *ValidationResult
+		var branchValidationResults []*ValidationResult
 
-		for _, anyOfSchema := range currentSchema.anyOf {
+		for i, anyOfSchema := range currentSchema.anyOf {
 			if !validatedAnyOf {
-				validationResult := anyOfSchema.Validate(currentNode, context)
+				validationResult := anyOfSchema.Validate(currentNode, context, result.options.forBranch())
 				validatedAnyOf = validationResult.IsValid()
-
-				if !validatedAnyOf && (bestValidationResult == nil || validationResult.score > bestValidationResult.score) {
-					bestValidationResult = validationResult
-				}
+				branchValidationResults = append(branchValidationResults, validationResult)
+				validationResult.keyword = KEY_ANY_OF
+				validationResult.location = strconv.Itoa(i)
 			}
 		}
 		if !validatedAnyOf {
-			if bestValidationResult != nil {
+			bestValidationResult := result.options.selectBranch(context, KEY_ANY_OF, currentSchema.anyOf, branchValidationResults)
+			if result.options.verboseBranchErrors {
+				result.mergeBranches(branchValidationResults, bestValidationResult, KEY_ANY_OF)
+			} else {
 				// add error messages of closest matching schema as
 				// that's probably the one the user was trying to
 				// match
 				result.Merge(bestValidationResult)
+				for _, branchValidationResult := range branchValidationResults {
+					result.addChild(branchValidationResult, KEY_ANY_OF, branchValidationResult.location)
+				}
+			}
+			result.addError(context, KEY_ANY_OF, "%s failed to validate any of the schema", currentSchema.property)
+		} else {
+			// the validated branch is the last one
+			validIndex := len(branchValidationResults) - 1
+			result.mergeValidBranch(branchValidationResults[validIndex])
+			result.addBranchMatch(context, KEY_ANY_OF, newMatchedBranch(validIndex, currentSchema.anyOf[validIndex]))
+			for _, branchValidationResult := range branchValidationResults {
+				releaseValidationResult(branchValidationResult)
 			}
-			result.addErrorMessage(context, fmt.Sprintf("%s failed to validate any of the schema", currentSchema.property))
 		}
 	}
 
-	if len(currentSchema.oneOf) > 0 {
-		nbValidated := 0
-		var bestValidationResult *ValidationResult
+	object, isObject := currentNode.(map[string]interface{})
 
-		for _, oneOfSchema := range currentSchema.oneOf {
-			validationResult := oneOfSchema.Validate(currentNode, context)
+	if result.options.ignores(KEY_ONE_OF) {
+		// neither the discriminator nor the branches are checked
+	} else if currentSchema.discriminator != nil && isObject {
+		v.validateDiscriminator(currentSchema, object, result, context)
+	} else if len(currentSchema.oneOf) > 0 {
+		nbValidated := 0
+		var branchValidationResults []*ValidationResult
+		var matchedBranches []MatchedBranch
+
+		for i, oneOfSchema := range currentSchema.oneOf {
+			validationResult := oneOfSchema.Validate(currentNode, context, result.options.forBranch())
+			branchValidationResults = append(branchValidationResults, validationResult)
+			validationResult.keyword = KEY_ONE_OF
+			validationResult.location = strconv.Itoa(i)
 			if validationResult.IsValid() {
 				nbValidated++
-			} else if nbValidated == 0 && (bestValidationResult == nil || validationResult.score > bestValidationResult.score) {
-				bestValidationResult = validationResult
+				matchedBranches = append(matchedBranches, newMatchedBranch(i, oneOfSchema))
+			}
+		}
+
+		if nbValidated > 0 {
+			if nbValidated == 1 {
+				result.mergeValidBranch(branchValidationResults[matchedBranches[0].Index])
+				result.addBranchMatch(context, KEY_ONE_OF, matchedBranches[0])
+			}
+			for _, branchValidationResult := range branchValidationResults {
+				releaseValidationResult(branchValidationResult)
 			}
 		}
 
@@ -252,54 +918,70 @@ func (v *jsonSchema) validateSchema(currentSchema *jsonSchema, currentNode inter
 		case 1:
 			// do nothing
 		case 0:
-			// add error messages of closest matching schema as
-			// that's probably the one the user was trying to
-			// match
-			result.Merge(bestValidationResult)
-			fallthrough
-		default: // != 1
-			result.addErrorMessage(context, fmt.Sprintf("%s failed to validate exactly one of the schema", currentSchema.property))
+			bestValidationResult := result.options.selectBranch(context, KEY_ONE_OF, currentSchema.oneOf, branchValidationResults)
+			if result.options.verboseBranchErrors {
+				result.mergeBranches(branchValidationResults, bestValidationResult, KEY_ONE_OF)
+			} else {
+				// add error messages of closest matching schema as
+				// that's probably the one the user was trying to
+				// match
+				result.Merge(bestValidationResult)
+				for _, branchValidationResult := range branchValidationResults {
+					result.addChild(branchValidationResult, KEY_ONE_OF, branchValidationResult.location)
+				}
+			}
+			result.addError(context, KEY_ONE_OF, "%s failed to validate exactly one of the schema", currentSchema.property)
+		default: // > 1
+			var matchedIndexes []string
+			for _, branch := range matchedBranches {
+				matchedIndexes = append(matchedIndexes, strconv.Itoa(branch.Index))
+			}
+			resultError := result.addError(context, KEY_ONE_OF, "%s failed to validate exactly one of the schema ( schemas %s are all valid )", currentSchema.property, strings.Join(matchedIndexes, ","))
+			resultError.Type = ERROR_TYPE_ONE_OF_MULTIPLE
+			resultError.MatchedBranches = matchedBranches
 		}
 	}
 
-	if len(currentSchema.allOf) > 0 {
+	if len(currentSchema.allOf) > 0 && !result.options.ignores(KEY_ALL_OF) {
 		nbValidated := 0
 
-		for _, allOfSchema := range currentSchema.allOf {
-			validationResult := allOfSchema.Validate(currentNode, context)
+		for i, allOfSchema := range currentSchema.allOf {
+			validationResult := allOfSchema.Validate(currentNode, context, result.options)
 			if validationResult.IsValid() {
 				nbValidated++
 			}
-			result.Merge(validationResult)
+			result.mergeChild(validationResult, KEY_ALL_OF, strconv.Itoa(i))
 		}
 
 		if nbValidated != len(currentSchema.allOf) {
-			result.addErrorMessage(context, fmt.Sprintf("%s failed to validate all of the schema", currentSchema.property))
+			result.addError(context, KEY_ALL_OF, "%s failed to validate all of the schema", currentSchema.property)
 		}
 	}
 
-	if currentSchema.not != nil {
-		validationResult := currentSchema.not.Validate(currentNode, context)
+	if currentSchema.not != nil && !result.options.ignores(KEY_NOT) {
+		validationResult := currentSchema.not.Validate(currentNode, context, result.options.forBranch())
 		if validationResult.IsValid() {
-			result.addErrorMessage(context, fmt.Sprintf("%s is not allowed to validate the schema", currentSchema.property))
+			result.addError(context, KEY_NOT, "%s is not allowed to validate the schema", currentSchema.property)
 		}
+		releaseValidationResult(validationResult)
 	}
 
-	if currentSchema.dependencies != nil && len(currentSchema.dependencies) > 0 {
-		if isKind(currentNode, reflect.Map) {
-			for elementKey := range currentNode.(map[string]interface{}) {
+	if currentSchema.dependencies != nil && len(currentSchema.dependencies) > 0 && !result.options.ignores(KEY_DEPENDENCIES) {
+		if isObject {
+			for elementKey := range object {
 				if dependency, ok := currentSchema.dependencies[elementKey]; ok {
 					switch dependency := dependency.(type) {
 
 					case []string:
 						for _, dependOnKey := range dependency {
-							if _, dependencyResolved := currentNode.(map[string]interface{})[dependOnKey]; !dependencyResolved {
-								result.addErrorMessage(context, fmt.Sprintf("%s has a dependency on %s", elementKey, dependOnKey))
+							if _, dependencyResolved := object[dependOnKey]; !dependencyResolved {
+								result.addError(context, KEY_DEPENDENCIES, "%s has a dependency on %s", elementKey, dependOnKey)
 							}
 						}
 
 					case *jsonSchema:
-						dependency.validateRecursive(dependency, currentNode, result, context)
+						validationResult := dependency.Validate(currentNode, context, result.options)
+						result.mergeChild(validationResult, KEY_DEPENDENCIES, elementKey)
 
 					}
 				}
@@ -310,31 +992,88 @@ func (v *jsonSchema) validateSchema(currentSchema *jsonSchema, currentNode inter
 	result.IncrementScore()
 }
 
+// Validates an object against the oneOf schema selected by its discriminator property
+func (v *jsonSchema) validateDiscriminator(currentSchema *jsonSchema, value map[string]interface{}, result *ValidationResult, context *jsonContext) {
+
+	discriminator := currentSchema.discriminator
+
+	discriminatorValue, ok := value[discriminator.propertyName].(string)
+	if !ok {
+		result.addError(context, KEY_DISCRIMINATOR, "%s property is required and must be of type string", discriminator.propertyName).Type = ERROR_TYPE_DISCRIMINATOR_MISSING
+		return
+	}
+
+	index, ok := discriminator.mapping[discriminatorValue]
+	if !ok {
+		var discriminatorValues []string
+		for k := range discriminator.mapping {
+			discriminatorValues = append(discriminatorValues, k)
+		}
+		sort.Strings(discriminatorValues)
+		result.addError(context, KEY_DISCRIMINATOR, "%s must match one of the discriminator values [%s]", discriminator.propertyName, strings.Join(discriminatorValues, ","))
+		return
+	}
+
+	validationResult := currentSchema.oneOf[index].Validate(value, context, result.options)
+	if validationResult.IsValid() {
+		result.addBranchMatch(context, KEY_ONE_OF, newMatchedBranch(index, currentSchema.oneOf[index]))
+	}
+	result.mergeChild(validationResult, KEY_ONE_OF, strconv.Itoa(index))
+}
+
+// Sets the missing properties of an object to their default value
+func (v *jsonSchema) applyDefaults(currentSchema *jsonSchema, value map[string]interface{}) {
+	for _, pSchema := range currentSchema.propertiesChildren {
+		defaultSchema := pSchema
+		for defaultSchema.refSchema != nil {
+			defaultSchema = defaultSchema.refSchema
+		}
+		if _, ok := value[pSchema.property]; !ok && defaultSchema.hasDefault {
+			value[pSchema.property] = copyJson(defaultSchema.defaultValue)
+		}
+	}
+}
+
 func (v *jsonSchema) validateCommon(currentSchema *jsonSchema, value interface{}, result *ValidationResult, context *jsonContext) {
 
 	if len(currentSchema.enum) > 0 {
-		has, err := currentSchema.HasEnum(value)
+		matched, err := currentSchema.HasEnum(value)
 		if err != nil {
-			result.addErrorMessage(context, err.Error())
-		}
-		if !has {
-			result.addErrorMessage(context, fmt.Sprintf("%s must match one of the enum values [%s]", currentSchema.property, strings.Join(currentSchema.enum, ",")))
+			result.addErrorMessage(context, KEY_ENUM, err.Error()).Type = ERROR_TYPE_INVALID_VALUE
+		} else if !matched {
+			given, _ := marshalToString(value)
+			values := truncatedValues{values: currentSchema.enum, limit: result.options.getEnumErrorLimit()}
+			resultError := result.addError(context, KEY_ENUM, "%s must match one of the enum values [%s], given %s", currentSchema.property, values, *given)
+			resultError.EnumValues = currentSchema.enum
 		}
 	}
 	result.IncrementScore()
 }
 
+// truncatedValues lists the first values of a list, and how many are left out
+type truncatedValues struct {
+	values []string
+	limit  int
+}
+
+func (t truncatedValues) String() string {
+	if t.limit < 0 || len(t.values) <= t.limit {
+		return strings.Join(t.values, ",")
+	}
+	return fmt.Sprintf("%s, ... %d more", strings.Join(t.values[:t.limit], ","), len(t.values)-t.limit)
+}
+
 func (v *jsonSchema) validateArray(currentSchema *jsonSchema, value []interface{}, result *ValidationResult, context *jsonContext) {
 
 	nbItems := len(value)
 
-	if currentSchema.itemsChildrenIsSingleSchema {
+	if ignoresItems := result.options.ignores(KEY_ITEMS); currentSchema.itemsChildrenIsSingleSchema && !ignoresItems {
 		for i := range value {
-			subContext := consJsonContext(strconv.Itoa(i), context)
-			validationResult := currentSchema.itemsChildren[0].Validate(value[i], subContext)
-			result.MergeWithAnnotation(validationResult, currentSchema.property)
+			subContext := consJsonIndexContext(i, context)
+			validationResult := currentSchema.itemsChildren[0].Validate(value[i], subContext, result.options)
+			result.mergeChild(validationResult, KEY_ITEMS, strconv.Itoa(i))
 		}
-	} else {
+	} else if !ignoresItems {
 		if currentSchema.itemsChildren != nil && len(currentSchema.itemsChildren) > 0 {
 
 			nbItems := len(currentSchema.itemsChildren)
@@ -342,22 +1081,22 @@ func (v *jsonSchema) validateArray(currentSchema *jsonSchema, value []interface{
 
 			if nbItems == nbValues {
 				for i := 0; i != nbItems; i++ {
-					subContext := consJsonContext(strconv.Itoa(i), context)
-					validationResult := currentSchema.itemsChildren[i].Validate(value[i], subContext)
-					result.Merge(validationResult)
+					subContext := consJsonIndexContext(i, context)
+					validationResult := currentSchema.itemsChildren[i].Validate(value[i], subContext, result.options)
+					result.mergeChild(validationResult, KEY_ITEMS, strconv.Itoa(i))
 				}
-			} else if nbItems < nbValues {
+			} else if nbItems < nbValues && !result.options.ignores(KEY_ADDITIONAL_ITEMS) {
 				switch currentSchema.additionalItems.(type) {
 				case bool:
 					if !currentSchema.additionalItems.(bool) {
-						result.addErrorMessage(context, fmt.Sprintf("No additional item allowed on %s", currentSchema.property))
+						result.addError(context, KEY_ADDITIONAL_ITEMS, "No additional item allowed on %s", currentSchema.property)
 					}
 				case *jsonSchema:
 					additionalItemSchema := currentSchema.additionalItems.(*jsonSchema)
 					for i := nbItems; i != nbValues; i++ {
-						subContext := consJsonContext(strconv.Itoa(i), context)
-						validationResult := additionalItemSchema.Validate(value[i], subContext)
-						result.Merge(validationResult)
+						subContext := consJsonIndexContext(i, context)
+						validationResult := additionalItemSchema.Validate(value[i], subContext, result.options)
+						result.mergeChild(validationResult, KEY_ADDITIONAL_ITEMS, strconv.Itoa(i))
 					}
 				}
 			}
@@ -366,25 +1105,25 @@ func (v *jsonSchema) validateArray(currentSchema *jsonSchema, value []interface{
 
 	if currentSchema.minItems != nil {
 		if nbItems < *currentSchema.minItems {
-			result.addErrorMessage(context, fmt.Sprintf("%s must have at least %d items", currentSchema.property, *currentSchema.minItems))
+			result.addError(context, KEY_MIN_ITEMS, "%s must have at least %d items", currentSchema.property, *currentSchema.minItems)
 		}
 	}
 
 	if currentSchema.maxItems != nil {
 		if nbItems > *currentSchema.maxItems {
-			result.addErrorMessage(context, fmt.Sprintf("%s must have at the most %d items", currentSchema.property, *currentSchema.maxItems))
+			result.addError(context, KEY_MAX_ITEMS, "%s must have at the most %d items", currentSchema.property, *currentSchema.maxItems)
 		}
 	}
 
 	if currentSchema.uniqueItems {
 		var stringifiedItems []string
 		for _, v := range value {
-			vString, err := marshalToString(v)
+			vString, err := marshalToCanonicalString(v)
 			if err != nil {
-				result.addErrorMessage(context, fmt.Sprintf("%s could not be marshalled", currentSchema.property))
+				result.addError(context, KEY_UNIQUE_ITEMS, "%s could not be marshalled", currentSchema.property).Type = ERROR_TYPE_INVALID_VALUE
 			}
 			if isStringInSlice(stringifiedItems, *vString) {
-				result.addErrorMessage(context, fmt.Sprintf("%s items must be unique", currentSchema.property))
+				result.addError(context, KEY_UNIQUE_ITEMS, "%s items must be unique", currentSchema.property)
 			}
 			stringifiedItems = append(stringifiedItems, *vString)
 		}
@@ -396,13 +1135,13 @@ func (v *jsonSchema) validateObject(currentSchema *jsonSchema, value map[string]
 
 	if currentSchema.minProperties != nil {
 		if len(value) < *currentSchema.minProperties {
-			result.addErrorMessage(context, fmt.Sprintf("%s must have at least %d properties", currentSchema.property, *currentSchema.minProperties))
+			result.addError(context, KEY_MIN_PROPERTIES, "%s must have at least %d properties", currentSchema.property, *currentSchema.minProperties)
 		}
 	}
 
 	if currentSchema.maxProperties != nil {
 		if len(value) > *currentSchema.maxProperties {
-			result.addErrorMessage(context, fmt.Sprintf("%s must have at the most %d properties", currentSchema.property, *currentSchema.maxProperties))
+			result.addError(context, KEY_MAX_PROPERTIES, "%s must have at the most %d properties", currentSchema.property, *currentSchema.maxProperties)
 		}
 	}
 
@@ -410,25 +1149,43 @@ func (v *jsonSchema) validateObject(currentSchema *jsonSchema, value map[string]
 		_, ok := value[requiredProperty]
 		if ok {
 			result.IncrementScore()
-		} else {
-			result.addErrorMessage(context, fmt.Sprintf("%s property is required", requiredProperty))
+		} else if !result.options.excludes(currentSchema.propertiesIndex[requiredProperty]) {
+			result.addError(context, KEY_REQUIRED, "%s property is required", requiredProperty)
 		}
 	}
 
-	if currentSchema.additionalProperties != nil {
+	if currentSchema.prunesUnknownFields {
+		v.pruneUnknownFields(currentSchema, value, result, context)
+	}
+
+	// each property is matched against the patterns once per validation
+	patternMatches := v.matchPatternProperties(currentSchema, value, result, context)
+
+	if currentSchema.closedProperties && !result.options.ignores(KEY_ADDITIONAL_PROPERTIES) {
+		// the keys are only checked against the declared properties, in one pass
+		for pk := range value {
+			if currentSchema.HasProperty(pk) {
+				continue
+			}
+			if result.options.removeAdditional {
+				delete(value, pk)
+				continue
+			}
+			v.addAdditionalPropertyError(currentSchema, pk, result, context)
+		}
+	} else if currentSchema.additionalProperties != nil && !result.options.ignores(KEY_ADDITIONAL_PROPERTIES) {
 		switch currentSchema.additionalProperties.(type) {
 		case bool:
 			if !currentSchema.additionalProperties.(bool) {
 				for pk := range value {
-					found := false
-					for _, spValue := range currentSchema.propertiesChildren {
-						if pk == spValue.property {
-							found = true
-						}
-					}
+					found := currentSchema.HasProperty(pk)
 
-					if !found && !v.validatePatternProperties(currentSchema, value, result, context) {
-						result.addErrorMessage(context, fmt.Sprintf("No additional property ( %s ) is allowed on %s", pk, currentSchema.property))
+					if _, matched := patternMatches[pk]; !found && !matched {
+						if result.options.removeAdditional {
+							delete(value, pk)
+							continue
+						}
+						v.addAdditionalPropertyError(currentSchema, pk, result, context)
 					}
 				}
 			}
@@ -436,115 +1193,198 @@ func (v *jsonSchema) validateObject(currentSchema *jsonSchema, value map[string]
 		case *jsonSchema:
 			additionalPropertiesSchema := currentSchema.additionalProperties.(*jsonSchema)
 			for pk := range value {
-				found := false
-				for _, spValue := range currentSchema.propertiesChildren {
-					if pk == spValue.property {
-						found = true
-					}
-				}
+				found := currentSchema.HasProperty(pk)
 				// check patternProperties on not found one since patternProperties overrides
-				if !found && !v.validatePatternProperties(currentSchema, value, result, context) {
+				if _, matched := patternMatches[pk]; !found && !matched {
 					// both additionalProperties and patternProperties failed
-					validationResult := additionalPropertiesSchema.Validate(value[pk], context)
-					result.Merge(validationResult)
+					validationResult := additionalPropertiesSchema.Validate(value[pk], context, result.options)
+					if !validationResult.IsValid() && result.options.removeAdditional {
+						delete(value, pk)
+						continue
+					}
+					result.mergeChild(validationResult, KEY_ADDITIONAL_PROPERTIES, pk)
 				}
 			}
 		}
 	}
 
-	v.validatePatternProperties(currentSchema, value, result, context)
+	v.validatePatternProperties(currentSchema, value, patternMatches, result, context)
 	result.IncrementScore()
 }
 
-func (v *jsonSchema) validatePatternProperties(currentSchema *jsonSchema, value map[string]interface{}, result *ValidationResult, context *jsonContext) (matched bool) {
-	matched = false
-	
-	if currentSchema.patternProperties == nil {
-		return
+// Reports a property additionalProperties does not allow, with the declared
+// property it is likely a typo of
+func (v *jsonSchema) addAdditionalPropertyError(currentSchema *jsonSchema, property string, result *ValidationResult, context *jsonContext) {
+	if suggestion := currentSchema.suggestProperty(property); suggestion != "" {
+		resultError := result.addError(context, KEY_ADDITIONAL_PROPERTIES, "No additional property ( %s ) is allowed on %s, did you mean \"%s\"?", property, currentSchema.property, suggestion)
+		resultError.Suggestion = suggestion
+	} else {
+		result.addError(context, KEY_ADDITIONAL_PROPERTIES, "No additional property ( %s ) is allowed on %s", property, currentSchema.property)
+	}
+}
+
+// suggestProperty returns the declared property an additional one is likely a typo of
+func (s *jsonSchema) suggestProperty(property string) string {
+	names := make([]string, len(s.propertiesChildren))
+	for i, child := range s.propertiesChildren {
+		names[i] = child.property
+	}
+	return closestName(property, names)
+}
+
+// Matches the properties of an object against the patternProperties,
+// returning the patterns each matched property has, a property a pattern
+// could not be matched against is present too
+func (v *jsonSchema) matchPatternProperties(currentSchema *jsonSchema, value map[string]interface{}, result *ValidationResult, context *jsonContext) map[string][]string {
+
+	if currentSchema.patternProperties == nil || result.options.ignores(KEY_PATTERN_PROPERTIES) {
+		return nil
 	}
 
+	patterns := make([]string, 0, len(currentSchema.patternProperties))
+	for pk := range currentSchema.patternProperties {
+		if currentSchema.patternPropertiesRegexp[pk] == nil {
+			result.addError(context, KEY_PATTERN_PROPERTIES, "Pattern '%s' of %s has no compiled regex", pk, currentSchema.property)
+			continue
+		}
+		patterns = append(patterns, pk)
+	}
+	sort.Strings(patterns)
+
+	matches := make(map[string][]string)
 	for k := range value {
-		for pk, pv := range currentSchema.patternProperties {
-			if matches, _ := regexp.MatchString(pk, k); matches {
-				subContext := consJsonContext(k, context)
-				validationResult := pv.Validate(value[k], subContext)
-				result.Merge(validationResult)
-				if validationResult.IsValid() {
-					matched = true
+		for _, pk := range patterns {
+			matched, err := currentSchema.patternPropertiesRegexp[pk].MatchString(k)
+			if err != nil {
+				result.addError(context, KEY_PATTERN_PROPERTIES, "Property %s could not be matched against the pattern '%s' ( %s )", k, pk, err.Error())
+				if _, ok := matches[k]; !ok {
+					// not reported as an additional property too
+					matches[k] = nil
 				}
+				continue
+			}
+			if matched {
+				matches[k] = append(matches[k], pk)
 			}
 		}
 	}
+	return matches
+}
+
+// Validates each matched property against the schemas of its patterns
+func (v *jsonSchema) validatePatternProperties(currentSchema *jsonSchema, value map[string]interface{}, matches map[string][]string, result *ValidationResult, context *jsonContext) {
+
+	if currentSchema.patternProperties == nil || result.options.ignores(KEY_PATTERN_PROPERTIES) {
+		return
+	}
+
+	for k, patterns := range matches {
+		subContext := consJsonContext(k, context)
+		for _, pk := range patterns {
+			validationResult := currentSchema.patternProperties[pk].Validate(value[k], subContext, result.options)
+			result.mergeChild(validationResult, KEY_PATTERN_PROPERTIES, k)
+		}
+	}
 	result.IncrementScore()
-	return
 }
 
 func (v *jsonSchema) validateString(currentSchema *jsonSchema, value interface{}, result *ValidationResult, context *jsonContext) {
 
 	// Ignore non strings
-	if !isKind(value, reflect.String) {
+	stringValue, ok := value.(string)
+	if !ok {
 		return
 	}
 
-	stringValue := value.(string)
-
 	if currentSchema.minLength != nil {
 		if len(stringValue) < *currentSchema.minLength {
-			result.addErrorMessage(context, fmt.Sprintf("%s's length must be greater or equal to %d", currentSchema.property, *currentSchema.minLength))
+			result.addError(context, KEY_MIN_LENGTH, "%s's length must be greater or equal to %d", currentSchema.property, *currentSchema.minLength)
 		}
 	}
 
 	if currentSchema.maxLength != nil {
 		if len(stringValue) > *currentSchema.maxLength {
-			result.addErrorMessage(context, fmt.Sprintf("%s's length must be lower or equal to %d", currentSchema.property, *currentSchema.maxLength))
+			result.addError(context, KEY_MAX_LENGTH, "%s's length must be lower or equal to %d", currentSchema.property, *currentSchema.maxLength)
 		}
 	}
 
 	if currentSchema.pattern != nil {
-		if !currentSchema.pattern.MatchString(stringValue) {
-			result.addErrorMessage(context, fmt.Sprintf("%s has an invalid format", currentSchema.property))
+		matched, err := currentSchema.pattern.MatchString(stringValue)
+		if err != nil {
+			result.addError(context, KEY_PATTERN, "%s could not be matched against the pattern ( %s )", currentSchema.property, err.Error())
+		} else if !matched {
+			result.addError(context, KEY_PATTERN, "%s has an invalid format", currentSchema.property)
 		}
 	}
+	if currentSchema.format != nil {
+		if checker, ok := result.options.getFormatChecker(*currentSchema.format); ok {
+			err := checker.CheckFormat(stringValue)
+			if result.options != nil && result.options.formatAnnotation {
+				description := ""
+				if err != nil {
+					description = fmt.Sprintf("%s does not match format %s ( %s )", currentSchema.property, *currentSchema.format, err.Error())
+				}
+				result.addAnnotation(context, KEY_FORMAT, *currentSchema.format, description)
+				if err != nil {
+					result.addWarning(context, KEY_FORMAT, "%s does not match format %s ( %s )", currentSchema.property, *currentSchema.format, err.Error())
+				}
+			} else if err != nil {
+				result.addError(context, KEY_FORMAT, "%s does not match format %s ( %s )", currentSchema.property, *currentSchema.format, err.Error())
+			}
+		}
+	}
+
 	result.IncrementScore()
 }
 
 func (v *jsonSchema) validateNumber(currentSchema *jsonSchema, value interface{}, result *ValidationResult, context *jsonContext) {
 
 	// Ignore non numbers
-	if !isKind(value, reflect.Float64) {
+	number, ok := result.options.newNumberValue(value)
+	if !ok {
 		return
 	}
 
-	float64Value := value.(float64)
-
 	if currentSchema.multipleOf != nil {
-		if !isFloat64AnInteger(float64Value / *currentSchema.multipleOf) {
-			result.addErrorMessage(context, fmt.Sprintf("%s (%s) is not a multiple of %s", currentSchema.property, validationErrorFormatNumber(float64Value), validationErrorFormatNumber(*currentSchema.multipleOf)))
+		if !number.isMultipleOf(*currentSchema.multipleOf, currentSchema.exactBound(KEY_MULTIPLE_OF)) {
+			result.addError(context, KEY_MULTIPLE_OF, "%s (%s) is not a multiple of %s", currentSchema.property, number, currentSchema.formatBound(KEY_MULTIPLE_OF, *currentSchema.multipleOf))
 		}
 	}
 
 	if currentSchema.maximum != nil {
 		if currentSchema.exclusiveMaximum {
-			if float64Value >= *currentSchema.maximum {
-				result.addErrorMessage(context, fmt.Sprintf("%s (%s) must be lower than or equal to %s", currentSchema.property, validationErrorFormatNumber(float64Value), validationErrorFormatNumber(*currentSchema.maximum)))
+			if number.compare(*currentSchema.maximum, currentSchema.exactBound(KEY_MAXIMUM)) >= 0 {
+				result.addError(context, KEY_MAXIMUM, "%s (%s) must be lower than or equal to %s", currentSchema.property, number, currentSchema.formatBound(KEY_MAXIMUM, *currentSchema.maximum)).Type = ERROR_TYPE_EXCLUSIVE_MAXIMUM
 			}
 		} else {
-			if float64Value > *currentSchema.maximum {
-				result.addErrorMessage(context, fmt.Sprintf("%s (%s) must be lower than %s", currentSchema.property, validationErrorFormatNumber(float64Value), validationErrorFormatNumber(*currentSchema.maximum)))
+			if number.compare(*currentSchema.maximum, currentSchema.exactBound(KEY_MAXIMUM)) > 0 {
+				result.addError(context, KEY_MAXIMUM, "%s (%s) must be lower than %s", currentSchema.property, number, currentSchema.formatBound(KEY_MAXIMUM, *currentSchema.maximum))
 			}
 		}
 	}
 
 	if currentSchema.minimum != nil {
 		if currentSchema.exclusiveMinimum {
-			if float64Value <= *currentSchema.minimum {
-				result.addErrorMessage(context, fmt.Sprintf("%s (%s) must be greater than or equal to %s", currentSchema.property, validationErrorFormatNumber(float64Value), validationErrorFormatNumber(*currentSchema.minimum)))
+			if number.compare(*currentSchema.minimum, currentSchema.exactBound(KEY_MINIMUM)) <= 0 {
+				result.addError(context, KEY_MINIMUM, "%s (%s) must be greater than or equal to %s", currentSchema.property, number, currentSchema.formatBound(KEY_MINIMUM, *currentSchema.minimum)).Type = ERROR_TYPE_EXCLUSIVE_MINIMUM
 			}
 		} else {
-			if float64Value < *currentSchema.minimum {
-				result.addErrorMessage(context, fmt.Sprintf("%s (%s) must be greater than %s", currentSchema.property, validationErrorFormatNumber(float64Value), validationErrorFormatNumber(*currentSchema.minimum)))
+			if number.compare(*currentSchema.minimum, currentSchema.exactBound(KEY_MINIMUM)) < 0 {
+				result.addError(context, KEY_MINIMUM, "%s (%s) must be greater than %s", currentSchema.property, number, currentSchema.formatBound(KEY_MINIMUM, *currentSchema.minimum))
 			}
 		}
 	}
+
+	if currentSchema.exclusiveMaximumValue != nil {
+		if number.compare(*currentSchema.exclusiveMaximumValue, currentSchema.exactBound(KEY_EXCLUSIVE_MAXIMUM)) >= 0 {
+			result.addError(context, KEY_EXCLUSIVE_MAXIMUM, "%s (%s) must be lower than the exclusiveMaximum %s", currentSchema.property, number, currentSchema.formatBound(KEY_EXCLUSIVE_MAXIMUM, *currentSchema.exclusiveMaximumValue))
+		}
+	}
+
+	if currentSchema.exclusiveMinimumValue != nil {
+		if number.compare(*currentSchema.exclusiveMinimumValue, currentSchema.exactBound(KEY_EXCLUSIVE_MINIMUM)) <= 0 {
+			result.addError(context, KEY_EXCLUSIVE_MINIMUM, "%s (%s) must be greater than the exclusiveMinimum %s", currentSchema.property, number, currentSchema.formatBound(KEY_EXCLUSIVE_MINIMUM, *currentSchema.exclusiveMinimumValue))
+		}
+	}
 	result.IncrementScore()
 }
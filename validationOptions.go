@@ -0,0 +1,296 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Options changing how a schema document validates.
+//                  Options are carried by the validation results down to every sub-schema.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"log/slog"
+)
+
+type validationOptions struct {
+	// Report the errors of every anyOf / oneOf branch instead of the closest one
+	verboseBranchErrors bool
+
+	scoringStrategy ScoringStrategy
+	branchSelector  BranchSelector
+
+	// Fill the missing properties with their default value
+	useDefaults bool
+
+	// Remove the properties not allowed by additionalProperties instead of reporting them
+	removeAdditional bool
+
+	// Compare float64 numbers as exact decimals
+	arbitraryPrecision bool
+
+	// Only the numbers written without a fraction nor an exponent are integers
+	strictIntegers bool
+
+	// Requests or responses, for readOnly and writeOnly
+	accessMode AccessMode
+
+	// Collect format as an annotation instead of asserting it
+	formatAnnotation bool
+
+	// Enum values listed in the errors, DEFAULT_ENUM_ERROR_LIMIT when 0, all of them when negative
+	enumErrorLimit int
+
+	// Name of the document in the contexts, DEFAULT_ROOT_NAME when nil
+	rootName *string
+
+	// Keywords not checked, as if they were not in the schemas
+	ignoredKeywords map[string]bool
+
+	// Collect title, description, default and examples as annotations of the
+	// instance locations they apply to
+	collectAnnotations bool
+
+	// Measures of the validations
+	metrics     Metrics
+	tracer      Tracer
+	logger      *slog.Logger
+	keywordHook KeywordHook
+
+	// Counts of the errors by schema and keyword, across validations
+	keywordStatistics *KeywordStatistics
+
+	// Record the keyword evaluations, to the trace of each validation
+	trace           bool
+	evaluationTrace *EvaluationTrace
+
+	// Format checkers shadowing the registered ones
+	formatCheckers map[string]FormatChecker
+
+	// Errors kept, before they are observed, all of them when 0
+	maxErrors int
+}
+
+// Looks up a format checker in the document first, then in the registry
+func (o *validationOptions) getFormatChecker(name string) (FormatChecker, bool) {
+	if o != nil {
+		if checker, ok := o.formatCheckers[name]; ok {
+			return checker, checker != nil
+		}
+	}
+	return getFormatChecker(name)
+}
+
+// Options for the branches of an anyOf / oneOf / not, a branch may not match so
+// it must never modify the document
+func (o *validationOptions) forBranch() *validationOptions {
+	if o == nil || (!o.useDefaults && !o.removeAdditional) {
+		return o
+	}
+	branchOptions := *o
+	branchOptions.useDefaults = false
+	branchOptions.removeAdditional = false
+	return &branchOptions
+}
+
+func (o *validationOptions) scoring() ScoringStrategy {
+	if o == nil || o.scoringStrategy == nil {
+		return DefaultScoringStrategy{}
+	}
+	return o.scoringStrategy
+}
+
+func (o *validationOptions) getEnumErrorLimit() int {
+	if o == nil || o.enumErrorLimit == 0 {
+		return DEFAULT_ENUM_ERROR_LIMIT
+	}
+	return o.enumErrorLimit
+}
+
+func (o *validationOptions) ignores(keyword string) bool {
+	return o != nil && o.ignoredKeywords[keyword]
+}
+
+// Options ignoring the keywords, in addition to those already ignored
+func (o *validationOptions) ignoring(keywords []string) *validationOptions {
+	var options validationOptions
+	if o != nil {
+		options = *o
+	}
+	// the map is shared with the previous options
+	ignoredKeywords := make(map[string]bool, len(options.ignoredKeywords)+len(keywords))
+	for keyword := range options.ignoredKeywords {
+		ignoredKeywords[keyword] = true
+	}
+	options.ignoredKeywords = ignoredKeywords
+	for _, keyword := range keywords {
+		options.ignoredKeywords[keyword] = true
+	}
+	return &options
+}
+
+// Context of the document root
+func (o *validationOptions) rootContext() *jsonContext {
+	if o == nil || o.rootName == nil {
+		return consJsonContext(DEFAULT_ROOT_NAME, nil)
+	}
+	return consJsonContext(*o.rootName, nil)
+}
+
+// Options of the next validations, never modified once returned
+func (d *JsonSchemaDocument) getOptions() *validationOptions {
+	if options := d.options.Load(); options != nil {
+		return options
+	}
+	return &validationOptions{}
+}
+
+// Replaces the options with a changed copy, validations in progress keep the previous ones
+func (d *JsonSchemaDocument) setOptions(change func(options *validationOptions)) {
+	d.optionsMutex.Lock()
+	defer d.optionsMutex.Unlock()
+	options := *d.getOptions()
+	change(&options)
+	d.options.Store(&options)
+}
+
+// When set, a failed anyOf / oneOf reports the errors of all its branches,
+// annotated with the branch index ( ex: anyOf[2] ), instead of the errors
+// of the closest matching branch only
+func (d *JsonSchemaDocument) SetVerboseBranchErrors(verbose bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.verboseBranchErrors = verbose
+	})
+}
+
+// Replaces the heuristic used to find the closest matching anyOf / oneOf branch
+func (d *JsonSchemaDocument) SetScoringStrategy(strategy ScoringStrategy) {
+	d.setOptions(func(options *validationOptions) {
+		options.scoringStrategy = strategy
+	})
+}
+
+// Replaces the choice of the failed anyOf / oneOf branch whose errors are
+// reported, ScoreBranchSelector when nil
+func (d *JsonSchemaDocument) SetBranchSelector(selector BranchSelector) {
+	d.setOptions(func(options *validationOptions) {
+		options.branchSelector = selector
+	})
+}
+
+// Replaces ROOT in the contexts of the errors ( ex: ROOT.items.0 ), with an
+// empty name the contexts start with the first property or index ( items.0 )
+func (d *JsonSchemaDocument) SetRootName(name string) {
+	d.setOptions(func(options *validationOptions) {
+		options.rootName = &name
+	})
+}
+
+// Keywords not checked by the validations, as if they were not in the schemas
+// ( ex: required to validate partial documents, or format ), the previously
+// ignored keywords are checked again. See ValidateIgnoring for a single validation
+func (d *JsonSchemaDocument) SetIgnoredKeywords(keywords ...string) {
+	d.setOptions(func(options *validationOptions) {
+		options.ignoredKeywords = make(map[string]bool, len(keywords))
+		for _, keyword := range keywords {
+			options.ignoredKeywords[keyword] = true
+		}
+	})
+}
+
+// When set, Validate fills the properties missing from the validated document
+// with a copy of their schema "default" value, before checking them
+// Defaults found in anyOf, oneOf and not sub-schemas are ignored
+func (d *JsonSchemaDocument) SetUseDefaults(useDefaults bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.useDefaults = useDefaults
+	})
+}
+
+// When set, Validate removes from the validated document the properties that
+// additionalProperties does not allow, instead of reporting them
+// See ValidateAndSanitize to keep the original document untouched
+func (d *JsonSchemaDocument) SetRemoveAdditional(removeAdditional bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.removeAdditional = removeAdditional
+	})
+}
+
+// When set, the numbers of the validated document are compared to minimum,
+// maximum and multipleOf as exact decimals ( math/big ) rather than as float64
+// ex: 0.3 is then a multiple of 0.1
+func (d *JsonSchemaDocument) SetArbitraryPrecision(arbitraryPrecision bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.arbitraryPrecision = arbitraryPrecision
+	})
+}
+
+// By default a number with a zero fraction, such as 1.0, is an integer, as in draft 6
+// When set, as in draft 4, only the numbers written without a fraction nor an
+// exponent are integers
+// The numbers are written as such in the json text ( ValidateBytes, ValidateReader,
+// json.Number values ), a float64 is an integer when its fraction is zero
+func (d *JsonSchemaDocument) SetStrictIntegers(strictIntegers bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.strictIntegers = strictIntegers
+	})
+}
+
+// By default format is an assertion, a string not matching its format is an error
+// When set to false, as in the annotation vocabulary of draft 2019-09, format
+// is collected in the annotations of the result, with the reason of the mismatch if any
+func (d *JsonSchemaDocument) SetFormatAssertion(formatAssertion bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.formatAnnotation = !formatAssertion
+	})
+}
+
+// When set, the result also holds, for each location of the validated document,
+// the title, description, default and examples of the schemas applied to it
+// See GetAnnotations and GetAnnotationsByContext
+func (d *JsonSchemaDocument) SetCollectAnnotations(collectAnnotations bool) {
+	d.setOptions(func(options *validationOptions) {
+		options.collectAnnotations = collectAnnotations
+	})
+}
+
+// Lists at most limit values of an enum in its errors, followed by the number
+// of values left out, all of them when limit is negative
+// The errors hold all the values in EnumValues
+func (d *JsonSchemaDocument) SetEnumErrorLimit(limit int) {
+	d.setOptions(func(options *validationOptions) {
+		options.enumErrorLimit = limit
+	})
+}
+
+// Adds a format checker to this document only, it shadows the checker of the
+// same name registered with AddFormatChecker
+// A nil checker disables the format for this document
+func (d *JsonSchemaDocument) AddFormatChecker(name string, checker FormatChecker) {
+	d.setOptions(func(options *validationOptions) {
+		// the map is shared with the previous options
+		formatCheckers := make(map[string]FormatChecker, len(options.formatCheckers)+1)
+		for k, v := range options.formatCheckers {
+			formatCheckers[k] = v
+		}
+		formatCheckers[name] = checker
+		options.formatCheckers = formatCheckers
+	})
+}
@@ -0,0 +1,1481 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for validation results.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func mustParseJson(t *testing.T, s string) interface{} {
+	var document interface{}
+	if err := json.Unmarshal([]byte(s), &document); err != nil {
+		t.Fatalf("Could not parse json : %s", err.Error())
+	}
+	return document
+}
+
+func mustNewSchemaDocument(t *testing.T, s string) *JsonSchemaDocument {
+	schemaDocument, err := NewJsonSchemaDocument(mustParseJson(t, s))
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+	return schemaDocument
+}
+
+func TestValidationResultAsError(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"a":{"type":"string"},"b":{"minimum":3}}}`)
+
+	validResult := schemaDocument.Validate(mustParseJson(t, `{"a":"x","b":4}`))
+	if validResult.AsError() != nil {
+		t.Errorf("Expects a nil error for a valid document, given %v", validResult.AsError())
+	}
+
+	invalidResult := schemaDocument.Validate(mustParseJson(t, `{"a":1,"b":1}`))
+	err := invalidResult.AsError()
+	if !errors.Is(err, ErrInvalidDocument) {
+		t.Errorf("Expects error to wrap ErrInvalidDocument, given %v", err)
+	}
+
+	var resultError *ResultError
+	if !errors.As(err, &resultError) {
+		t.Errorf("Expects error to wrap a *ResultError, given %v", err)
+	}
+
+	var asError error = invalidResult
+	if !errors.As(asError, &resultError) {
+		t.Errorf("Expects result to unwrap to a *ResultError")
+	}
+
+	if len(invalidResult.GetErrors()) != 2 {
+		t.Errorf("Expects 2 errors, given %d", len(invalidResult.GetErrors()))
+	}
+}
+
+func TestValidationResultSortedErrors(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"b":{"type":"string"},"a":{"items":{"type":"string"}}},"required":["c"]}`)
+
+	expected := []string{
+		`ROOT : c property is required`,
+		`ROOT.a.2 : items must be of type string`,
+		`ROOT.a.10 : items must be of type string`,
+		`ROOT.b : b must be of type string`,
+	}
+
+	for i := 0; i < 10; i++ {
+		result := schemaDocument.Validate(mustParseJson(t, `{"a":["x","y",1,"x","x","x","x","x","x","x",2],"b":1}`))
+		messages := result.GetErrorMessages()
+		if len(messages) != len(expected) {
+			t.Fatalf("Expects %d errors, given %v", len(expected), messages)
+		}
+		for j := range expected {
+			if messages[j] != expected[j] {
+				t.Errorf("Expects error %d to be %q, given %q", j, expected[j], messages[j])
+			}
+		}
+	}
+}
+
+func TestValidationResultDuplicateErrors(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"allOf":[{"type":"string"},{"type":"string"},{"type":"string","minLength":2}]}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `1`))
+	messages := result.GetErrorMessages()
+	if len(messages) != 2 {
+		t.Errorf("Expects 2 errors, given %v", messages)
+	}
+}
+
+func TestVerboseBranchErrors(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"anyOf":[{"type":"string"},{"type":"number","minimum":10}]}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `1`))
+	if len(result.GetErrors()) != 2 {
+		t.Errorf("Expects 2 errors, given %v", result.GetErrorMessages())
+	}
+
+	schemaDocument.SetVerboseBranchErrors(true)
+
+	expected := []string{
+		`ROOT : (root) failed to validate any of the schema`,
+		`anyOf[1] ROOT : anyOf (1) must be greater than 10`,
+		`anyOf[0] ROOT : anyOf must be of type string`,
+	}
+	messages := schemaDocument.Validate(mustParseJson(t, `1`)).GetErrorMessages()
+	if len(messages) != len(expected) {
+		t.Fatalf("Expects %d errors, given %v", len(expected), messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Errorf("Expects error %d to be %q, given %q", i, expected[i], messages[i])
+		}
+	}
+}
+
+func TestOneOfMatchedBranches(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"oneOf":[{"title":"a number","type":"number"},{"type":"string"},{"$id":"#int","type":"integer"}]}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `1`))
+	if len(result.GetErrors()) != 1 {
+		t.Fatalf("Expects 1 error, given %v", result.GetErrorMessages())
+	}
+
+	matchedBranches := result.GetErrors()[0].MatchedBranches
+	expected := []MatchedBranch{{Index: 0, Title: "a number"}, {Index: 2, Id: "#int"}}
+	if len(matchedBranches) != len(expected) {
+		t.Fatalf("Expects %d matched branches, given %v", len(expected), matchedBranches)
+	}
+	for i := range expected {
+		if matchedBranches[i] != expected[i] {
+			t.Errorf("Expects matched branch %v, given %v", expected[i], matchedBranches[i])
+		}
+	}
+}
+
+func TestDiscriminator(t *testing.T) {
+
+	// internal references can only be resolved on a schema loaded from a file
+	schemaFile := t.TempDir() + "/discriminator.json"
+	err := os.WriteFile(schemaFile, []byte(`{
+		"definitions":{
+			"Cat":{"properties":{"kind":{"type":"string"},"lives":{"type":"integer"}},"required":["lives"]},
+			"Dog":{"properties":{"kind":{"type":"string"},"bark":{"type":"string"}},"required":["bark"]},
+			"Sea/Lion":{"properties":{"kind":{"type":"string"},"fins":{"type":"integer"}},"required":["fins"]}
+		},
+		"oneOf":[{"$ref":"#/definitions/Cat"},{"$ref":"#/definitions/Dog"},{"$ref":"#/definitions/Sea~1Lion"}],
+		"discriminator":{"propertyName":"kind","mapping":{"hound":"#/definitions/Dog","puppy":"Dog"}}
+	}`), 0644)
+	if err != nil {
+		t.Fatalf("Could not write schema : %s", err.Error())
+	}
+
+	schemaDocument, err := NewJsonSchemaDocumentWithOptions("file://"+schemaFile, SchemaOptions{Dialect: DIALECT_OPENAPI_3_0})
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	// an unknown keyword of JSON Schema
+	if _, err := NewJsonSchemaDocument(mustParseJson(t, `{"discriminator":{"propertyName":"kind"}}`)); err != nil {
+		t.Errorf("Expects discriminator to be ignored out of OpenAPI, given %s", err.Error())
+	}
+
+	tests := []struct {
+		document string
+		expected []string
+	}{
+		{`{"kind":"Cat","lives":9}`, nil},
+		{`{"kind":"Sea/Lion","fins":2}`, nil},
+		{`{"kind":"hound","bark":"woof"}`, nil},
+		{`{"kind":"puppy","lives":9}`, []string{`ROOT : bark property is required`}},
+		{`{"kind":"Dog","lives":9}`, []string{`ROOT : bark property is required`}},
+		{`{"kind":"Bird"}`, []string{`ROOT : kind must match one of the discriminator values [Cat,Dog,Sea/Lion,hound,puppy]`}},
+		{`{"lives":9}`, []string{`ROOT : kind property is required and must be of type string`}},
+	}
+
+	for _, test := range tests {
+		messages := schemaDocument.Validate(mustParseJson(t, test.document)).GetErrorMessages()
+		if len(messages) != len(test.expected) {
+			t.Errorf("Expects %v for %s, given %v", test.expected, test.document, messages)
+			continue
+		}
+		for i := range test.expected {
+			if messages[i] != test.expected[i] {
+				t.Errorf("Expects %q for %s, given %q", test.expected[i], test.document, messages[i])
+			}
+		}
+	}
+}
+
+type requiredFirstScoringStrategy struct {
+	DefaultScoringStrategy
+}
+
+func (requiredFirstScoringStrategy) ErrorScore(keyword string) int {
+	if keyword == KEY_REQUIRED {
+		return -100
+	}
+	return -2
+}
+
+func TestScoringStrategy(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"anyOf":[
+		{"properties":{"a":{"type":"string"},"b":{"type":"string"},"c":{"type":"string"}}},
+		{"required":["d"]}
+	]}`)
+
+	document := mustParseJson(t, `{"a":1,"b":1,"c":1}`)
+
+	bestKeyword := func() string {
+		for _, resultError := range schemaDocument.Validate(document).GetErrors() {
+			if resultError.Keyword != KEY_ANY_OF {
+				return resultError.Keyword
+			}
+		}
+		return ""
+	}
+
+	if keyword := bestKeyword(); keyword != KEY_REQUIRED {
+		t.Errorf("Expects the %s branch to be reported, given %s", KEY_REQUIRED, keyword)
+	}
+
+	schemaDocument.SetScoringStrategy(requiredFirstScoringStrategy{})
+
+	if keyword := bestKeyword(); keyword != KEY_TYPE {
+		t.Errorf("Expects the %s branch to be reported, given %s", KEY_TYPE, keyword)
+	}
+}
+
+func TestResultScore(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"anyOf":[
+		{"properties":{"a":{"type":"string"},"b":{"type":"string"},"c":{"type":"string"}}},
+		{"required":["d"]}
+	]}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"a":1,"b":1,"c":1}`))
+	branches := result.Children()
+	if len(branches) != 2 {
+		t.Fatalf("Expects 2 failed branches, given %d", len(branches))
+	}
+	if branches[1].Score() <= branches[0].Score() {
+		t.Errorf("Expects the %s branch to score higher, given %d and %d", KEY_REQUIRED, branches[1].Score(), branches[0].Score())
+	}
+
+	properties := mustNewSchemaDocument(t, `{"properties":{"a":{"type":"string"},"b":{"type":"string"},"c":{"type":"string"}}}`)
+	closer := properties.Validate(mustParseJson(t, `{"a":1,"b":"x","c":"x"}`))
+	further := properties.Validate(mustParseJson(t, `{"a":1,"b":1,"c":1}`))
+	if closer.Score() <= further.Score() {
+		t.Errorf("Expects fewer errors to score higher, given %d and %d", closer.Score(), further.Score())
+	}
+}
+
+func TestValidationResultChild(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"items":{"oneOf":[{"type":"string"},{"type":"object","required":["id"]}]}}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `["x",{"name":"y"}]`))
+	if result.Child(KEY_ITEMS, "0") != nil {
+		t.Errorf("Expects no child for the valid element")
+	}
+	element := result.Child(KEY_ITEMS, "1")
+	if element == nil {
+		t.Fatalf("Expects a child for the invalid element")
+	}
+	if element.Context() != "ROOT.1" || element.Pointer() != "/1" {
+		t.Errorf("Expects the child to apply to ROOT.1, given %s %s", element.Context(), element.Pointer())
+	}
+	branch := element.Child(KEY_ONE_OF, "1")
+	if branch == nil || len(branch.GetErrors()) != 1 || branch.GetErrors()[0].Keyword != KEY_REQUIRED {
+		t.Errorf("Expects the errors of the second alternative, given %v", branch)
+	}
+	if result.Context() != "ROOT" || result.Pointer() != "" {
+		t.Errorf("Expects the result to apply to the root, given %s %s", result.Context(), result.Pointer())
+	}
+}
+
+func TestValidationResultChildren(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"a":{"items":{"type":"string"}},"b":{"anyOf":[{"type":"string"},{"type":"boolean"}]}}}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"a":["x",1],"b":1}`))
+
+	type node struct {
+		keyword  string
+		location string
+		nbErrors int
+	}
+	var walked []node
+	var walk func(result *ValidationResult)
+	walk = func(result *ValidationResult) {
+		for _, child := range result.Children() {
+			walked = append(walked, node{child.Keyword(), child.Location(), len(child.GetErrors())})
+			walk(child)
+		}
+	}
+	walk(result)
+
+	// properties are validated in no particular order
+	if len(walked) == 5 && walked[0].location == "b" {
+		walked = append(walked[3:], walked[:3]...)
+	}
+
+	expected := []node{
+		{KEY_PROPERTIES, "a", 1},
+		{KEY_ITEMS, "1", 1},
+		{KEY_PROPERTIES, "b", 2},
+		{KEY_ANY_OF, "0", 1},
+		{KEY_ANY_OF, "1", 1},
+	}
+	if len(walked) != len(expected) {
+		t.Fatalf("Expects %v, given %v", expected, walked)
+	}
+	for i := range expected {
+		if walked[i] != expected[i] {
+			t.Errorf("Expects %v, given %v", expected[i], walked[i])
+		}
+	}
+}
+
+func TestUseDefaults(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"properties":{
+			"port":{"type":"integer","default":8080},
+			"tags":{"type":"array","default":["a"]},
+			"nested":{"properties":{"debug":{"default":false}},"default":{}},
+			"kind":{"anyOf":[{"properties":{"x":{"default":1}}}]}
+		},
+		"required":["port"]
+	}`)
+
+	document := mustParseJson(t, `{"kind":{}}`).(map[string]interface{})
+	if schemaDocument.Validate(document).IsValid() {
+		t.Errorf("Expects an error when defaults are not used")
+	}
+
+	schemaDocument.SetUseDefaults(true)
+	result := schemaDocument.Validate(document)
+	if !result.IsValid() {
+		t.Errorf("Expects defaults to fill required properties, given %v", result.GetErrorMessages())
+	}
+
+	expected := mustParseJson(t, `{"port":8080,"tags":["a"],"nested":{"debug":false},"kind":{}}`)
+	if given, _ := marshalToString(document); *given != *mustMarshalToString(t, expected) {
+		t.Errorf("Expects %s, given %s", *mustMarshalToString(t, expected), *given)
+	}
+
+	document["tags"].([]interface{})[0] = "b"
+	if other := mustParseJson(t, `{}`); !schemaDocument.Validate(other).IsValid() || other.(map[string]interface{})["tags"].([]interface{})[0] != "a" {
+		t.Errorf("Expects default values to be copied")
+	}
+}
+
+func mustMarshalToString(t *testing.T, value interface{}) *string {
+	s, err := marshalToString(value)
+	if err != nil {
+		t.Fatalf("Could not marshal : %s", err.Error())
+	}
+	return s
+}
+
+func TestValidateAndSanitize(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"properties":{
+			"a":{"type":"string"},
+			"b":{"additionalProperties":{"type":"integer"}}
+		},
+		"patternProperties":{"^x-":{}},
+		"additionalProperties":false
+	}`)
+
+	document := mustParseJson(t, `{"a":"a","x-1":1,"unknown":1,"b":{"int":1,"string":"s"}}`)
+
+	sanitized, result := schemaDocument.ValidateAndSanitize(document)
+	if !result.IsValid() {
+		t.Errorf("Expects the sanitized document to be valid, given %v", result.GetErrorMessages())
+	}
+
+	expected := `{"a":"a","b":{"int":1},"x-1":1}`
+	if given := *mustMarshalToString(t, sanitized); given != expected {
+		t.Errorf("Expects %s, given %s", expected, given)
+	}
+
+	if schemaDocument.Validate(document).IsValid() {
+		t.Errorf("Expects the original document to be left untouched")
+	}
+}
+
+func TestValidateInto(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"name":{"type":"string"},"port":{"type":"integer","default":80}},"required":["name"]}`)
+
+	var config struct {
+		Name string `json:"name"`
+		Port int    `json:"port"`
+	}
+
+	if _, err := schemaDocument.ValidateInto([]byte(`{"port":1}`), &config); !errors.Is(err, ErrInvalidDocument) {
+		t.Errorf("Expects an invalid document error, given %v", err)
+	}
+
+	if _, err := schemaDocument.ValidateInto([]byte(`{"name":`), &config); err == nil || errors.Is(err, ErrInvalidDocument) {
+		t.Errorf("Expects a json error, given %v", err)
+	}
+
+	schemaDocument.SetUseDefaults(true)
+	result, err := schemaDocument.ValidateInto([]byte(`{"name":"server"}`), &config)
+	if err != nil || !result.IsValid() {
+		t.Fatalf("Expects no error, given %v", err)
+	}
+	if config.Name != "server" || config.Port != 80 {
+		t.Errorf("Expects the document to be decoded with its defaults, given %+v", config)
+	}
+}
+
+func TestValidateBytes(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"id":{"type":"integer","maximum":9007199254740993}}}`)
+
+	result, err := schemaDocument.ValidateBytes([]byte(`{"id":9007199254740994}`))
+	if err != nil {
+		t.Fatalf("Could not validate : %s", err.Error())
+	}
+	if result.IsValid() {
+		t.Errorf("Expects the id to be above the maximum")
+	}
+
+	result, err = schemaDocument.ValidateReader(strings.NewReader(` {"id":12} `))
+	if err != nil || !result.IsValid() {
+		t.Errorf("Expects the document to be valid, given %v", err)
+	}
+
+	for _, document := range []string{`{"id":`, `{} {}`, ``} {
+		if _, err := schemaDocument.ValidateBytes([]byte(document)); err == nil {
+			t.Errorf("Expects %q to be an error", document)
+		}
+	}
+}
+
+type testAddress struct {
+	Street string `json:"street"`
+}
+
+type testPerson struct {
+	testAddress
+	Name     string            `json:"name"`
+	Age      int               `json:"age,omitempty"`
+	Nickname *string           `json:"nickname"`
+	Tags     []string          `json:"tags"`
+	Scores   map[string]uint16 `json:"scores"`
+	Secret   string            `json:"-"`
+	internal string
+}
+
+func TestValidateGoValues(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"properties":{
+			"street":{"type":"string","minLength":1},
+			"name":{"type":"string"},
+			"age":{"type":"integer","minimum":18},
+			"nickname":{"type":["string","null"]},
+			"tags":{"type":"array","items":{"type":"string"},"uniqueItems":true},
+			"scores":{"additionalProperties":{"maximum":100}}
+		},
+		"required":["street","name"],
+		"additionalProperties":false
+	}`)
+
+	valid := testPerson{testAddress{"main"}, "bob", 20, nil, []string{"a"}, map[string]uint16{"x": 10}, "secret", "internal"}
+	if result := schemaDocument.Validate(valid); !result.IsValid() {
+		t.Errorf("Expects the struct to be valid, given %v", result.GetErrorMessages())
+	}
+	if result := schemaDocument.Validate(&valid); !result.IsValid() {
+		t.Errorf("Expects the struct pointer to be valid, given %v", result.GetErrorMessages())
+	}
+
+	invalid := testPerson{testAddress{""}, "bob", 12, nil, []string{"a", "a"}, map[string]uint16{"x": 200}, "", ""}
+	if result := schemaDocument.Validate(invalid); len(result.GetErrors()) != 4 {
+		t.Errorf("Expects 4 errors, given %v", result.GetErrorMessages())
+	}
+
+	if result := schemaDocument.Validate(map[string]interface{}{"street": "main", "name": "bob", "tags": []string{"a", "b"}}); !result.IsValid() {
+		t.Errorf("Expects typed slices to be valid, given %v", result.GetErrorMessages())
+	}
+
+	if result := schemaDocument.Validate(map[string]interface{}{"street": "main", "name": make(chan int)}); result.IsValid() {
+		t.Errorf("Expects a channel to be invalid")
+	}
+}
+
+type testName struct {
+	Name string `json:"name"`
+	Tag  string
+}
+
+type testAlias struct {
+	Tag string `json:"Tag"`
+}
+
+type testLabel string
+
+func (l *testLabel) MarshalText() ([]byte, error) {
+	return []byte("label:" + string(*l)), nil
+}
+
+type testNode struct {
+	Label testLabel `json:"label"`
+	Next  *testNode `json:"next,omitempty"`
+}
+
+func TestValidateGoValuesAsEncodingJson(t *testing.T) {
+
+	// the tagged Tag wins over the untagged one at the same depth, the outer
+	// name wins over the embedded one
+	conflicts := struct {
+		testName
+		testAlias
+		Name string `json:"name"`
+	}{testName{"inner", "untagged"}, testAlias{"tagged"}, "outer"}
+	value, err := toJsonValue(conflicts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"name": "outer", "Tag": "tagged"}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Expects %v, given %v", expected, value)
+	}
+
+	// the untagged fields of a name at the same depth conflict and are dropped
+	type testTag struct {
+		Tag string
+	}
+	value, err = toJsonValue(struct {
+		testName
+		testTag
+		testAddress
+	}{testName{"inner", "untagged"}, testTag{"other"}, testAddress{"main"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]interface{}{"name": "inner", "street": "main"}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Expects %v, given %v", expected, value)
+	}
+
+	// the tagged field wins at the same depth, even behind a nil embedded pointer
+	type testPromoted struct {
+		testName
+		*testAlias
+		Extra struct {
+			testName
+			testAlias
+		} `json:"extra"`
+	}
+	promoted := testPromoted{testName: testName{"n", "untagged"}}
+	promoted.Extra.testName = testName{"n", "untagged"}
+	promoted.Extra.testAlias = testAlias{"tagged"}
+	value, err = toJsonValue(promoted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	marshalled, _ := marshalToJsonValue(promoted)
+	if !reflect.DeepEqual(value, marshalled) {
+		t.Errorf("Expects %v as encoding/json, given %v", marshalled, value)
+	}
+
+	// the pointer receiver methods are used on addressable values
+	node := &testNode{Label: "a", Next: &testNode{Label: "b"}}
+	value, err = toJsonValue(node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]interface{}{"label": "label:a", "next": map[string]interface{}{"label": "label:b"}}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Expects %v, given %v", expected, value)
+	}
+
+	node.Next.Next = node
+	if _, err := toJsonValue(node); err == nil {
+		t.Errorf("Expects a cycle to be an error")
+	}
+	shared := &testNode{Label: "shared"}
+	if _, err := toJsonValue([]*testNode{shared, shared}); err != nil {
+		t.Errorf("Expects a pointer used twice not to be a cycle, given %s", err.Error())
+	}
+}
+
+type testBigNumber int64
+
+func (n testBigNumber) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(n), 10)), nil
+}
+
+func TestGoValuesAsJsonMarshal(t *testing.T) {
+
+	name := "<b>"
+	ratio := 0.5
+	values := []interface{}{
+		struct {
+			Slice  []int          `json:",omitempty"`
+			Map    map[string]int `json:",omitempty"`
+			Array  [2]int         `json:",omitempty"`
+			Empty  [0]int         `json:",omitempty"`
+			Struct struct{}       `json:",omitempty"`
+			Text   string         `json:",omitempty"`
+			Ptr    *int           `json:",omitempty"`
+		}{Slice: []int{}, Map: map[string]int{}},
+		struct {
+			Name    string   `json:",string"`
+			Count   int64    `json:",string"`
+			Ratio   float64  `json:",string"`
+			Small   float64  `json:",string"`
+			Single  float32  `json:",string"`
+			Flag    bool     `json:",string"`
+			Pointer *string  `json:",string"`
+			Ratios  *float64 `json:",string"`
+			Nil     *int     `json:",string"`
+			Items   []int    `json:",string"`
+		}{Name: `say "hi" & <bye>`, Count: 1 << 60, Ratio: 1e21, Small: 1e-7, Single: 0.1, Flag: true, Pointer: &name, Ratios: &ratio, Items: []int{1}},
+		struct {
+			Float32 float32
+			Big     testBigNumber
+			Bigs    []testBigNumber
+		}{Float32: 3.3, Big: 1<<53 + 1, Bigs: []testBigNumber{1<<62 + 1}},
+	}
+
+	for _, value := range values {
+		expected, err := json.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		jsonValue, err := toJsonValue(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		given, err := json.Marshal(jsonValue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the keys of the maps are sorted, not in the order of the fields
+		if normalized := normalizeJson(t, expected); normalizeJson(t, given) != normalized {
+			t.Errorf("Expects %s, given %s", normalized, given)
+		}
+	}
+}
+
+// Writes json text with the keys sorted, the numbers as written
+func normalizeJson(t *testing.T, text []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(text))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatal(err)
+	}
+	normalized, _ := json.Marshal(value)
+	return string(normalized)
+}
+
+func TestValidateJsonNumber(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"id":{"type":"integer","maximum":9007199254740992},"ratio":{"type":"number","multipleOf":0.5},"name":{"type":"string"}}}`)
+
+	decode := func(s string) interface{} {
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+		var document interface{}
+		if err := decoder.Decode(&document); err != nil {
+			t.Fatalf("Could not parse json : %s", err.Error())
+		}
+		return document
+	}
+
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`{"id":9007199254740992,"ratio":1.5}`, true},
+		{`{"id":9007199254740993}`, false},
+		{`{"id":1.5}`, false},
+		{`{"id":2.0}`, true},
+		{`{"ratio":1.25}`, false},
+		{`{"name":1}`, false},
+	}
+
+	for _, test := range tests {
+		result := schemaDocument.Validate(decode(test.document))
+		if result.IsValid() != test.valid {
+			t.Errorf("Expects %s to be valid %t, given %v", test.document, test.valid, result.GetErrorMessages())
+		}
+	}
+
+	if result := schemaDocument.Validate(map[string]interface{}{"id": int64(9007199254740993)}); result.IsValid() {
+		t.Errorf("Expects a large int64 to keep its precision")
+	}
+}
+
+func TestIntegerBounds(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"max":{"maximum":9223372036854774784},"min":{"minimum":-9223372036854774784,"exclusiveMinimum":true}}}`)
+
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`{"max":9223372036854774784}`, true},
+		{`{"max":9223372036854774785}`, false},
+		{`{"max":9223372036854775807}`, false},
+		{`{"max":9223372036854774783.5}`, true},
+		{`{"min":-9223372036854774783}`, true},
+		{`{"min":-9223372036854774784}`, false},
+		{`{"min":-9223372036854774785}`, false},
+	}
+
+	for _, test := range tests {
+		result, err := schemaDocument.ValidateBytes([]byte(test.document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.IsValid() != test.valid {
+			t.Errorf("Expects %s to be valid %t, given %v", test.document, test.valid, result.GetErrorMessages())
+		}
+	}
+
+	if result := schemaDocument.Validate(map[string]interface{}{"max": int64(9223372036854774785)}); result.IsValid() {
+		t.Errorf("Expects an int64 above the maximum to be invalid")
+	}
+}
+
+func TestExactNumberBounds(t *testing.T) {
+
+	// 2^53 + 1 has no float64
+	decoder := json.NewDecoder(strings.NewReader(`{"properties":{"a":{"minimum":9007199254740993,"maximum":1e400},"b":{"exclusiveMaximum":0.3,"minLength":1},"c":{"multipleOf":9007199254740993}}}`))
+	decoder.UseNumber()
+	var schema interface{}
+	if err := decoder.Decode(&schema); err != nil {
+		t.Fatal(err)
+	}
+	schemaDocument, err := NewJsonSchemaDocument(schema)
+	if err != nil {
+		t.Fatalf("Expects json.Number keywords to be numbers, given %s", err.Error())
+	}
+
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`{"a":9007199254740992}`, false},
+		{`{"a":9007199254740993}`, true},
+		{`{"c":18014398509481984}`, false},
+		{`{"c":18014398509481986}`, true},
+		{`{"b":0.3}`, false},
+		{`{"b":0.29999999999999999999}`, true},
+	}
+	for _, test := range tests {
+		result, err := schemaDocument.ValidateBytes([]byte(test.document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.IsValid() != test.valid {
+			t.Errorf("Expects %s to be valid %t, given %v", test.document, test.valid, result.GetErrorMessages())
+		}
+	}
+
+	result, _ := schemaDocument.ValidateBytes([]byte(`{"a":9007199254740992}`))
+	if messages := result.GetErrorMessages(); len(messages) != 1 || !strings.HasSuffix(messages[0], "must be greater than 9007199254740993") {
+		t.Errorf("Expects the bound to be written as in the schema, given %v", messages)
+	}
+
+	marshalled, _ := json.Marshal(schemaDocument)
+	if !strings.Contains(string(marshalled), `"minimum":9007199254740993`) {
+		t.Errorf("Expects the exact bound to be marshalled, given %s", marshalled)
+	}
+	binary, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unmarshalled JsonSchemaDocument
+	if err := unmarshalled.UnmarshalBinary(binary); err != nil {
+		t.Fatal(err)
+	}
+	if result, _ := unmarshalled.ValidateBytes([]byte(`{"a":9007199254740992}`)); result.IsValid() {
+		t.Errorf("Expects the exact bound to be kept by the binary form")
+	}
+}
+
+func TestNumericExclusiveBounds(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"minimum":0,"exclusiveMinimum":0,"exclusiveMaximum":10}`)
+
+	tests := []struct {
+		document string
+		types    []ErrorType
+	}{
+		{`5`, nil},
+		{`0`, []ErrorType{ERROR_TYPE_EXCLUSIVE_MINIMUM}},
+		{`-1`, []ErrorType{ERROR_TYPE_MINIMUM, ERROR_TYPE_EXCLUSIVE_MINIMUM}},
+		{`10`, []ErrorType{ERROR_TYPE_EXCLUSIVE_MAXIMUM}},
+	}
+
+	for _, test := range tests {
+		result := schemaDocument.Validate(mustParseJson(t, test.document))
+		if len(result.GetErrors()) != len(test.types) {
+			t.Errorf("Expects %s to have the errors %v, given %v", test.document, test.types, result.GetErrorMessages())
+			continue
+		}
+		for _, errorType := range test.types {
+			if !hasErrorType(result, errorType) {
+				t.Errorf("Expects %s to have an error %s, given %v", test.document, errorType, result.GetErrorMessages())
+			}
+		}
+	}
+
+	result := schemaDocument.Validate(mustParseJson(t, `10`))
+	if resultError := result.GetErrors()[0]; resultError.Keyword != KEY_EXCLUSIVE_MAXIMUM || !strings.Contains(resultError.Description, "exclusiveMaximum 10") {
+		t.Errorf("Expects the error to name the exclusiveMaximum, given %v", resultError)
+	}
+
+	text, err := schemaDocument.MarshalJSON()
+	if err != nil || !strings.Contains(string(text), `"exclusiveMaximum":10`) {
+		t.Errorf("Expects the numeric bound to be marshaled, given %s %v", text, err)
+	}
+	data, err := schemaDocument.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if result := loaded.Validate(mustParseJson(t, `10`)); !hasErrorType(result, ERROR_TYPE_EXCLUSIVE_MAXIMUM) {
+		t.Errorf("Expects the loaded schema to keep the numeric bound, given %v", result.GetErrorMessages())
+	}
+
+	if _, err := NewJsonSchemaDocument(mustParseJson(t, `{"exclusiveMinimum":"1"}`)); err == nil {
+		t.Errorf("Expects a string exclusiveMinimum to be a schema error")
+	}
+}
+
+func TestStrictIntegers(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"type":"integer"}`)
+
+	tests := []struct {
+		document string
+		valid    bool
+		strict   bool
+	}{
+		{`1`, true, true},
+		{`-12`, true, true},
+		{`1.0`, true, false},
+		{`1e2`, true, false},
+		{`1.5`, false, false},
+		{`100e-2`, true, false},
+		{`1.25e1`, false, false},
+		{`1.25e2`, true, false},
+		{`0.0e-999999999999`, true, false},
+		{`1e9999999`, true, false},
+		{`1e-9999999`, false, false},
+	}
+
+	for _, strict := range []bool{false, true} {
+		schemaDocument.SetStrictIntegers(strict)
+		for _, test := range tests {
+			result, err := schemaDocument.ValidateBytes([]byte(test.document))
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := test.valid
+			if strict {
+				expected = test.strict
+			}
+			if result.IsValid() != expected {
+				t.Errorf("Expects %s to be valid %t in strict mode %t, given %v", test.document, expected, strict, result.GetErrorMessages())
+			}
+		}
+	}
+
+	if result := schemaDocument.Validate(1.0); !result.IsValid() {
+		t.Errorf("Expects a float64 with a zero fraction to be an integer, given %v", result.GetErrorMessages())
+	}
+}
+
+func TestArbitraryPrecision(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"multipleOf":0.1,"maximum":0.3}`)
+
+	document := mustParseJson(t, `0.3`)
+
+	schemaDocument.SetArbitraryPrecision(true)
+	if result := schemaDocument.Validate(document); !result.IsValid() {
+		t.Errorf("Expects 0.3 to be a multiple of 0.1, given %v", result.GetErrorMessages())
+	}
+	if result := schemaDocument.Validate(mustParseJson(t, `0.35`)); len(result.GetErrors()) != 2 {
+		t.Errorf("Expects 2 errors, given %v", result.GetErrorMessages())
+	}
+}
+
+func TestMultipleOfDecimals(t *testing.T) {
+
+	tests := []struct {
+		value      string
+		multipleOf string
+		valid      bool
+	}{
+		{`19.99`, `0.01`, true},
+		{`0.3`, `0.1`, true},
+		{`4.35`, `0.05`, true},
+		{`1e308`, `0.5`, true},
+		{`19.995`, `0.01`, false},
+		{`0.31`, `0.1`, false},
+		{`7`, `2`, false},
+	}
+
+	for _, test := range tests {
+		schemaDocument := mustNewSchemaDocument(t, `{"multipleOf":`+test.multipleOf+`}`)
+		if result := schemaDocument.Validate(mustParseJson(t, test.value)); result.IsValid() != test.valid {
+			t.Errorf("Expects %s multipleOf %s to be valid %t, given %v", test.value, test.multipleOf, test.valid, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestValidateTypes(t *testing.T) {
+
+	values := map[string]interface{}{
+		"null":    nil,
+		"boolean": true,
+		"integer": 1.0,
+		"number":  1.5,
+		"string":  "a",
+		"array":   []interface{}{},
+		"object":  map[string]interface{}{},
+	}
+
+	for schemaType := range values {
+		schemaDocument := mustNewSchemaDocument(t, `{"type":"`+schemaType+`"}`)
+		for valueType, value := range values {
+			expected := valueType == schemaType || (schemaType == "number" && valueType == "integer")
+			if result := schemaDocument.Validate(value); result.IsValid() != expected {
+				t.Errorf("Expects %s to be valid against type %s %t, given %v", valueType, schemaType, expected, result.GetErrorMessages())
+			}
+		}
+		// numbers decoded with UseNumber
+		if result := schemaDocument.Validate(json.Number("2")); result.IsValid() != (schemaType == "integer" || schemaType == "number") {
+			t.Errorf("Expects json.Number validity against type %s, given %v", schemaType, result.GetErrorMessages())
+		}
+	}
+}
+
+func TestCollectAnnotations(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"title":"Order",
+		"properties":{
+			"customer":{"title":"Customer","description":"Who ordered","examples":["Ann"]},
+			"quantity":{"allOf":[{"title":"Quantity"},{"default":1}]},
+			"items":{"items":{"title":"Item"}},
+			"note":{"anyOf":[{"type":"number","title":"Number"},{"title":"Text"}]}
+		}
+	}`)
+
+	document := mustParseJson(t, `{"customer":"Bob","quantity":2,"items":["a"],"note":"n"}`)
+	if result := schemaDocument.Validate(document); len(result.GetAnnotations()) != 0 {
+		t.Errorf("Expects no annotation by default, given %d", len(result.GetAnnotations()))
+	}
+
+	schemaDocument.SetCollectAnnotations(true)
+	result := schemaDocument.Validate(document)
+
+	var given []string
+	for _, annotation := range result.GetAnnotations() {
+		value, _ := json.Marshal(annotation.Value)
+		given = append(given, annotation.Context+" "+annotation.Keyword+" "+string(value))
+	}
+	expected := []string{
+		`ROOT title "Order"`,
+		`ROOT.customer title "Customer"`,
+		`ROOT.customer description "Who ordered"`,
+		`ROOT.customer examples ["Ann"]`,
+		`ROOT.items.0 title "Item"`,
+		`ROOT.note title "Text"`,
+		`ROOT.quantity title "Quantity"`,
+		`ROOT.quantity default 1`,
+	}
+	if strings.Join(given, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects annotations :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(given, "\n"))
+	}
+
+	if annotations := result.GetAnnotationsByContext()["ROOT.customer"]; len(annotations) != 3 {
+		t.Errorf("Expects 3 annotations on ROOT.customer, given %d", len(annotations))
+	}
+}
+
+func TestMatchedBranches(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"properties":{
+			"payment":{"oneOf":[
+				{"$id":"card","title":"Card","required":["number"]},
+				{"$id":"transfer","title":"Transfer","required":["iban"]}
+			]},
+			"tags":{"items":{"anyOf":[{"type":"number"},{"type":"string","title":"Tag"}]}}
+		}
+	}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"payment":{"iban":"FR76"},"tags":[1,"a"]}`))
+	if !result.IsValid() {
+		t.Fatalf("Expects a valid document, given %v", result.GetErrorMessages())
+	}
+
+	var given []string
+	for _, match := range result.GetMatchedBranches() {
+		given = append(given, fmt.Sprintf("%s %s %d %s %s", match.Context, match.Keyword, match.Index, match.Id, match.Title))
+	}
+	expected := []string{
+		`ROOT.payment oneOf 1 transfer Transfer`,
+		`ROOT.tags.0 anyOf 0  `,
+		`ROOT.tags.1 anyOf 1  Tag`,
+	}
+	if strings.Join(given, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expects matched branches :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(given, "\n"))
+	}
+
+	// a oneOf matching several branches is not valid, no branch is reported
+	result = schemaDocument.Validate(mustParseJson(t, `{"payment":{"iban":"FR76","number":"4970"}}`))
+	if result.IsValid() || len(result.GetMatchedBranches()) != 0 {
+		t.Errorf("Expects no matched branch, given %v", result.GetMatchedBranches())
+	}
+}
+
+func TestPooledResults(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"type":"array",
+		"items":{
+			"properties":{"id":{"type":"integer","minimum":1}},
+			"anyOf":[{"required":["a"]},{"required":["b"]}],
+			"not":{"required":["c"]}
+		}
+	}`)
+
+	// results of the sub-schemas are reused, errors must not leak between validations
+	for i := 0; i != 3; i++ {
+		result := schemaDocument.Validate(mustParseJson(t, `[{"id":1,"a":1},{"id":0,"b":1},{"id":2,"c":1}]`))
+		expected := []string{
+			`ROOT.1.id : id (0) must be greater than 1`,
+			`ROOT.2 : items failed to validate any of the schema`,
+			`ROOT.2 : items is not allowed to validate the schema`,
+			`ROOT.2 : a property is required`,
+		}
+		if strings.Join(result.GetErrorMessages(), "\n") != strings.Join(expected, "\n") {
+			t.Errorf("Expects errors :\n%s\ngiven :\n%s", strings.Join(expected, "\n"), strings.Join(result.GetErrorMessages(), "\n"))
+		}
+		if result := schemaDocument.Validate(mustParseJson(t, `[{"id":1,"a":1}]`)); !result.IsValid() {
+			t.Errorf("Expects a valid document, given %v", result.GetErrorMessages())
+		}
+	}
+}
+
+func TestResultErrorPointer(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"a/b":{"items":{"maximum":1}}},"required":["c"]}`)
+	result := schemaDocument.Validate(mustParseJson(t, `{"a/b":[0,2]}`))
+
+	var pointers []string
+	for _, resultError := range result.GetErrors() {
+		pointers = append(pointers, resultError.Pointer())
+	}
+	if strings.Join(pointers, " ") != " /a~1b/1" {
+		t.Errorf("Expects the pointers ' /a~1b/1', given '%s'", strings.Join(pointers, " "))
+	}
+}
+
+func TestResultErrorPath(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"1":{"items":{"maximum":1}}}}`)
+	resultError := schemaDocument.Validate(mustParseJson(t, `{"1":[0,2]}`)).GetErrors()[0]
+
+	if tokens := resultError.Tokens(); len(tokens) != 2 || tokens[0] != "1" || tokens[1] != "1" {
+		t.Errorf("Expects the tokens [1 1], given %v", tokens)
+	}
+	path := resultError.Path()
+	if len(path) != 2 || path[0] != "1" || path[1] != 1 {
+		t.Errorf("Expects the property \"1\" then the index 1, given %#v", path)
+	}
+
+	document := mustParseJson(t, `{"1":[0,2]}`)
+	var value interface{} = document
+	for _, element := range path {
+		switch element := element.(type) {
+		case string:
+			value = value.(map[string]interface{})[element]
+		case int:
+			value = value.([]interface{})[element]
+		}
+	}
+	if fmt.Sprint(value) != "2" {
+		t.Errorf("Expects the path to lead to the failing value, given %v", value)
+	}
+}
+
+func TestAdditionalPropertySuggestion(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"userName":{},"email":{},"id":{}},"additionalProperties":false}`)
+
+	tests := []struct {
+		property   string
+		suggestion string
+	}{
+		{"usrName", "userName"},
+		{"username", "userName"},
+		{"emial", "email"},
+		{"ix", "id"},
+		{"address", ""},
+		{"x", ""},
+	}
+
+	for _, test := range tests {
+		result := schemaDocument.Validate(map[string]interface{}{test.property: 1})
+		if len(result.GetErrors()) != 1 {
+			t.Fatalf("Expects an error for %s, given %v", test.property, result.GetErrorMessages())
+		}
+		resultError := result.GetErrors()[0]
+		if resultError.Suggestion != test.suggestion {
+			t.Errorf("Expects the suggestion '%s' for %s, given '%s'", test.suggestion, test.property, resultError.Suggestion)
+		}
+		if test.suggestion != "" && !strings.HasSuffix(resultError.Description, `did you mean "`+test.suggestion+`"?`) {
+			t.Errorf("Expects the suggestion in the description, given %s", resultError.Description)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"emial", "email", 1},
+		{"été", "ete", 2},
+	}
+	for _, test := range tests {
+		if distance := levenshteinDistance(test.a, test.b); distance != test.distance {
+			t.Errorf("Expects a distance of %d between %s and %s, given %d", test.distance, test.a, test.b, distance)
+		}
+	}
+}
+
+func TestEnumNumbers(t *testing.T) {
+
+	decoder := json.NewDecoder(strings.NewReader(`{"items":{"enum":[1,2.5,{"a":[10]}]},"uniqueItems":true}`))
+	decoder.UseNumber()
+	var numberSchema interface{}
+	if err := decoder.Decode(&numberSchema); err != nil {
+		t.Fatal(err)
+	}
+	// the schema numbers decoded as float64 and as json.Number
+	schemaDocuments := []*JsonSchemaDocument{mustNewSchemaDocument(t, `{"items":{"enum":[1,2.5,{"a":[10]}]},"uniqueItems":true}`)}
+	numberSchemaDocument, err := NewJsonSchemaDocument(numberSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaDocuments = append(schemaDocuments, numberSchemaDocument)
+
+	tests := []struct {
+		document string
+		valid    bool
+	}{
+		{`[1.0,2.50]`, true},
+		{`[1e0,25e-1,{"a":[1e1]}]`, true},
+		{`[0.1e1]`, true},
+		{`[2]`, false},
+		{`[-1]`, false},
+		{`[1,1.0]`, false},
+	}
+
+	for _, schemaDocument := range schemaDocuments {
+		for _, test := range tests {
+			// json.Number values
+			result, err := schemaDocument.ValidateBytes([]byte(test.document))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.IsValid() != test.valid {
+				t.Errorf("Expects %s to be valid %t as json.Number, given %v", test.document, test.valid, result.GetErrorMessages())
+			}
+			// float64 values
+			if result := schemaDocument.Validate(mustParseJson(t, test.document)); result.IsValid() != test.valid {
+				t.Errorf("Expects %s to be valid %t as float64, given %v", test.document, test.valid, result.GetErrorMessages())
+			}
+		}
+	}
+
+	if _, err := NewJsonSchemaDocument(mustParseJson(t, `{"enum":[1,1.0]}`)); err == nil {
+		t.Errorf("Expects the equal numbers of an enum not to be unique")
+	}
+}
+
+func TestEnumErrors(t *testing.T) {
+
+	var values []string
+	for i := 0; i < 25; i++ {
+		values = append(values, fmt.Sprintf(`"v%d"`, i))
+	}
+	schemaDocument := mustNewSchemaDocument(t, `{"properties":{"code":{"enum":[`+strings.Join(values, ",")+`]},"size":{"enum":["s","m",1]}}}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"code":"v25","size":2}`))
+	expected := []string{
+		`code must match one of the enum values ["v0","v1","v2","v3","v4","v5","v6","v7","v8","v9", ... 15 more], given "v25"`,
+		`size must match one of the enum values ["s","m",1], given 2`,
+	}
+	for i, resultError := range result.GetErrors() {
+		if resultError.Description != expected[i] {
+			t.Errorf("Expects the error %s, given %s", expected[i], resultError.Description)
+		}
+	}
+	if enumValues := result.GetErrors()[0].EnumValues; len(enumValues) != 25 {
+		t.Errorf("Expects all the enum values in the error, given %v", enumValues)
+	}
+
+	schemaDocument.SetEnumErrorLimit(2)
+	result = schemaDocument.Validate(mustParseJson(t, `{"size":2}`))
+	if description := result.GetErrors()[0].Description; description != `size must match one of the enum values ["s","m", ... 1 more], given 2` {
+		t.Errorf("Expects 2 enum values in the error, given %s", description)
+	}
+	schemaDocument.SetEnumErrorLimit(-1)
+	result = schemaDocument.Validate(mustParseJson(t, `{"code":true}`))
+	if description := result.GetErrors()[0].Description; !strings.Contains(description, `"v24"], given true`) {
+		t.Errorf("Expects all the enum values in the error, given %s", description)
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+
+	var schema map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"type":"array",
+		"items":{
+			"type":"object",
+			"properties":{"id":{"type":"integer","minimum":1},"name":{"type":"string","maxLength":16},"tags":{"items":{"enum":["a","b","c"]}}},
+			"required":["id"],
+			"oneOf":[{"required":["name"]},{"required":["tags"]}]
+		}
+	}`), &schema)
+	schemaDocument, err := NewJsonSchemaDocument(schema)
+	if err != nil {
+		b.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	var document interface{}
+	json.Unmarshal([]byte(`[{"id":1,"name":"a"},{"id":2,"tags":["a","b"]},{"id":3,"name":"b"},{"id":4,"tags":["c"]}]`), &document)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		schemaDocument.Validate(document)
+	}
+}
+
+func TestErrorTypes(t *testing.T) {
+
+	testCases := []struct {
+		schema   string
+		document string
+		expected ErrorType
+	}{
+		{`{"type":"string"}`, `1`, ERROR_TYPE_TYPE},
+		{`{"required":["a"]}`, `{}`, ERROR_TYPE_REQUIRED},
+		{`{"pattern":"^a"}`, `"b"`, ERROR_TYPE_PATTERN},
+		{`{"maximum":5}`, `6`, ERROR_TYPE_MAXIMUM},
+		{`{"maximum":5,"exclusiveMaximum":true}`, `5`, ERROR_TYPE_EXCLUSIVE_MAXIMUM},
+		{`{"minimum":5,"exclusiveMinimum":true}`, `5`, ERROR_TYPE_EXCLUSIVE_MINIMUM},
+		{`{"enum":[1,2]}`, `3`, ERROR_TYPE_ENUM},
+		{`{"properties":{"a":{}},"additionalProperties":false}`, `{"b":1}`, ERROR_TYPE_ADDITIONAL_PROPERTY},
+		{`{"maxItems":1}`, `[1,2]`, ERROR_TYPE_MAX_ITEMS},
+	}
+	for _, testCase := range testCases {
+		result := mustNewSchemaDocument(t, testCase.schema).Validate(mustParseJson(t, testCase.document))
+		if len(result.GetErrors()) != 1 || result.GetErrors()[0].Type != testCase.expected {
+			t.Errorf("Expects one error of type %s validating %s against %s, given %v", testCase.expected, testCase.document, testCase.schema, result.GetErrors())
+		}
+	}
+
+	result := mustNewSchemaDocument(t, `{"oneOf":[{"type":"string"},{"type":"integer"}]}`).Validate(mustParseJson(t, `true`))
+	if !hasErrorType(result, ERROR_TYPE_ONE_OF_NONE) {
+		t.Errorf("Expects an error of type %s, given %v", ERROR_TYPE_ONE_OF_NONE, result.GetErrors())
+	}
+	result = mustNewSchemaDocument(t, `{"oneOf":[{"type":"string"},{"maxLength":1}]}`).Validate(mustParseJson(t, `"a"`))
+	if !hasErrorType(result, ERROR_TYPE_ONE_OF_MULTIPLE) {
+		t.Errorf("Expects an error of type %s, given %v", ERROR_TYPE_ONE_OF_MULTIPLE, result.GetErrors())
+	}
+}
+
+func hasErrorType(result *ValidationResult, errorType ErrorType) bool {
+	for _, resultError := range result.GetErrors() {
+		if resultError.Type == errorType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRootName(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"items":{"type":"string"}}`)
+	document := mustParseJson(t, `["x",1]`)
+
+	for _, test := range []struct {
+		name     string
+		expected string
+	}{
+		{"payload", "payload.1"},
+		{"", "1"},
+	} {
+		schemaDocument.SetRootName(test.name)
+		result := schemaDocument.Validate(document)
+		if context := result.GetErrors()[0].Context; context != test.expected {
+			t.Errorf("Expects the context %s, given %s", test.expected, context)
+		}
+		if pointer := result.GetErrors()[0].Pointer(); pointer != "/1" {
+			t.Errorf("Expects the pointer /1, given %s", pointer)
+		}
+	}
+}
+
+func TestIgnoredKeywords(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{
+		"required":["id","name"],
+		"properties":{"id":{"type":"integer"},"mail":{"type":"string","format":"email"}},
+		"additionalProperties":false
+	}`)
+	patch := mustParseJson(t, `{"mail":"nope"}`)
+
+	result := schemaDocument.ValidateIgnoring(patch, KEY_REQUIRED)
+	if len(result.GetErrors()) != 1 || result.GetErrors()[0].Keyword != KEY_FORMAT {
+		t.Errorf("Expects only the format error, given %v", result.GetErrors())
+	}
+	result = schemaDocument.ValidateIgnoring(patch, KEY_REQUIRED, KEY_FORMAT)
+	if !result.IsValid() {
+		t.Errorf("Expects the patch to be valid, given %v", result.GetErrors())
+	}
+	if result = schemaDocument.Validate(patch); len(result.GetErrors()) != 3 {
+		t.Errorf("Expects Validate to check all the keywords, given %v", result.GetErrors())
+	}
+
+	schemaDocument.SetIgnoredKeywords(KEY_PROPERTIES, KEY_ADDITIONAL_PROPERTIES)
+	if result = schemaDocument.ValidateIgnoring(mustParseJson(t, `{"id":"x","other":1}`), KEY_REQUIRED); !result.IsValid() {
+		t.Errorf("Expects the ignored keywords to be added up, given %v", result.GetErrors())
+	}
+	if result = schemaDocument.Validate(mustParseJson(t, `{"id":"x"}`)); len(result.GetErrors()) != 1 || result.GetErrors()[0].Keyword != KEY_REQUIRED {
+		t.Errorf("Expects only the required error, given %v", result.GetErrors())
+	}
+
+	// the other keywords are still checked on a value of another type
+	result = mustNewSchemaDocument(t, `{"type":"integer","enum":[1]}`).ValidateIgnoring(mustParseJson(t, `"x"`), KEY_TYPE)
+	if len(result.GetErrors()) != 1 || result.GetErrors()[0].Keyword != KEY_ENUM {
+		t.Errorf("Expects only the enum error, given %v", result.GetErrors())
+	}
+}
+
+func BenchmarkValidateLargeObject(b *testing.B) {
+
+	properties := map[string]interface{}{}
+	var required []interface{}
+	document := map[string]interface{}{}
+	for i := 0; i < 1000; i++ {
+		name := fmt.Sprintf("p%d", i)
+		properties[name] = map[string]interface{}{"type": "integer"}
+		required = append(required, name)
+		document[name] = float64(i)
+	}
+	schemaDocument, err := NewJsonSchemaDocument(map[string]interface{}{"properties": properties, "required": required, "additionalProperties": false})
+	if err != nil {
+		b.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		schemaDocument.Validate(document)
+	}
+}
+
+func TestClosedProperties(t *testing.T) {
+
+	closed := mustNewSchemaDocument(t, `{"properties":{"name":{}},"additionalProperties":false}`)
+	patterns := mustNewSchemaDocument(t, `{"properties":{"name":{}},"patternProperties":{"^x-":{}},"additionalProperties":false}`)
+	if !closed.rootSchema.closedProperties || patterns.rootSchema.closedProperties {
+		t.Fatalf("Expects only the schema without patternProperties to close its properties")
+	}
+
+	data, err := closed.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &JsonSchemaDocument{}
+	if err := loaded.UnmarshalBinary(data); err != nil || !loaded.rootSchema.closedProperties {
+		t.Fatalf("Expects the loaded schema to close its properties, given %v", err)
+	}
+
+	for _, schemaDocument := range []*JsonSchemaDocument{closed, loaded} {
+		result := schemaDocument.Validate(mustParseJson(t, `{"name":1,"nmae":2,"x-a":3}`))
+		if len(result.GetErrors()) != 2 || result.GetErrors()[0].Suggestion != "name" && result.GetErrors()[1].Suggestion != "name" {
+			t.Errorf("Expects the 2 undeclared properties to be reported, given %v", result.GetErrors())
+		}
+	}
+
+	sanitized, result := closed.ValidateAndSanitize(mustParseJson(t, `{"name":1,"other":2}`))
+	if !result.IsValid() || len(sanitized.(map[string]interface{})) != 1 {
+		t.Errorf("Expects the undeclared property to be removed, given %v", sanitized)
+	}
+}
+
+func TestPatternPropertiesMatching(t *testing.T) {
+
+	schemaDocument := mustNewSchemaDocument(t, `{"patternProperties":{"^x-":{"type":"string"},"-a$":{"minLength":2}},"additionalProperties":false}`)
+
+	result := schemaDocument.Validate(mustParseJson(t, `{"x-a":"1","x-b":"ok","other":1}`))
+	errs := result.GetErrors()
+	if len(errs) != 2 {
+		t.Fatalf("Expects 2 errors, given %v", errs)
+	}
+	for _, resultError := range errs {
+		switch resultError.Context {
+		case "ROOT":
+			if resultError.Keyword != KEY_ADDITIONAL_PROPERTIES || !strings.Contains(resultError.Description, "other") {
+				t.Errorf("Expects only the unmatched property to be additional, given %v", resultError)
+			}
+		case "ROOT.x-a":
+			if resultError.Keyword != KEY_MIN_LENGTH {
+				t.Errorf("Expects the property to be validated against each matching pattern, given %v", resultError)
+			}
+		default:
+			t.Errorf("Unexpected error %v", resultError)
+		}
+	}
+
+	delete(schemaDocument.rootSchema.patternPropertiesRegexp, "-a$")
+	result = schemaDocument.Validate(mustParseJson(t, `{"x-a":"ok"}`))
+	if len(result.GetErrors()) != 1 || result.GetErrors()[0].Keyword != KEY_PATTERN_PROPERTIES {
+		t.Errorf("Expects the pattern without a regex to be reported, given %v", result.GetErrors())
+	}
+}
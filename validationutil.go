@@ -30,6 +30,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 func isKind(what interface{}, kind reflect.Kind) bool {
@@ -83,3 +84,96 @@ func marshalToString(value interface{}) (*string, error) {
 	sBytes := string(mBytes)
 	return &sBytes, nil
 }
+
+// Marshals a json value with its numbers in a canonical form, the values equal
+// as json have the same string : 1, 1.0 and 1e0 are the same number
+func marshalToCanonicalString(value interface{}) (*string, error) {
+	return marshalToString(canonicalNumbers(value))
+}
+
+// Copies the maps and slices of a json value holding numbers, the numbers
+// replaced by their canonical literal
+func canonicalNumbers(value interface{}) interface{} {
+	switch value := value.(type) {
+	case float64:
+		return json.Number(canonicalNumberLiteral(strconv.FormatFloat(value, 'g', -1, 64)))
+	case json.Number:
+		return json.Number(canonicalNumberLiteral(string(value)))
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = canonicalNumbers(v)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(value))
+		for i, v := range value {
+			a[i] = canonicalNumbers(v)
+		}
+		return a
+	}
+	return value
+}
+
+// Deep copies a json value made of maps, slices and simple values
+func copyJson(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = copyJson(v)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(value))
+		for i, v := range value {
+			a[i] = copyJson(v)
+		}
+		return a
+	}
+	return value
+}
+
+// levenshteinDistance counts the runes to insert, delete or substitute to
+// change a into b, swapping two adjacent runes counting as a single edit
+func levenshteinDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	distances := make([][]int, len(ra)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(rb)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			distances[i][j] = min(distances[i-1][j]+1, distances[i][j-1]+1, distances[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+	return distances[len(ra)][len(rb)]
+}
+
+// closestName returns the candidate a mistyped name most likely stands for,
+// ignoring the case, empty when none is close enough
+func closestName(name string, candidates []string) string {
+	closest, closestDistance := "", 0
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(candidate))
+		// a third of the name may be mistyped
+		if distance > max(1, len([]rune(candidate))/3) {
+			continue
+		}
+		if closest == "" || distance < closestDistance || distance == closestDistance && candidate < closest {
+			closest, closestDistance = candidate, distance
+		}
+	}
+	return closest
+}
@@ -0,0 +1,130 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Loads YAML schemas and documents into the JSON data model.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+// Helper function to read a yaml document from bytes
+func GetYamlDocument(content []byte) (interface{}, error) {
+
+	var document interface{}
+	err := yaml.Unmarshal(content, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeYaml(document)
+}
+
+// Helper function to read a yaml from a filepath
+func GetFileYaml(filepath string) (interface{}, error) {
+
+	bodyBuff, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetYamlDocument(bodyBuff)
+}
+
+// isYamlFilename tells if a file should be loaded as yaml rather than json
+func isYamlFilename(filename string) bool {
+	lowerFilename := strings.ToLower(filename)
+	return strings.HasSuffix(lowerFilename, ".yaml") || strings.HasSuffix(lowerFilename, ".yml")
+}
+
+// normalizeYaml converts a decoded yaml value into the values encoding/json
+// would produce : map[interface{}]interface{} become map[string]interface{}
+// and all numbers float64
+func normalizeYaml(value interface{}) (interface{}, error) {
+
+	switch v := value.(type) {
+
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			var stringKey string
+			switch k := key.(type) {
+			case string:
+				stringKey = k
+			case bool, int, int64, uint64, float64:
+				stringKey = fmt.Sprint(k)
+			default:
+				return nil, errors.New(fmt.Sprintf("yaml key %v cannot be converted to a json property", key))
+			}
+			normalized, err := normalizeYaml(element)
+			if err != nil {
+				return nil, err
+			}
+			m[stringKey] = normalized
+		}
+		return m, nil
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			normalized, err := normalizeYaml(element)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = normalized
+		}
+		return m, nil
+
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, element := range v {
+			normalized, err := normalizeYaml(element)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = normalized
+		}
+		return a, nil
+
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+
+	case nil, bool, string, float64:
+		return v, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("yaml value %v of type %T cannot be converted to json", value, value))
+}
@@ -0,0 +1,82 @@
+// Copyright 2013 sigu-399 ( https://github.com/sigu-399 )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           sigu-399
+// author-github    https://github.com/sigu-399
+// author-mail      sigu.399@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the yaml loader.
+//
+// created          16-10-2026
+
+package gojsonschema
+
+import (
+	"os"
+	"testing"
+)
+
+func TestNormalizeYaml(t *testing.T) {
+
+	normalized, err := normalizeYaml(map[interface{}]interface{}{
+		"name":  "gopher",
+		"ports": []interface{}{80, 443},
+		1:       map[interface{}]interface{}{true: nil},
+	})
+	if err != nil {
+		t.Fatalf("Could not normalize yaml : %s", err.Error())
+	}
+
+	expected := `{"1":{"true":null},"name":"gopher","ports":[80,443]}`
+	if given := *mustMarshalToString(t, normalized); given != expected {
+		t.Errorf("Expects %s, given %s", expected, given)
+	}
+
+	if _, err := normalizeYaml(map[interface{}]interface{}{[2]int{1, 2}: "x"}); err == nil {
+		t.Errorf("Expects a composite key to be an error")
+	}
+}
+
+func TestYamlSchemaAndDocument(t *testing.T) {
+
+	schemaFile := t.TempDir() + "/schema.yaml"
+	err := os.WriteFile(schemaFile, []byte("type: object\nproperties:\n  port:\n    type: integer\n    maximum: 65535\nrequired: [port]\n"), 0644)
+	if err != nil {
+		t.Fatalf("Could not write schema : %s", err.Error())
+	}
+
+	schemaDocument, err := NewJsonSchemaDocument("file://" + schemaFile)
+	if err != nil {
+		t.Fatalf("Could not parse schema : %s", err.Error())
+	}
+
+	document, err := GetYamlDocument([]byte("port: 8080\n"))
+	if err != nil {
+		t.Fatalf("Could not parse document : %s", err.Error())
+	}
+	if result := schemaDocument.Validate(document); !result.IsValid() {
+		t.Errorf("Expects the document to be valid, given %v", result.GetErrorMessages())
+	}
+
+	document, err = GetYamlDocument([]byte("port: 70000\n"))
+	if err != nil {
+		t.Fatalf("Could not parse document : %s", err.Error())
+	}
+	if result := schemaDocument.Validate(document); result.IsValid() {
+		t.Errorf("Expects the document to be invalid")
+	}
+}